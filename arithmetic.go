@@ -0,0 +1,310 @@
+package nullable
+
+import "math/big"
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. The sum
+// wraps around on overflow, the same as Go int arithmetic.
+func (n Int) Add(other Int) Int {
+	if !n.isValid || !other.isValid {
+		return NullInt()
+	}
+	return IntFrom(n.realValue + other.realValue)
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. The
+// difference wraps around on overflow, the same as Go int arithmetic.
+func (n Int) Sub(other Int) Int {
+	if !n.isValid || !other.isValid {
+		return NullInt()
+	}
+	return IntFrom(n.realValue - other.realValue)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. The sum
+// wraps around on overflow, the same as Go int8 arithmetic.
+func (n Int8) Add(other Int8) Int8 {
+	if !n.isValid || !other.isValid {
+		return NullInt8()
+	}
+	return Int8From(n.realValue + other.realValue)
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. The
+// difference wraps around on overflow, the same as Go int8 arithmetic.
+func (n Int8) Sub(other Int8) Int8 {
+	if !n.isValid || !other.isValid {
+		return NullInt8()
+	}
+	return Int8From(n.realValue - other.realValue)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. The sum
+// wraps around on overflow, the same as Go int16 arithmetic.
+func (n Int16) Add(other Int16) Int16 {
+	if !n.isValid || !other.isValid {
+		return NullInt16()
+	}
+	return Int16From(n.realValue + other.realValue)
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. The
+// difference wraps around on overflow, the same as Go int16 arithmetic.
+func (n Int16) Sub(other Int16) Int16 {
+	if !n.isValid || !other.isValid {
+		return NullInt16()
+	}
+	return Int16From(n.realValue - other.realValue)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. The sum
+// wraps around on overflow, the same as Go int32 arithmetic.
+func (n Int32) Add(other Int32) Int32 {
+	if !n.isValid || !other.isValid {
+		return NullInt32()
+	}
+	return Int32From(n.realValue + other.realValue)
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. The
+// difference wraps around on overflow, the same as Go int32 arithmetic.
+func (n Int32) Sub(other Int32) Int32 {
+	if !n.isValid || !other.isValid {
+		return NullInt32()
+	}
+	return Int32From(n.realValue - other.realValue)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. The sum
+// wraps around on overflow, the same as Go int64 arithmetic.
+func (n Int64) Add(other Int64) Int64 {
+	if !n.isValid || !other.isValid {
+		return NullInt64()
+	}
+	return Int64From(n.realValue + other.realValue)
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. The
+// difference wraps around on overflow, the same as Go int64 arithmetic.
+func (n Int64) Sub(other Int64) Int64 {
+	if !n.isValid || !other.isValid {
+		return NullInt64()
+	}
+	return Int64From(n.realValue - other.realValue)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. The sum
+// wraps around on overflow, the same as Go uint arithmetic.
+func (n Uint) Add(other Uint) Uint {
+	if !n.isValid || !other.isValid {
+		return NullUint()
+	}
+	return UintFrom(n.realValue + other.realValue)
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. The
+// difference wraps around on overflow, the same as Go uint arithmetic.
+func (n Uint) Sub(other Uint) Uint {
+	if !n.isValid || !other.isValid {
+		return NullUint()
+	}
+	return UintFrom(n.realValue - other.realValue)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. The sum
+// wraps around on overflow, the same as Go uint8 arithmetic.
+func (n Uint8) Add(other Uint8) Uint8 {
+	if !n.isValid || !other.isValid {
+		return NullUint8()
+	}
+	return Uint8From(n.realValue + other.realValue)
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. The
+// difference wraps around on overflow, the same as Go uint8 arithmetic.
+func (n Uint8) Sub(other Uint8) Uint8 {
+	if !n.isValid || !other.isValid {
+		return NullUint8()
+	}
+	return Uint8From(n.realValue - other.realValue)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. The sum
+// wraps around on overflow, the same as Go uint16 arithmetic.
+func (n Uint16) Add(other Uint16) Uint16 {
+	if !n.isValid || !other.isValid {
+		return NullUint16()
+	}
+	return Uint16From(n.realValue + other.realValue)
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. The
+// difference wraps around on overflow, the same as Go uint16 arithmetic.
+func (n Uint16) Sub(other Uint16) Uint16 {
+	if !n.isValid || !other.isValid {
+		return NullUint16()
+	}
+	return Uint16From(n.realValue - other.realValue)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. The sum
+// wraps around on overflow, the same as Go uint32 arithmetic.
+func (n Uint32) Add(other Uint32) Uint32 {
+	if !n.isValid || !other.isValid {
+		return NullUint32()
+	}
+	return Uint32From(n.realValue + other.realValue)
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. The
+// difference wraps around on overflow, the same as Go uint32 arithmetic.
+func (n Uint32) Sub(other Uint32) Uint32 {
+	if !n.isValid || !other.isValid {
+		return NullUint32()
+	}
+	return Uint32From(n.realValue - other.realValue)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. The sum
+// wraps around on overflow, the same as Go uint64 arithmetic.
+func (n Uint64) Add(other Uint64) Uint64 {
+	if !n.isValid || !other.isValid {
+		return NullUint64()
+	}
+	return Uint64From(n.realValue + other.realValue)
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. The
+// difference wraps around on overflow, the same as Go uint64 arithmetic.
+func (n Uint64) Sub(other Uint64) Uint64 {
+	if !n.isValid || !other.isValid {
+		return NullUint64()
+	}
+	return Uint64From(n.realValue - other.realValue)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. The sum
+// wraps around on overflow, the same as Go byte arithmetic.
+func (n Byte) Add(other Byte) Byte {
+	if !n.isValid || !other.isValid {
+		return NullByte()
+	}
+	return ByteFrom(n.realValue + other.realValue)
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. The
+// difference wraps around on overflow, the same as Go byte arithmetic.
+func (n Byte) Sub(other Byte) Byte {
+	if !n.isValid || !other.isValid {
+		return NullByte()
+	}
+	return ByteFrom(n.realValue - other.realValue)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. The sum
+// wraps around on overflow, the same as Go time.Duration arithmetic.
+func (n Duration) Add(other Duration) Duration {
+	if !n.isValid || !other.isValid {
+		return NullDuration()
+	}
+	return DurationFrom(n.realValue + other.realValue)
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. The
+// difference wraps around on overflow, the same as Go time.Duration arithmetic.
+func (n Duration) Sub(other Duration) Duration {
+	if !n.isValid || !other.isValid {
+		return NullDuration()
+	}
+	return DurationFrom(n.realValue - other.realValue)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. A
+// sum too large for float32 is NULL too, since JSON has no infinity.
+func (n Float32) Add(other Float32) Float32 {
+	if !n.isValid || !other.isValid {
+		return NullFloat32()
+	}
+	return finiteFloat32(n.realValue + other.realValue)
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. A
+// difference too large for float32 is NULL too, since JSON has no infinity.
+func (n Float32) Sub(other Float32) Float32 {
+	if !n.isValid || !other.isValid {
+		return NullFloat32()
+	}
+	return finiteFloat32(n.realValue - other.realValue)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. A
+// sum too large for float64 is NULL too, since Float64 rejects infinities.
+func (n Float64) Add(other Float64) Float64 {
+	if !n.isValid || !other.isValid {
+		return NullFloat64()
+	}
+	return finiteFloat64(n.realValue + other.realValue)
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. A
+// difference too large for float64 is NULL too, since Float64 rejects
+// infinities.
+func (n Float64) Sub(other Float64) Float64 {
+	if !n.isValid || !other.isValid {
+		return NullFloat64()
+	}
+	return finiteFloat64(n.realValue - other.realValue)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. The sum
+// is exact.
+func (n Decimal) Add(other Decimal) Decimal {
+	if !n.isValid || !other.isValid {
+		return NullDecimal()
+	}
+	return DecimalFrom(n.realValue.Add(other.realValue))
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. The
+// difference is exact.
+func (n Decimal) Sub(other Decimal) Decimal {
+	if !n.isValid || !other.isValid {
+		return NullDecimal()
+	}
+	return DecimalFrom(n.realValue.Sub(other.realValue))
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. The sum
+// is exact.
+func (n BigInt) Add(other BigInt) BigInt {
+	if !n.isValid || !other.isValid {
+		return NullBigInt()
+	}
+	return BigInt{core: core[*big.Int]{realValue: new(big.Int).Add(n.realValue, other.realValue), isValid: true}}
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. The
+// difference is exact.
+func (n BigInt) Sub(other BigInt) BigInt {
+	if !n.isValid || !other.isValid {
+		return NullBigInt()
+	}
+	return BigInt{core: core[*big.Int]{realValue: new(big.Int).Sub(n.realValue, other.realValue), isValid: true}}
+}
+
+// finiteFloat32 is a valid Float32 holding value, or NULL when value is NaN
+// or an infinity
+func finiteFloat32(value float32) Float32 {
+	if checkFinite(float64(value)) != nil {
+		return NullFloat32()
+	}
+	return Float32From(value)
+}
+
+// finiteFloat64 is a valid Float64 holding value, or NULL when value is NaN
+// or an infinity
+func finiteFloat64(value float64) Float64 {
+	if checkFinite(value) != nil {
+		return NullFloat64()
+	}
+	return Float64From(value)
+}
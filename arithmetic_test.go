@@ -0,0 +1,44 @@
+package nullable_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestAddSub(t *testing.T) {
+	tests.AssertEqual(t, nullable.Int64From(40).Add(nullable.Int64From(2)), nullable.Int64From(42))
+	tests.AssertEqual(t, nullable.Int64From(40).Sub(nullable.Int64From(42)), nullable.Int64From(-2))
+	tests.AssertEqual(t, nullable.Uint32From(7).Add(nullable.Uint32From(8)), nullable.Uint32From(15))
+	tests.AssertEqual(t, nullable.Float64From(0.5).Add(nullable.Float64From(0.25)), nullable.Float64From(0.75))
+	tests.AssertEqual(t, nullable.DurationFrom(time.Hour).Sub(nullable.DurationFrom(time.Minute)), nullable.DurationFrom(59*time.Minute))
+	tests.AssertEqual(t, nullable.DecimalFrom(decimal.RequireFromString("0.1")).Add(nullable.DecimalFrom(decimal.RequireFromString("0.2"))).String(), "0.3")
+	tests.AssertEqual(t, nullable.BigIntFromInt64(math.MaxInt64).Add(nullable.BigIntFromInt64(1)).String(), "9223372036854775808")
+}
+
+func TestAddSubNull(t *testing.T) {
+	tests.AssertEqual(t, nullable.Int64From(1).Add(nullable.NullInt64()), nullable.NullInt64())
+	tests.AssertEqual(t, nullable.NullInt64().Add(nullable.Int64From(1)), nullable.NullInt64())
+	tests.AssertEqual(t, nullable.NullInt64().Sub(nullable.NullInt64()), nullable.NullInt64())
+	tests.AssertEqual(t, nullable.Uint8From(1).Sub(nullable.NullUint8()), nullable.NullUint8())
+	tests.AssertEqual(t, nullable.NullFloat32().Add(nullable.Float32From(1)), nullable.NullFloat32())
+	tests.AssertEqual(t, nullable.NullDuration().Sub(nullable.DurationFrom(time.Second)), nullable.NullDuration())
+	tests.AssertEqual(t, nullable.DecimalFrom(decimal.NewFromInt(1)).Add(nullable.NullDecimal()), nullable.NullDecimal())
+	tests.AssertEqual(t, nullable.NullBigInt().Sub(nullable.BigIntFromInt64(1)).IsNull(), true)
+}
+
+func TestAddSubOverflow(t *testing.T) {
+	tests.AssertEqual(t, nullable.Int64From(math.MaxInt64).Add(nullable.Int64From(1)), nullable.Int64From(math.MinInt64))
+	tests.AssertEqual(t, nullable.Int8From(math.MinInt8).Sub(nullable.Int8From(1)), nullable.Int8From(math.MaxInt8))
+	tests.AssertEqual(t, nullable.Uint64From(math.MaxUint64).Add(nullable.Uint64From(1)), nullable.Uint64From(0))
+	tests.AssertEqual(t, nullable.UintFrom(0).Sub(nullable.UintFrom(1)), nullable.UintFrom(math.MaxUint))
+	tests.AssertEqual(t, nullable.ByteFrom(255).Add(nullable.ByteFrom(1)), nullable.ByteFrom(0))
+	tests.AssertEqual(t, nullable.Float64From(math.MaxFloat64).Add(nullable.Float64From(math.MaxFloat64)), nullable.NullFloat64())
+	tests.AssertEqual(t, nullable.Float64From(-math.MaxFloat64).Sub(nullable.Float64From(math.MaxFloat64)), nullable.NullFloat64())
+	tests.AssertEqual(t, nullable.Float32From(math.MaxFloat32).Add(nullable.Float32From(math.MaxFloat32)), nullable.NullFloat32())
+	tests.AssertEqual(t, nullable.Float32From(-math.MaxFloat32).Sub(nullable.Float32From(math.MaxFloat32)), nullable.NullFloat32())
+}
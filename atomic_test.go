@@ -0,0 +1,112 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestAtomicUint64(t *testing.T) {
+	var counter nullable.AtomicUint64
+	tests.AssertEqual(t, counter.Load(), nullable.NullUint64())
+	tests.AssertEqual(t, counter.Add(1), nullable.NullUint64())
+
+	counter.Store(nullable.Uint64From(0))
+	tests.AssertEqual(t, counter.Add(5), nullable.Uint64From(5))
+	tests.AssertEqual(t, counter.Swap(nullable.NullUint64()), nullable.Uint64From(5))
+	tests.AssertEqual(t, counter.Load().IsNull(), true)
+
+	tests.AssertEqual(t, counter.CompareAndSwap(nullable.Uint64From(0), nullable.Uint64From(1)), false)
+	tests.AssertEqual(t, counter.CompareAndSwap(nullable.NullUint64(), nullable.Uint64From(1)), true)
+	tests.AssertEqual(t, counter.CompareAndSwap(nullable.Uint64From(1), nullable.Uint64From(2)), true)
+	tests.AssertEqual(t, counter.String(), "2")
+
+	serialized, err := json.Marshal(&counter)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "2")
+	tests.AssertEqual(t, json.Unmarshal([]byte(`null`), &counter), nil)
+	tests.AssertEqual(t, counter.Load().IsNull(), true)
+	tests.AssertEqual(t, json.Unmarshal([]byte(`-1`), &counter) != nil, true)
+
+	tests.AssertEqual(t, counter.Scan("18446744073709551615"), nil)
+	value, err := counter.Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, "18446744073709551615")
+	tests.AssertEqual(t, counter.Scan(true) != nil, true)
+	tests.AssertEqual(t, counter.Load(), nullable.Uint64From(18446744073709551615))
+
+	tests.AssertEqual(t, nullable.NewAtomicUint64(nullable.Uint64From(7)).Load(), nullable.Uint64From(7))
+}
+
+func TestAtomicBool(t *testing.T) {
+	var flag nullable.AtomicBool
+	tests.AssertEqual(t, flag.Load(), nullable.NullBool())
+
+	tests.AssertEqual(t, flag.Swap(nullable.BoolFrom(false)), nullable.NullBool())
+	tests.AssertEqual(t, flag.Load(), nullable.BoolFrom(false))
+	tests.AssertEqual(t, flag.CompareAndSwap(nullable.NullBool(), nullable.BoolFrom(true)), false)
+	tests.AssertEqual(t, flag.CompareAndSwap(nullable.BoolFrom(false), nullable.BoolFrom(true)), true)
+	tests.AssertEqual(t, flag.String(), "true")
+
+	serialized, err := json.Marshal(&flag)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "true")
+	tests.AssertEqual(t, json.Unmarshal([]byte(`null`), &flag), nil)
+	tests.AssertEqual(t, flag.Load().IsNull(), true)
+
+	tests.AssertEqual(t, flag.Scan(int64(0)), nil)
+	value, err := flag.Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, false)
+
+	tests.AssertEqual(t, nullable.NewAtomicBool(nullable.BoolFrom(true)).Load(), nullable.BoolFrom(true))
+}
+
+// TestAtomicConcurrent is meant for go test -race, which fails it on any
+// unsynchronized access
+func TestAtomicConcurrent(t *testing.T) {
+	const goroutines, iterations = 8, 1000
+
+	counter := nullable.NewAtomicUint64(nullable.Uint64From(0))
+	var flag nullable.AtomicBool
+	var wg sync.WaitGroup
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < iterations; j++ {
+				counter.Add(1)
+				flag.Store(nullable.BoolFrom(j%2 == 0))
+				if j%100 == 0 {
+					flag.Store(nullable.NullBool())
+				}
+				_ = flag.Load()
+				if _, err := json.Marshal(counter); err != nil {
+					t.Error(err)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	tests.AssertEqual(t, counter.Load(), nullable.Uint64From(goroutines*iterations))
+
+	// CompareAndSwap lets exactly one goroutine fill a NULL value
+	var winners nullable.AtomicUint64
+	var filled nullable.AtomicUint64
+	filled.Store(nullable.Uint64From(0))
+	for i := 0; i < goroutines; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if winners.CompareAndSwap(nullable.NullUint64(), nullable.Uint64From(uint64(i))) {
+				filled.Add(1)
+			}
+		}(i)
+	}
+	wg.Wait()
+	tests.AssertEqual(t, filled.Load(), nullable.Uint64From(1))
+	tests.AssertEqual(t, winners.Load().IsValid(), true)
+}
@@ -0,0 +1,126 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"log/slog"
+	"sync/atomic"
+)
+
+// The bits AtomicBool packs the valid bit and the boolean into
+const (
+	atomicBoolValid uint32 = 1 << iota
+	atomicBoolTrue
+)
+
+// AtomicBool is a Bool that goroutines may load and store at the same time
+// without a lock, the valid bit and the boolean packed into one uint32. The
+// zero value is NULL, and it must not be copied after first use.
+type AtomicBool struct {
+	bits atomic.Uint32
+}
+
+// NewAtomicBool creates a new atomic nullable boolean holding value
+func NewAtomicBool(value Bool) *AtomicBool {
+	n := new(AtomicBool)
+	n.Store(value)
+	return n
+}
+
+// packBool returns the bits of value
+func packBool(value Bool) uint32 {
+	switch {
+	case !value.isValid:
+		return 0
+	case value.realValue:
+		return atomicBoolValid | atomicBoolTrue
+	}
+	return atomicBoolValid
+}
+
+// unpackBool returns the value of bits
+func unpackBool(bits uint32) Bool {
+	if bits&atomicBoolValid == 0 {
+		return NullBool()
+	}
+	return BoolFrom(bits&atomicBoolTrue != 0)
+}
+
+// Load returns a snapshot of the current value
+func (n *AtomicBool) Load() Bool {
+	return unpackBool(n.bits.Load())
+}
+
+// Store sets the current value
+func (n *AtomicBool) Store(value Bool) {
+	n.bits.Store(packBool(value))
+}
+
+// Swap sets the current value and returns the one it replaced
+func (n *AtomicBool) Swap(value Bool) Bool {
+	return unpackBool(n.bits.Swap(packBool(value)))
+}
+
+// CompareAndSwap sets value only when the current value equals old the way
+// Bool.Equal tells them apart, and reports whether it did
+func (n *AtomicBool) CompareAndSwap(old, value Bool) bool {
+	return n.bits.CompareAndSwap(packBool(old), packBool(value))
+}
+
+// String returns the current value as "true" or "false", or "<null>" when
+// NULL
+func (n *AtomicBool) String() string {
+	return n.Load().String()
+}
+
+// LogValue implements slog.LogValuer on a snapshot, like Load().LogValue()
+func (n *AtomicBool) LogValue() slog.Value {
+	return n.Load().LogValue()
+}
+
+// MarshalJSON converts a snapshot of the current value to JSON
+func (n *AtomicBool) MarshalJSON() ([]byte, error) {
+	return n.Load().MarshalJSON()
+}
+
+// UnmarshalJSON stores JSON to this type, leaving the value unchanged on error
+func (n *AtomicBool) UnmarshalJSON(data []byte) error {
+	var parsed Bool
+	if err := parsed.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Store(parsed)
+	return nil
+}
+
+// MarshalText converts a snapshot of the current value to text, NULL is
+// empty text
+func (n *AtomicBool) MarshalText() ([]byte, error) {
+	return n.Load().MarshalText()
+}
+
+// UnmarshalText stores text to this type, empty text is NULL
+func (n *AtomicBool) UnmarshalText(text []byte) error {
+	var parsed Bool
+	if err := parsed.UnmarshalText(text); err != nil {
+		return err
+	}
+	n.Store(parsed)
+	return nil
+}
+
+// Scan implements scanner interface, storing the value the way Bool.Scan
+// reads it
+func (n *AtomicBool) Scan(value interface{}) error {
+	var scanned Bool
+	if err := scanned.Scan(value); err != nil {
+		return err
+	}
+	n.Store(scanned)
+	return nil
+}
+
+// Value implements the driver Valuer interface with a snapshot of the
+// current value
+func (n *AtomicBool) Value() (driver.Value, error) {
+	return n.Load().Value()
+}
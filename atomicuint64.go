@@ -0,0 +1,139 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"log/slog"
+	"sync/atomic"
+)
+
+// AtomicUint64 is a Uint64 that goroutines may load and store at the same
+// time without a lock. A 64-bit value leaves no room for the valid bit, so it
+// holds a pointer to an immutable value that every Store replaces, nil when
+// NULL. The zero value is NULL, and it must not be copied after first use.
+type AtomicUint64 struct {
+	value atomic.Pointer[uint64]
+}
+
+// NewAtomicUint64 creates a new atomic nullable 64-bit unsigned integer
+// holding value
+func NewAtomicUint64(value Uint64) *AtomicUint64 {
+	n := new(AtomicUint64)
+	n.Store(value)
+	return n
+}
+
+// packUint64 returns nil for NULL, or a new pointer to the value
+func packUint64(value Uint64) *uint64 {
+	if !value.isValid {
+		return nil
+	}
+	return &value.realValue
+}
+
+// unpackUint64 returns the value pointer points to
+func unpackUint64(pointer *uint64) Uint64 {
+	return NewUint64(pointer)
+}
+
+// Load returns a snapshot of the current value
+func (n *AtomicUint64) Load() Uint64 {
+	return unpackUint64(n.value.Load())
+}
+
+// Store sets the current value
+func (n *AtomicUint64) Store(value Uint64) {
+	n.value.Store(packUint64(value))
+}
+
+// Swap sets the current value and returns the one it replaced
+func (n *AtomicUint64) Swap(value Uint64) Uint64 {
+	return unpackUint64(n.value.Swap(packUint64(value)))
+}
+
+// CompareAndSwap sets value only when the current value equals old the way
+// Uint64.Equal tells them apart, and reports whether it did
+func (n *AtomicUint64) CompareAndSwap(old, value Uint64) bool {
+	replacement := packUint64(value)
+	for {
+		current := n.value.Load()
+		if !unpackUint64(current).Equal(old) {
+			return false
+		}
+		if n.value.CompareAndSwap(current, replacement) {
+			return true
+		}
+	}
+}
+
+// Add adds delta to the current value and returns the sum, NULL stays NULL
+// like it does in SQL. It wraps around on overflow as Go arithmetic does.
+func (n *AtomicUint64) Add(delta uint64) Uint64 {
+	for {
+		current := n.value.Load()
+		if current == nil {
+			return NullUint64()
+		}
+		sum := *current + delta
+		if n.value.CompareAndSwap(current, &sum) {
+			return Uint64From(sum)
+		}
+	}
+}
+
+// String returns the current value in decimal, or "<null>" when NULL
+func (n *AtomicUint64) String() string {
+	return n.Load().String()
+}
+
+// LogValue implements slog.LogValuer on a snapshot, like Load().LogValue()
+func (n *AtomicUint64) LogValue() slog.Value {
+	return n.Load().LogValue()
+}
+
+// MarshalJSON converts a snapshot of the current value to JSON
+func (n *AtomicUint64) MarshalJSON() ([]byte, error) {
+	return n.Load().MarshalJSON()
+}
+
+// UnmarshalJSON stores JSON to this type, leaving the value unchanged on error
+func (n *AtomicUint64) UnmarshalJSON(data []byte) error {
+	var parsed Uint64
+	if err := parsed.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	n.Store(parsed)
+	return nil
+}
+
+// MarshalText converts a snapshot of the current value to text, NULL is
+// empty text
+func (n *AtomicUint64) MarshalText() ([]byte, error) {
+	return n.Load().MarshalText()
+}
+
+// UnmarshalText stores text to this type, empty text is NULL
+func (n *AtomicUint64) UnmarshalText(text []byte) error {
+	var parsed Uint64
+	if err := parsed.UnmarshalText(text); err != nil {
+		return err
+	}
+	n.Store(parsed)
+	return nil
+}
+
+// Scan implements scanner interface, storing the value the way Uint64.Scan
+// reads it
+func (n *AtomicUint64) Scan(value interface{}) error {
+	var scanned Uint64
+	if err := scanned.Scan(value); err != nil {
+		return err
+	}
+	n.Store(scanned)
+	return nil
+}
+
+// Value implements the driver Valuer interface with a snapshot of the
+// current value
+func (n *AtomicUint64) Value() (driver.Value, error) {
+	return n.Load().Value()
+}
@@ -0,0 +1,603 @@
+package nullable
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"log/slog"
+	"math/big"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// BigInt SQL type that can retrieve NULL value. The big integer is copied on
+// the way in and out, so callers may keep on changing their own *big.Int.
+type BigInt struct {
+	core[*big.Int]
+}
+
+// NewBigInt creates a new nullable big integer, nil is NULL
+func NewBigInt(value *big.Int) BigInt {
+	if value == nil {
+		return BigInt{}
+	}
+	return BigInt{core: core[*big.Int]{realValue: new(big.Int).Set(value), isValid: true}}
+}
+
+// BigIntFromInt64 creates a new valid nullable big integer from value
+func BigIntFromInt64(value int64) BigInt {
+	return NewBigInt(big.NewInt(value))
+}
+
+// NullBigInt creates a new NULL big integer
+func NullBigInt() BigInt {
+	return NewBigInt(nil)
+}
+
+// BigIntZeroAsNull creates a new nullable big integer that is NULL when value is nil or zero
+func BigIntZeroAsNull(value *big.Int) BigInt {
+	if value == nil || value.Sign() == 0 {
+		return NullBigInt()
+	}
+	return NewBigInt(value)
+}
+
+// CoalesceBigInt returns the first valid value, or NULL when all of them are NULL
+func CoalesceBigInt(values ...BigInt) BigInt {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return BigInt{}
+}
+
+// MinBigInt returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinBigInt(values ...BigInt) BigInt {
+	var result BigInt
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// MaxBigInt returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxBigInt(values ...BigInt) BigInt {
+	var result BigInt
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// BigIntPtr returns n.Get(), handy where a function value is needed
+func BigIntPtr(n BigInt) *big.Int {
+	return n.Get()
+}
+
+// BigIntValue returns n.GetOrZero(), zero when NULL
+func BigIntValue(n BigInt) *big.Int {
+	return n.GetOrZero()
+}
+
+// ParseBigInt parses text like UnmarshalText does, empty text is NULL
+func ParseBigInt(text string) (BigInt, error) {
+	var n BigInt
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return BigInt{}, err
+	}
+	return n, nil
+}
+
+// MustParseBigInt is ParseBigInt that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseBigInt(text string) BigInt {
+	n, err := ParseBigInt(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseBigInt(%q): %v", text, err))
+	}
+	return n
+}
+
+// Get either nil or a copy of big integer
+func (n BigInt) Get() *big.Int {
+	if !n.isValid {
+		return nil
+	}
+	return new(big.Int).Set(n.realValue)
+}
+
+// Unwrap returns a copy of big integer and true, or nil and false when NULL,
+// the comma-ok way
+func (n BigInt) Unwrap() (*big.Int, bool) {
+	return n.Get(), n.isValid
+}
+
+// Set either nil or big integer
+func (n *BigInt) Set(value *big.Int) {
+	*n = NewBigInt(value)
+}
+
+// Swap sets either nil or big integer like Set does and returns the previous
+// value, nil when it was NULL
+func (n *BigInt) Swap(value *big.Int) *big.Int {
+	previous := n.Get()
+	n.Set(value)
+	return previous
+}
+
+// SetValue sets big integer and marks it as not NULL, nil is still NULL
+func (n *BigInt) SetValue(value *big.Int) {
+	n.Set(value)
+}
+
+// Reset sets the value back to the zero NULL BigInt, the one a declared
+// BigInt starts with, letting go of the held big.Int so pooled values can be
+// reused
+func (n *BigInt) Reset() {
+	*n = BigInt{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *BigInt) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds zero
+func (n *BigInt) NullIfZero() {
+	if n.isValid && n.realValue.Sign() == 0 {
+		n.SetNull()
+	}
+}
+
+// Validate runs fns on the big integer unless NULL and joins their errors, nil
+// when all of them pass or the value is NULL
+func (n BigInt) Validate(fns ...func(*big.Int) error) error {
+	return validate(n.isValid, n.Get(), fns)
+}
+
+// GetOr either fallback or a copy of big integer
+func (n BigInt) GetOr(fallback *big.Int) *big.Int {
+	if !n.isValid {
+		return fallback
+	}
+	return n.Get()
+}
+
+// GetOrZero either zero or a copy of big integer
+func (n BigInt) GetOrZero() *big.Int {
+	return n.GetOr(new(big.Int))
+}
+
+// MustGet either a copy of big integer or panic when NULL
+func (n BigInt) MustGet() *big.Int {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL BigInt")
+	}
+	return n.Get()
+}
+
+// Clone returns a copy of the value that shares no memory with n
+func (n BigInt) Clone() BigInt {
+	if n.isValid {
+		n.realValue = new(big.Int).Set(n.realValue)
+	}
+	return n
+}
+
+// String returns big integer in decimal form, or "<null>" when NULL
+func (n BigInt) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return n.realValue.String()
+}
+
+// DebugString returns BigInt(value) or BigInt(NULL), telling NULL apart from a
+// zero value in logs
+func (n BigInt) DebugString() string {
+	return debugString("BigInt", n.isValid, n.String())
+}
+
+// LogValue implements slog.LogValuer, logging NULL as nil and the value as
+// its decimal digits as a String, since it may not fit an Int64
+func (n BigInt) LogValue() slog.Value {
+	return logText(n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same big integer
+func (n BigInt) Equal(other BigInt) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue.Cmp(other.realValue) == 0
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n BigInt) Changed(old BigInt) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any big integer.
+func (n BigInt) Compare(other BigInt) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
+	}
+	return n.realValue.Cmp(other.realValue)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL its decimal digits, telling NULL apart from zero in cache keys
+func (n BigInt) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagBigInt, n.isValid, []byte(n.realValue.String()))
+}
+
+// MarshalJSON converts current value to JSON
+func (n BigInt) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	// Bare JSON number, just like big.Int does
+	return []byte(n.realValue.String()), nil
+}
+
+// UnmarshalJSON writes JSON to this type
+func (n *BigInt) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	// Quoted numbers are accepted as integers beyond 2^53 often are quoted
+	parsed, err := parseBigInt(strings.Trim(strings.TrimSpace(string(data)), `"`))
+	if err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n BigInt) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(n.realValue.String()), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *BigInt) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	parsed, err := parseBigInt(string(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n BigInt) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *BigInt) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n BigInt) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return n.realValue.String(), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n BigInt) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	// A plain string would get quoted, tag it as integer instead
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: n.realValue.String()}, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *BigInt) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	parsed, err := parseBigInt(value.Value)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n BigInt) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue.String(), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *BigInt) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	parsed, err := parseBigInt(strings.TrimSpace(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n BigInt) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(n.realValue.String())
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *BigInt) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	text, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+	parsed, err := parseBigInt(text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n BigInt) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	// BSON has no integer wider than 64 bits
+	return bson.MarshalValue(n.realValue.String())
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *BigInt) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	var parsed *big.Int
+	switch t {
+	case bson.TypeString:
+		text, _, ok := bsoncore.ReadString(data)
+		if !ok {
+			return bsonTypeError(t, "BigInt")
+		}
+		value, err := parseBigInt(text)
+		if err != nil {
+			return err
+		}
+		parsed = value
+	case bson.TypeInt32:
+		value, _, ok := bsoncore.ReadInt32(data)
+		if !ok {
+			return bsonTypeError(t, "BigInt")
+		}
+		parsed = big.NewInt(int64(value))
+	case bson.TypeInt64:
+		value, _, ok := bsoncore.ReadInt64(data)
+		if !ok {
+			return bsonTypeError(t, "BigInt")
+		}
+		parsed = big.NewInt(value)
+	default:
+		return bsonTypeError(t, "BigInt")
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n BigInt) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *BigInt) GobDecode(data []byte) error {
+	parsed := new(big.Int)
+	isValid, err := gobDecode(data, parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// Scan implements scanner interface
+func (n *BigInt) Scan(value interface{}) error {
+	return scanError(n.ScanContext(context.Background(), value))
+}
+
+// ScanContext is Scan that gives up with ctx.Err(), leaving the value
+// unchanged, when ctx is done before parsing starts
+func (n *BigInt) ScanContext(ctx context.Context, value interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	value = scanValue(value)
+	if value == nil {
+		n.realValue, n.isValid = nil, false
+		return nil
+	}
+
+	var scanned *big.Int
+	switch value := plainValue(value).(type) {
+	case int64:
+		scanned = big.NewInt(value)
+	case []byte:
+		parsed, err := parseBigInt(string(value))
+		if err != nil {
+			return scanError(err)
+		}
+		scanned = parsed
+	case string:
+		parsed, err := parseBigInt(value)
+		if err != nil {
+			return scanError(err)
+		}
+		scanned = parsed
+	default:
+		return scanError(fmt.Errorf("converting driver.Value type %T (%v) to a BigInt: unsupported type", value, value))
+	}
+	n.realValue = scanned
+
+	n.isValid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n BigInt) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue.String(), nil
+}
+
+// GormDataType gorm common data type
+func (BigInt) GormDataType() string {
+	return "big_int_null"
+}
+
+// GormDBDataType gorm db data type
+func (n BigInt) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n BigInt) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (BigInt) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
+	case "sqlite":
+		// NUMERIC affinity would turn anything beyond int64 into a lossy REAL
+		return "TEXT"
+	case "mysql":
+		return "DECIMAL(65,0)"
+	case "postgres", "cockroachdb":
+		return "numeric"
+	case "sqlserver":
+		// SQL Server caps DECIMAL precision at 38
+		return "DECIMAL(38,0)"
+	case "clickhouse":
+		return "Nullable(Decimal(76,0))"
+	}
+	return ""
+}
+
+// parseBigInt parses a base 10 integer of any size
+func parseBigInt(text string) (*big.Int, error) {
+	parsed, ok := new(big.Int).SetString(text, 10)
+	if !ok {
+		return nil, fmt.Errorf("nullable: invalid big integer %q", text)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,367 @@
+package nullable_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func bigIntFromString(text string) *big.Int {
+	value, ok := new(big.Int).SetString(text, 10)
+	if !ok {
+		panic("invalid big integer " + text)
+	}
+	return value
+}
+
+func TestScanBigInt(t *testing.T) {
+	nullableBigInt := nullable.NewBigInt(nil)
+
+	tests.AssertEqual(t, nullableBigInt.Scan("123456789012345678901234567890"), nil)
+	tests.AssertEqual(t, nullableBigInt.Get().String(), "123456789012345678901234567890")
+
+	tests.AssertEqual(t, nullableBigInt.Scan([]byte("-98765432109876543210")), nil)
+	tests.AssertEqual(t, nullableBigInt.Get().String(), "-98765432109876543210")
+
+	tests.AssertEqual(t, nullableBigInt.Scan(int64(42)), nil)
+	tests.AssertEqual(t, nullableBigInt.Get().String(), "42")
+
+	tests.AssertEqual(t, nullableBigInt.Scan(sql.RawBytes("-7")), nil)
+	tests.AssertEqual(t, nullableBigInt.Get().String(), "-7")
+
+	if err := nullableBigInt.Scan("12.5"); err == nil {
+		t.Error("scanning fractional number into big integer must fail")
+	}
+	if err := nullableBigInt.Scan(12.0); err == nil {
+		t.Error("scanning float64 into big integer must fail")
+	}
+
+	tests.AssertEqual(t, nullableBigInt.Scan(nil), nil)
+	tests.AssertEqual(t, nullableBigInt.Get() == nil, true)
+}
+
+func TestScanContextBigInt(t *testing.T) {
+	nullableBigInt := nullable.NullBigInt()
+	tests.AssertEqual(t, nullableBigInt.ScanContext(context.Background(), "123456789012345678901234567890"), nil)
+	tests.AssertEqual(t, nullableBigInt, nullable.NewBigInt(bigIntFromString("123456789012345678901234567890")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests.AssertEqual(t, nullableBigInt.ScanContext(ctx, "42"), context.Canceled)
+	tests.AssertEqual(t, nullableBigInt, nullable.NewBigInt(bigIntFromString("123456789012345678901234567890")))
+
+	tests.AssertEqual(t, nullableBigInt.ScanContext(context.Background(), nil), nil)
+	tests.AssertEqual(t, nullableBigInt.IsNull(), true)
+}
+
+func TestScanJSONNumberBigInt(t *testing.T) {
+	nullableBigInt := nullable.NewBigInt(nil)
+
+	tests.AssertEqual(t, nullableBigInt.Scan(json.Number("123456789012345678901234567890")), nil)
+	tests.AssertEqual(t, nullableBigInt.Get().String(), "123456789012345678901234567890")
+}
+
+func TestNewBigInt(t *testing.T) {
+	basic := bigIntFromString("123456789012345678901234567890")
+	nullableBigInt1 := nullable.NewBigInt(basic)
+	tests.AssertEqual(t, nullableBigInt1.Get(), basic)
+
+	nullableBigInt2 := nullable.NewBigInt(nil)
+	tests.AssertEqual(t, nullableBigInt2.Get() == nil, true)
+	tests.AssertEqual(t, nullableBigInt2.IsNull(), true)
+}
+
+func TestCopyBigInt(t *testing.T) {
+	basic := big.NewInt(42)
+	nullableBigInt := nullable.NewBigInt(basic)
+
+	// Neither the given nor the returned big integer is shared
+	basic.SetInt64(7)
+	tests.AssertEqual(t, nullableBigInt.Get().Int64(), int64(42))
+	nullableBigInt.Get().SetInt64(7)
+	tests.AssertEqual(t, nullableBigInt.Get().Int64(), int64(42))
+	nullableBigInt.MustGet().SetInt64(7)
+	tests.AssertEqual(t, nullableBigInt.Get().Int64(), int64(42))
+}
+
+func TestSetBigInt(t *testing.T) {
+	nullableBigInt := nullable.NewBigInt(nil)
+	tests.AssertEqual(t, nullableBigInt.Get() == nil, true)
+
+	basic := bigIntFromString("123456789012345678901234567890")
+	nullableBigInt.Set(basic)
+	tests.AssertEqual(t, nullableBigInt.Get(), basic)
+
+	nullableBigInt.Set(nil)
+	tests.AssertEqual(t, nullableBigInt.Get() == nil, true)
+	tests.AssertEqual(t, nullableBigInt, nullable.NullBigInt())
+}
+
+func TestBigIntFromInt64(t *testing.T) {
+	tests.AssertEqual(t, nullable.BigIntFromInt64(42), nullable.NewBigInt(big.NewInt(42)))
+	tests.AssertEqual(t, nullable.BigIntFromInt64(42).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullBigInt(), nullable.NewBigInt(nil))
+	tests.AssertEqual(t, nullable.NullBigInt().IsNull(), true)
+}
+
+func TestCoalesceBigInt(t *testing.T) {
+	basic := nullable.BigIntFromInt64(42)
+	zero := nullable.BigIntFromInt64(0)
+	tests.AssertEqual(t, nullable.CoalesceBigInt(), nullable.BigInt{})
+	tests.AssertEqual(t, nullable.CoalesceBigInt(nullable.NullBigInt(), nullable.NullBigInt()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceBigInt(nullable.NullBigInt(), basic, zero), basic)
+	tests.AssertEqual(t, nullable.CoalesceBigInt(zero, basic), zero)
+}
+
+func TestSetValueBigInt(t *testing.T) {
+	var nullableBigInt nullable.BigInt
+	nullableBigInt.SetValue(big.NewInt(42))
+	tests.AssertEqual(t, nullableBigInt, nullable.BigIntFromInt64(42))
+
+	nullableBigInt.SetValue(nil)
+	tests.AssertEqual(t, nullableBigInt, nullable.NullBigInt())
+
+	nullableBigInt.SetValue(big.NewInt(42))
+	nullableBigInt.SetNull()
+	tests.AssertEqual(t, nullableBigInt, nullable.NullBigInt())
+}
+
+func TestZeroAsNullBigInt(t *testing.T) {
+	tests.AssertEqual(t, nullable.BigIntZeroAsNull(nil).IsNull(), true)
+	tests.AssertEqual(t, nullable.BigIntZeroAsNull(new(big.Int)).IsNull(), true)
+	tests.AssertEqual(t, nullable.BigIntZeroAsNull(big.NewInt(42)), nullable.BigIntFromInt64(42))
+
+	nullableBigInt := nullable.BigIntFromInt64(0)
+	nullableBigInt.NullIfZero()
+	tests.AssertEqual(t, nullableBigInt, nullable.NullBigInt())
+
+	nullableBigInt = nullable.BigIntFromInt64(42)
+	nullableBigInt.NullIfZero()
+	tests.AssertEqual(t, nullableBigInt, nullable.BigIntFromInt64(42))
+}
+
+func TestGetOrBigInt(t *testing.T) {
+	fallback := big.NewInt(7)
+	tests.AssertEqual(t, nullable.BigIntFromInt64(42).GetOr(fallback), big.NewInt(42))
+	tests.AssertEqual(t, nullable.NullBigInt().GetOr(fallback), fallback)
+	tests.AssertEqual(t, nullable.NullBigInt().GetOrZero().Sign(), 0)
+	tests.AssertEqual(t, nullable.BigIntFromInt64(42).MustGet(), big.NewInt(42))
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustGet on NULL big integer must panic")
+		}
+	}()
+	nullable.NullBigInt().MustGet()
+}
+
+func TestIsZeroBigInt(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullBigInt().IsZero(), true)
+	tests.AssertEqual(t, nullable.BigIntFromInt64(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.NewBigInt(bigIntFromString("123456789012345678901234567890")).IsZero(), false)
+}
+
+func TestCloneBigInt(t *testing.T) {
+	original := nullable.NewBigInt(bigIntFromString("123456789012345678901234567890"))
+	tests.AssertEqual(t, original.Clone().Equal(original), true)
+	tests.AssertEqual(t, nullable.NullBigInt().Clone(), nullable.NullBigInt())
+}
+
+func TestUnwrapBigInt(t *testing.T) {
+	value, ok := nullable.BigIntFromInt64(42).Unwrap()
+	tests.AssertEqual(t, value.Int64(), int64(42))
+	tests.AssertEqual(t, ok, true)
+
+	var zero nullable.BigInt
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value == nil, true)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseBigInt(t *testing.T) {
+	parsed, err := nullable.ParseBigInt("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.IsNull(), true)
+
+	parsed, err = nullable.ParseBigInt("-123456789012345678901234567890")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.String(), "-123456789012345678901234567890")
+
+	for _, text := range []string{"abc", "1.5", " 1"} {
+		if _, err := nullable.ParseBigInt(text); err == nil {
+			t.Errorf("parsing %q as big integer must fail", text)
+		}
+	}
+}
+
+func TestMustParseBigInt(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseBigInt("").IsNull(), true)
+	tests.AssertEqual(t, nullable.MustParseBigInt("123456789012345678901234567890").String(), "123456789012345678901234567890")
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseBigInt of malformed text must panic")
+		}
+	}()
+	nullable.MustParseBigInt("abc")
+}
+
+func TestPtrBigInt(t *testing.T) {
+	tests.AssertEqual(t, nullable.BigIntPtr(nullable.BigIntFromInt64(42)), big.NewInt(42))
+	tests.AssertEqual(t, nullable.BigIntPtr(nullable.NullBigInt()) == nil, true)
+	tests.AssertEqual(t, nullable.BigIntValue(nullable.BigIntFromInt64(42)), big.NewInt(42))
+	tests.AssertEqual(t, nullable.BigIntValue(nullable.NullBigInt()).Sign(), 0)
+}
+
+func TestTextBigInt(t *testing.T) {
+	marshalUnmarshalText(t, nullable.NewBigInt(bigIntFromString("123456789012345678901234567890")))
+
+	marshalUnmarshalText(t, nullable.NewBigInt(nil))
+}
+
+func TestCSVBigInt(t *testing.T) {
+	marshalUnmarshalCSV(t, nullable.NewBigInt(bigIntFromString("123456789012345678901234567890")))
+
+	marshalUnmarshalCSV(t, nullable.NullBigInt())
+}
+
+func TestYAMLBigInt(t *testing.T) {
+	marshalUnmarshalYAML(t, nullable.NewBigInt(bigIntFromString("123456789012345678901234567890")))
+
+	marshalUnmarshalYAML(t, nullable.NewBigInt(nil))
+}
+
+func TestMsgpackBigInt(t *testing.T) {
+	marshalUnmarshalMsgpack(t, nullable.NewBigInt(bigIntFromString("123456789012345678901234567890")))
+
+	marshalUnmarshalMsgpack(t, nullable.NewBigInt(nil))
+}
+
+func TestBSONBigInt(t *testing.T) {
+	marshalUnmarshalBSON(t, nullable.NewBigInt(bigIntFromString("123456789012345678901234567890")))
+
+	marshalUnmarshalBSON(t, nullable.NewBigInt(nil))
+
+	var unserialized nullable.BigInt
+	raw := rawBSONValue(t, int64(-42))
+	tests.AssertEqual(t, unserialized.UnmarshalBSONValue(raw.Type, raw.Value), nil)
+	tests.AssertEqual(t, unserialized, nullable.BigIntFromInt64(-42))
+}
+
+func TestGobBigInt(t *testing.T) {
+	marshalUnmarshalGob(t, nullable.NewBigInt(bigIntFromString("123456789012345678901234567890")))
+
+	marshalUnmarshalGob(t, nullable.BigIntFromInt64(0))
+
+	marshalUnmarshalGob(t, nullable.NewBigInt(nil))
+}
+
+func TestStringerBigInt(t *testing.T) {
+	nullableBigInt := nullable.NewBigInt(bigIntFromString("123456789012345678901234567890"))
+	tests.AssertEqual(t, nullableBigInt.String(), "123456789012345678901234567890")
+	tests.AssertEqual(t, fmt.Sprint(nullableBigInt), "123456789012345678901234567890")
+
+	nullableBigInt.Set(nil)
+	tests.AssertEqual(t, nullableBigInt.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableBigInt), "<null>")
+}
+
+func TestEqualBigInt(t *testing.T) {
+	basic := nullable.NewBigInt(bigIntFromString("123456789012345678901234567890"))
+	zero := nullable.BigIntFromInt64(0)
+	tests.AssertEqual(t, nullable.NullBigInt().Equal(nullable.NullBigInt()), true)
+	tests.AssertEqual(t, nullable.NullBigInt().Equal(basic), false)
+	tests.AssertEqual(t, basic.Equal(nullable.NullBigInt()), false)
+	tests.AssertEqual(t, basic.Equal(nullable.NewBigInt(bigIntFromString("123456789012345678901234567890"))), true)
+	tests.AssertEqual(t, basic.Equal(zero), false)
+	tests.AssertEqual(t, zero.Equal(nullable.NullBigInt()), false)
+}
+
+func TestCompareBigInt(t *testing.T) {
+	null := nullable.NullBigInt()
+	small := nullable.NewBigInt(bigIntFromString("-123456789012345678901234567890"))
+	large := nullable.NewBigInt(bigIntFromString("123456789012345678901234567890"))
+
+	tests.AssertEqual(t, null.Compare(nullable.NullBigInt()), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(large), -1)
+	tests.AssertEqual(t, large.Compare(small), 1)
+	tests.AssertEqual(t, large.Compare(nullable.NewBigInt(bigIntFromString("123456789012345678901234567890"))), 0)
+
+	values := []nullable.BigInt{large, null, small}
+	slices.SortFunc(values, nullable.BigInt.Compare)
+	tests.AssertEqual(t, values, []nullable.BigInt{null, small, large})
+}
+
+func TestXMLBigInt(t *testing.T) {
+	marshalUnmarshalXML(t, nullable.NewBigInt(bigIntFromString("123456789012345678901234567890")))
+
+	marshalUnmarshalXML(t, nullable.NewBigInt(nil))
+}
+
+func TestJSONBigInt(t *testing.T) {
+	basic := bigIntFromString("123456789012345678901234567890")
+	marshalUnmarshalJSON(t, nullable.NewBigInt(basic))
+
+	marshalUnmarshalJSON(t, nullable.NewBigInt(nil))
+
+	serialized, err := nullable.NewBigInt(basic).MarshalJSON()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "123456789012345678901234567890")
+
+	var unserialized nullable.BigInt
+	tests.AssertEqual(t, unserialized.UnmarshalJSON([]byte(`"123456789012345678901234567890"`)), nil)
+	tests.AssertEqual(t, unserialized.Get(), basic)
+
+	for _, invalid := range []string{`12.5`, `"twelve"`, `1e3`, `true`} {
+		if err := unserialized.UnmarshalJSON([]byte(invalid)); err == nil {
+			t.Errorf("unmarshalling %s into big integer must fail", invalid)
+		}
+	}
+}
+
+func TestBigInt(t *testing.T) {
+	type TestNullableBigInt struct {
+		ID      uint
+		Name    string
+		Balance nullable.BigInt
+	}
+
+	DB.Migrator().DropTable(&TestNullableBigInt{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableBigInt{}); err != nil {
+		t.Errorf("failed to migrate nullable big integer, got error: %v", err)
+	}
+
+	balance := bigIntFromString("123456789012345678901234567890")
+	rich := TestNullableBigInt{
+		Name:    "rich",
+		Balance: nullable.NewBigInt(balance),
+	}
+	DB.Create(&rich)
+
+	unknown := TestNullableBigInt{
+		Name:    "unknown",
+		Balance: nullable.NewBigInt(nil),
+	}
+	DB.Create(&unknown)
+
+	var result1 TestNullableBigInt
+	if err := DB.First(&result1, "name = ?", "rich").Error; err != nil {
+		t.Fatal("Cannot read big integer test record of \"rich\"")
+	}
+	tests.AssertEqual(t, result1.Balance.Get().Cmp(balance), 0)
+
+	var result2 TestNullableBigInt
+	if err := DB.First(&result2, "name = ?", "unknown").Error; err != nil {
+		t.Fatal("Cannot read big integer test record of \"unknown\"")
+	}
+	tests.AssertEqual(t, result2, unknown)
+}
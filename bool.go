@@ -1,69 +1,443 @@
 package nullable
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
 
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 )
 
 // Bool SQL type that can retrieve NULL value
 type Bool struct {
-	realValue bool
-	isValid   bool
+	core[bool]
 }
 
 // NewBool creates a new nullable boolean
 func NewBool(value *bool) Bool {
-	if value == nil {
-		return Bool{
-			realValue: false,
-			isValid:   false,
+	return Bool{core: newCore(value)}
+}
+
+// BoolFrom creates a new valid nullable boolean from value
+func BoolFrom(value bool) Bool {
+	return NewBool(&value)
+}
+
+// NullBool creates a new NULL boolean
+func NullBool() Bool {
+	return NewBool(nil)
+}
+
+// BoolZeroAsNull creates a new nullable boolean that is NULL when value is the zero value
+func BoolZeroAsNull(value bool) Bool {
+	if value == false {
+		return NullBool()
+	}
+	return BoolFrom(value)
+}
+
+// BoolFromSQL creates a new nullable boolean from sql.NullBool
+func BoolFromSQL(value sql.NullBool) Bool {
+	if !value.Valid {
+		return NullBool()
+	}
+	return BoolFrom(value.Bool)
+}
+
+// CoalesceBool returns the first valid value, or NULL when all of them are NULL
+func CoalesceBool(values ...Bool) Bool {
+	for _, value := range values {
+		if value.isValid {
+			return value
 		}
 	}
-	return Bool{
-		realValue: *value,
-		isValid:   true,
+	return Bool{}
+}
+
+// BoolPtr returns n.Get(), handy where a function value is needed
+func BoolPtr(n Bool) *bool {
+	return n.Get()
+}
+
+// BoolValue returns n.GetOrZero(), zero value when NULL
+func BoolValue(n Bool) bool {
+	return n.GetOrZero()
+}
+
+// ParseBool parses text with the spellings Scan accepts, ignoring case and
+// surrounding spaces, empty text is NULL
+func ParseBool(text string) (Bool, error) {
+	if len(text) == 0 {
+		return NullBool(), nil
+	}
+	parsed, err := parseScannedBool(text)
+	if err != nil {
+		return Bool{}, err
+	}
+	return BoolFrom(parsed), nil
+}
+
+// MustParseBool is ParseBool that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseBool(text string) Bool {
+	n, err := ParseBool(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseBool(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL Bool, the one a declared
+// Bool starts with, so pooled values can be reused
+func (n *Bool) Reset() {
+	*n = Bool{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Bool) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
 	}
+	*n = scanned
+	return nil
 }
 
-// Get either nil or boolean
-func (n Bool) Get() *bool {
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Bool) NullIfZero() {
+	if n.isValid && n.realValue == false {
+		n.SetNull()
+	}
+}
+
+// MustGet either boolean or panic when NULL
+func (n Bool) MustGet() bool {
+	return n.mustGet("Bool")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Bool) Clone() Bool {
+	return n
+}
+
+// String returns boolean in its natural text form, or "<null>" when NULL
+func (n Bool) String() string {
 	if !n.isValid {
-		return nil
+		return nullString
 	}
-	return &n.realValue
+	return strconv.FormatBool(n.realValue)
 }
 
-// Set either nil or boolean
-func (n *Bool) Set(value *bool) {
-	n.isValid = (value != nil)
-	if n.isValid {
-		n.realValue = *value
-	} else {
-		n.realValue = false
+// DebugString returns Bool(value) or Bool(NULL), telling NULL apart from a
+// zero value in logs
+func (n Bool) DebugString() string {
+	return debugString("Bool", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same boolean
+func (n Bool) Equal(other Bool) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Bool) Changed(old Bool) bool {
+	return !n.Equal(old)
+}
+
+// And follows SQL three-valued logic: false when either side is false, NULL
+// when the other side is true or NULL, true when both are true
+func (n Bool) And(other Bool) Bool {
+	if (n.isValid && !n.realValue) || (other.isValid && !other.realValue) {
+		return BoolFrom(false)
+	}
+	if !n.isValid || !other.isValid {
+		return NullBool()
+	}
+	return BoolFrom(true)
+}
+
+// Or follows SQL three-valued logic: true when either side is true, NULL
+// when the other side is false or NULL, false when both are false
+func (n Bool) Or(other Bool) Bool {
+	if (n.isValid && n.realValue) || (other.isValid && other.realValue) {
+		return BoolFrom(true)
+	}
+	if !n.isValid || !other.isValid {
+		return NullBool()
+	}
+	return BoolFrom(false)
+}
+
+// Not follows SQL three-valued logic, NOT NULL is NULL
+func (n Bool) Not() Bool {
+	if !n.isValid {
+		return NullBool()
 	}
+	return BoolFrom(!n.realValue)
+}
+
+// ToSQL converts current value to sql.NullBool
+func (n Bool) ToSQL() sql.NullBool {
+	return sql.NullBool{Bool: n.realValue, Valid: n.isValid}
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL one byte, telling NULL apart from zero in cache keys
+func (n Bool) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagBool, n.isValid, []byte{boolByte(n.realValue)})
 }
 
 // MarshalJSON converts current value to JSON
 func (n Bool) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return strconv.AppendBool(make([]byte, 0, 5), n.realValue), nil
 }
 
-// UnmarshalJSON writes JSON to this type
+// UnmarshalJSON writes JSON to this type, taking true and false as well as
+// 1, 0, and quoted spellings such as "true" that other systems send
 func (n *Bool) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
 		n.isValid = false
 		n.realValue = false
 		return nil
 	}
 
+	parsed, err := unmarshalJSONBool(data)
+	if err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// unmarshalJSONBool reads JSON true and false, the numbers 1 and 0, or a
+// string with one of the spellings Scan accepts, such as "true" or "0"
+func unmarshalJSONBool(data []byte) (bool, error) {
+	switch trimmed := strings.TrimSpace(string(data)); {
+	case trimmed == "1":
+		return true, nil
+	case trimmed == "0":
+		return false, nil
+	case strings.HasPrefix(trimmed, `"`):
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return false, err
+		}
+		return parseScannedBool(text)
+	}
+
 	var parsed bool
 	if err := json.Unmarshal(data, &parsed); err != nil {
+		return false, err
+	}
+	return parsed, nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Bool) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendBool(nil, n.realValue), nil
+}
+
+// UnmarshalText writes text to this type with the spellings Scan accepts,
+// empty text is NULL
+func (n *Bool) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = false
+		return nil
+	}
+
+	parsed, err := parseScannedBool(string(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Bool) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Bool) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Bool) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return strconv.FormatBool(n.realValue), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Bool) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Bool) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = false
+		return nil
+	}
+
+	var parsed bool
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n Bool) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue, start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Bool) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = false
+		return nil
+	}
+
+	parsed, err := strconv.ParseBool(strings.TrimSpace(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Bool) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Bool) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = false
+		return nil
+	}
+
+	var parsed bool
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Bool) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Bool) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = false
+		return nil
+	}
+
+	var parsed bool
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Bool) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Bool) GobDecode(data []byte) error {
+	var parsed bool
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
 		return err
 	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = false
+		return nil
+	}
 
 	n.isValid = true
 	n.realValue = parsed
@@ -72,12 +446,40 @@ func (n *Bool) UnmarshalJSON(data []byte) error {
 
 // Scan implements scanner interface
 func (n *Bool) Scan(value interface{}) error {
+	value = scanValue(value)
 	if value == nil {
 		n.realValue, n.isValid = false, false
 		return nil
 	}
+
+	var scanned bool
+	switch value := plainValue(value).(type) {
+	case bool:
+		scanned = value
+	case int64:
+		// MySQL tinyint(1) and SQLite integer, any non-zero is true
+		scanned = value != 0
+	case []byte:
+		parsed, err := parseScannedBool(string(value))
+		if err != nil {
+			return scanError(err)
+		}
+		scanned = parsed
+	case string:
+		parsed, err := parseScannedBool(value)
+		if err != nil {
+			return scanError(err)
+		}
+		scanned = parsed
+	default:
+		if err := convertAssign(&scanned, value); err != nil {
+			return scanError(err)
+		}
+	}
+	n.realValue = scanned
+
 	n.isValid = true
-	return convertAssign(&n.realValue, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -94,12 +496,39 @@ func (Bool) GormDataType() string {
 }
 
 // GormDBDataType gorm db data type
-func (Bool) GormDBDataType(db *gorm.DB, field *schema.Field) string {
-	switch db.Dialector.Name() {
+func (n Bool) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Bool) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Bool) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
 	case "sqlite", "mysql":
 		return "BOOLEAN"
-	case "postgres":
+	case "postgres", "cockroachdb":
 		return "boolean"
+	case "sqlserver":
+		return "BIT"
+	case "clickhouse":
+		return "Nullable(Bool)"
 	}
 	return ""
 }
+
+// parseScannedBool reads the spellings of true and false used by MySQL,
+// PostgreSQL, and SQLite, ignoring case and surrounding spaces
+func parseScannedBool(text string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(text)) {
+	case "1", "t", "true", "y", "yes", "on":
+		return true, nil
+	case "0", "f", "false", "n", "no", "off":
+		return false, nil
+	}
+	return false, fmt.Errorf("nullable: invalid Bool %q, expected one of 1/0, t/f, true/false, y/n, yes/no, or on/off", text)
+}
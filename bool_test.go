@@ -1,6 +1,9 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"gorm.io/gorm/utils/tests"
@@ -21,6 +24,54 @@ func TestScanBool(t *testing.T) {
 	tests.AssertEqual(t, nullableBool.Get(), nil)
 }
 
+func TestScanRepresentationsBool(t *testing.T) {
+	cases := []struct {
+		value    interface{}
+		expected bool
+	}{
+		{true, true},
+		{false, false},
+		{int64(1), true},
+		{int64(0), false},
+		{int64(-1), true},
+		{"1", true},
+		{"0", false},
+		{"t", true},
+		{"f", false},
+		{"true", true},
+		{"false", false},
+		{"TRUE", true},
+		{"False", false},
+		{"Y", true},
+		{"n", false},
+		{"yes", true},
+		{"no", false},
+		{"on", true},
+		{"off", false},
+		{" t ", true},
+		{[]byte("1"), true},
+		{[]byte("0"), false},
+		{[]byte("t"), true},
+		{[]byte("f"), false},
+	}
+	for _, c := range cases {
+		nullableBool := nullable.NewBool(nil)
+		if err := nullableBool.Scan(c.value); err != nil {
+			t.Errorf("scanning %#v into Bool failed: %v", c.value, err)
+			continue
+		}
+		tests.AssertEqual(t, nullableBool.Get(), c.expected)
+	}
+
+	for _, invalid := range []interface{}{"", "2", "maybe", []byte("yess"), 1.5} {
+		nullableBool := nullable.NewBool(nil)
+		if err := nullableBool.Scan(invalid); err == nil {
+			t.Errorf("scanning %#v into Bool must fail", invalid)
+		}
+		tests.AssertEqual(t, nullableBool.IsNull(), true)
+	}
+}
+
 func TestNewBool(t *testing.T) {
 	basicBool1 := true
 	nullableBool1 := nullable.NewBool(&basicBool1)
@@ -50,6 +101,313 @@ func TestSetBool(t *testing.T) {
 	tests.AssertEqual(t, nullableBool.Get(), nil)
 }
 
+func TestBoolFrom(t *testing.T) {
+	var basic bool = true
+	tests.AssertEqual(t, nullable.BoolFrom(basic), nullable.NewBool(&basic))
+	tests.AssertEqual(t, nullable.BoolFrom(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullBool(), nullable.NewBool(nil))
+	tests.AssertEqual(t, nullable.NullBool().IsNull(), true)
+}
+
+func TestCoalesceBool(t *testing.T) {
+	var basic bool = true
+	var zero bool = false
+	tests.AssertEqual(t, nullable.CoalesceBool(), nullable.Bool{})
+	tests.AssertEqual(t, nullable.CoalesceBool(nullable.NullBool(), nullable.NullBool()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceBool(nullable.NullBool(), nullable.BoolFrom(basic), nullable.BoolFrom(zero)), nullable.BoolFrom(basic))
+	tests.AssertEqual(t, nullable.CoalesceBool(nullable.BoolFrom(zero), nullable.BoolFrom(basic)), nullable.BoolFrom(zero))
+}
+
+func TestIsZeroBool(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullBool().IsZero(), true)
+	tests.AssertEqual(t, nullable.BoolFrom(false).IsZero(), false)
+	tests.AssertEqual(t, nullable.BoolFrom(true).IsZero(), false)
+}
+
+func TestCloneBool(t *testing.T) {
+	tests.AssertEqual(t, nullable.BoolFrom(true).Clone(), nullable.BoolFrom(true))
+	tests.AssertEqual(t, nullable.NullBool().Clone(), nullable.NullBool())
+}
+
+func TestUnwrapBool(t *testing.T) {
+	var basic bool = true
+	value, ok := nullable.BoolFrom(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullBool().Unwrap()
+	tests.AssertEqual(t, value, false)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Bool
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, false)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseBool(t *testing.T) {
+	parsed, err := nullable.ParseBool("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullBool())
+
+	text, err := nullable.BoolFrom(true).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseBool(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.BoolFrom(true)), true)
+
+	for _, text := range []string{"abc", "2"} {
+		if _, err := nullable.ParseBool(text); err == nil {
+			t.Errorf("parsing %q as boolean must fail", text)
+		}
+	}
+}
+
+func TestParseSpellingsBool(t *testing.T) {
+	for text, expected := range map[string]bool{"1": true, "YES": true, " on ": true, "t": true, "0": false, "No": false, "off": false, "F": false} {
+		parsed, err := nullable.ParseBool(text)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, parsed, nullable.BoolFrom(expected))
+	}
+}
+
+func TestMustParseBool(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseBool(""), nullable.NullBool())
+
+	text, err := nullable.BoolFrom(true).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseBool(string(text)).Equal(nullable.BoolFrom(true)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseBool of malformed text must panic")
+		}
+	}()
+	nullable.MustParseBool("abc")
+}
+
+func TestPtrBool(t *testing.T) {
+	var basic bool = true
+	tests.AssertEqual(t, nullable.BoolPtr(nullable.NewBool(&basic)), basic)
+	tests.AssertEqual(t, nullable.BoolPtr(nullable.NewBool(nil)), nil)
+	tests.AssertEqual(t, nullable.BoolValue(nullable.NewBool(&basic)), basic)
+	tests.AssertEqual(t, nullable.BoolValue(nullable.NewBool(nil)), false)
+}
+
+func TestSetFromInterfaceBool(t *testing.T) {
+	nullableBool := nullable.NullBool()
+	tests.AssertEqual(t, nullableBool.SetFromInterface(true), nil)
+	tests.AssertEqual(t, nullableBool, nullable.BoolFrom(true))
+	tests.AssertEqual(t, nullableBool.SetFromInterface("off"), nil)
+	tests.AssertEqual(t, nullableBool, nullable.BoolFrom(false))
+	tests.AssertEqual(t, nullableBool.SetFromInterface(1), nil)
+	tests.AssertEqual(t, nullableBool, nullable.BoolFrom(true))
+
+	if err := nullableBool.SetFromInterface("maybe"); err == nil {
+		t.Error("setting maybe as Bool must fail")
+	}
+	tests.AssertEqual(t, nullableBool, nullable.BoolFrom(true))
+
+	tests.AssertEqual(t, nullableBool.SetFromInterface(nil), nil)
+	tests.AssertEqual(t, nullableBool.IsNull(), true)
+}
+
+func TestSetValueBool(t *testing.T) {
+	var basic bool = true
+	var nullableBool nullable.Bool
+	nullableBool.SetValue(basic)
+	tests.AssertEqual(t, nullableBool, nullable.BoolFrom(basic))
+
+	nullableBool.SetNull()
+	tests.AssertEqual(t, nullableBool, nullable.NullBool())
+}
+
+func TestZeroAsNullBool(t *testing.T) {
+	var basic bool = true
+	var zero bool = false
+	tests.AssertEqual(t, nullable.BoolZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.BoolZeroAsNull(basic), nullable.BoolFrom(basic))
+
+	nullableBool := nullable.BoolFrom(zero)
+	nullableBool.NullIfZero()
+	tests.AssertEqual(t, nullableBool, nullable.NullBool())
+
+	nullableBool = nullable.BoolFrom(basic)
+	nullableBool.NullIfZero()
+	tests.AssertEqual(t, nullableBool, nullable.BoolFrom(basic))
+}
+
+func TestBoolFromSQL(t *testing.T) {
+	var basic bool = true
+	tests.AssertEqual(t, nullable.BoolFromSQL(sql.NullBool{Bool: basic, Valid: true}), nullable.BoolFrom(basic))
+	tests.AssertEqual(t, nullable.BoolFromSQL(sql.NullBool{Bool: basic}), nullable.NullBool())
+
+	tests.AssertEqual(t, nullable.BoolFrom(basic).ToSQL(), sql.NullBool{Bool: basic, Valid: true})
+	tests.AssertEqual(t, nullable.NullBool().ToSQL(), sql.NullBool{})
+}
+
+func TestIsNullBool(t *testing.T) {
+	var zero nullable.Bool
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic bool = true
+	nullableBool := nullable.NewBool(&basic)
+	tests.AssertEqual(t, nullableBool.IsNull(), false)
+	tests.AssertEqual(t, nullableBool.IsValid(), true)
+
+	nullableBool.Set(nil)
+	tests.AssertEqual(t, nullableBool.IsNull(), true)
+	tests.AssertEqual(t, nullableBool.IsValid(), false)
+}
+
+func TestGetOrBool(t *testing.T) {
+	var zero nullable.Bool
+	var fallback bool = true
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), false)
+
+	var basic bool = true
+	nullableBool := nullable.NewBool(&basic)
+	tests.AssertEqual(t, nullableBool.GetOr(false), basic)
+	tests.AssertEqual(t, nullableBool.GetOrZero(), basic)
+}
+
+func TestMustGetBool(t *testing.T) {
+	var basic bool = true
+	nullableBool := nullable.NewBool(&basic)
+	tests.AssertEqual(t, nullableBool.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Bool")
+	}()
+	nullableBool.Set(nil)
+	nullableBool.MustGet()
+	t.Error("MustGet on NULL Bool must panic")
+}
+
+func TestTextBool(t *testing.T) {
+	var basic bool = true
+	marshalUnmarshalText(t, nullable.NewBool(&basic))
+
+	marshalUnmarshalText(t, nullable.NewBool(nil))
+}
+
+func TestCSVBool(t *testing.T) {
+	var basic bool = true
+	marshalUnmarshalCSV(t, nullable.NewBool(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewBool(nil))
+
+	// Cells take the spellings Scan and ParseBool accept
+	for cell, expected := range map[string]bool{"yes": true, "No": false, " on ": true, "f": false} {
+		var unserialized nullable.Bool
+		tests.AssertEqual(t, unserialized.UnmarshalCSV(cell), nil)
+		tests.AssertEqual(t, unserialized, nullable.BoolFrom(expected))
+	}
+
+	var unserialized nullable.Bool
+	if err := unserialized.UnmarshalCSV("maybe"); err == nil {
+		t.Error("unmarshalling CSV cell \"maybe\" must fail")
+	}
+}
+
+func TestYAMLBool(t *testing.T) {
+	var basic bool = true
+	marshalUnmarshalYAML(t, nullable.NewBool(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewBool(nil))
+}
+
+func TestMsgpackBool(t *testing.T) {
+	var basic bool = true
+	marshalUnmarshalMsgpack(t, nullable.NewBool(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewBool(nil))
+}
+
+func TestBSONBool(t *testing.T) {
+	var basic bool = true
+	marshalUnmarshalBSON(t, nullable.NewBool(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewBool(nil))
+}
+
+func TestGobBool(t *testing.T) {
+	var basic bool = true
+	marshalUnmarshalGob(t, nullable.NewBool(&basic))
+
+	var zero bool = false
+	marshalUnmarshalGob(t, nullable.NewBool(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewBool(nil))
+}
+
+func TestStringerBool(t *testing.T) {
+	var basic bool = true
+	nullableBool := nullable.NewBool(&basic)
+	tests.AssertEqual(t, nullableBool.String(), "true")
+	tests.AssertEqual(t, fmt.Sprint(nullableBool), "true")
+
+	nullableBool.Set(nil)
+	tests.AssertEqual(t, nullableBool.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableBool), "<null>")
+}
+
+func TestChangedBool(t *testing.T) {
+	var basic bool = true
+	value, null := nullable.NewBool(&basic), nullable.NewBool(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.BoolFrom(false)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewBool(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewBool(nil)), false)
+}
+
+func TestAndOrBool(t *testing.T) {
+	yes, no, null := nullable.BoolFrom(true), nullable.BoolFrom(false), nullable.NullBool()
+	cases := []struct {
+		left, right nullable.Bool
+		and, or     nullable.Bool
+	}{
+		{yes, yes, yes, yes},
+		{yes, no, no, yes},
+		{yes, null, null, yes},
+		{no, yes, no, yes},
+		{no, no, no, no},
+		{no, null, no, null},
+		{null, yes, null, yes},
+		{null, no, no, null},
+		{null, null, null, null},
+	}
+	for _, c := range cases {
+		tests.AssertEqual(t, c.left.And(c.right), c.and)
+		tests.AssertEqual(t, c.left.Or(c.right), c.or)
+	}
+
+	tests.AssertEqual(t, yes.Not(), no)
+	tests.AssertEqual(t, no.Not(), yes)
+	tests.AssertEqual(t, null.Not(), null)
+}
+
+func TestEqualBool(t *testing.T) {
+	var basic bool = true
+	var zero bool = false
+	tests.AssertEqual(t, nullable.NewBool(nil).Equal(nullable.NewBool(nil)), true)
+	tests.AssertEqual(t, nullable.NewBool(nil).Equal(nullable.NewBool(&basic)), false)
+	tests.AssertEqual(t, nullable.NewBool(&basic).Equal(nullable.NewBool(nil)), false)
+	tests.AssertEqual(t, nullable.NewBool(&basic).Equal(nullable.NewBool(&basic)), true)
+	tests.AssertEqual(t, nullable.NewBool(&basic).Equal(nullable.NewBool(&zero)), false)
+	tests.AssertEqual(t, nullable.NewBool(&zero).Equal(nullable.NewBool(nil)), false)
+}
+
+func TestXMLBool(t *testing.T) {
+	var basic bool = true
+	marshalUnmarshalXML(t, nullable.NewBool(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewBool(nil))
+}
+
 func TestJSONBool(t *testing.T) {
 	trueBool := true
 	marshalUnmarshalJSON(t, nullable.NewBool(&trueBool))
@@ -60,6 +418,70 @@ func TestJSONBool(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewBool(nil))
 }
 
+func TestUnmarshalJSONLenientBool(t *testing.T) {
+	cases := []struct {
+		data     string
+		expected nullable.Bool
+	}{
+		{`true`, nullable.BoolFrom(true)},
+		{`false`, nullable.BoolFrom(false)},
+		{`1`, nullable.BoolFrom(true)},
+		{`0`, nullable.BoolFrom(false)},
+		{` 1 `, nullable.BoolFrom(true)},
+		{`"true"`, nullable.BoolFrom(true)},
+		{`"false"`, nullable.BoolFrom(false)},
+		{`"TRUE"`, nullable.BoolFrom(true)},
+		{`"1"`, nullable.BoolFrom(true)},
+		{`"0"`, nullable.BoolFrom(false)},
+		{`"yes"`, nullable.BoolFrom(true)},
+		{`"off"`, nullable.BoolFrom(false)},
+		{`null`, nullable.NullBool()},
+	}
+	for _, c := range cases {
+		unserialized := nullable.BoolFrom(true)
+		tests.AssertEqual(t, json.Unmarshal([]byte(c.data), &unserialized), nil)
+		tests.AssertEqual(t, unserialized, c.expected)
+	}
+
+	for _, data := range []string{`2`, `-1`, `1.0`, `""`, `"null"`, `"maybe"`, `[true]`, `{}`} {
+		var unserialized nullable.Bool
+		if err := json.Unmarshal([]byte(data), &unserialized); err == nil {
+			t.Errorf("unmarshalling %s as Bool must fail", data)
+		}
+	}
+}
+
+func TestMarshalJSONCompatibleBool(t *testing.T) {
+	for _, basic := range []bool{true, false} {
+		expected, err := json.Marshal(&basic)
+		tests.AssertEqual(t, err, nil)
+		serialized, err := json.Marshal(nullable.NewBool(&basic))
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(serialized), string(expected))
+	}
+
+	serialized, err := json.Marshal(nullable.NewBool(nil))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "null")
+}
+
+func BenchmarkMarshalJSONBool(b *testing.B) {
+	var basic bool = true
+	nullableBool := nullable.NewBool(&basic)
+	b.Run("nullable", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			jsonSink, _ = nullableBool.MarshalJSON()
+		}
+	})
+	b.Run("json.Marshal of Get", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			jsonSink, _ = json.Marshal(nullableBool.Get())
+		}
+	})
+}
+
 func TestBool(t *testing.T) {
 	type TestNullableBool struct {
 		ID      uint
@@ -0,0 +1,23 @@
+package nullable
+
+import (
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// isBSONNull reports whether t is BSON null or the deprecated undefined
+func isBSONNull(t bsontype.Type) bool {
+	return t == bson.TypeNull || t == bson.TypeUndefined
+}
+
+// unmarshalBSONValue decodes single BSON value into target with the default codecs
+func unmarshalBSONValue(t bsontype.Type, data []byte, target interface{}) error {
+	return bson.RawValue{Type: t, Value: data}.Unmarshal(target)
+}
+
+// bsonTypeError is returned when BSON value has no sensible conversion
+func bsonTypeError(t bsontype.Type, typeName string) error {
+	return fmt.Errorf("nullable: cannot unmarshal BSON %s into %s", t, typeName)
+}
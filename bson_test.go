@@ -0,0 +1,79 @@
+package nullable_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/tee8z/nullable"
+	"go.mongodb.org/mongo-driver/bson"
+	"gorm.io/gorm/utils/tests"
+)
+
+type bsonEnvelope[T any] struct {
+	Value T `bson:"value"`
+}
+
+func marshalUnmarshalBSON[T any](t *testing.T, target T) {
+	serialized, err := bson.Marshal(bsonEnvelope[T]{Value: target})
+	if err != nil {
+		t.Fatalf("Failed to marshal %T because: %s", target, err)
+		return
+	}
+
+	var unserialized bsonEnvelope[T]
+	if err := bson.Unmarshal(serialized, &unserialized); err != nil {
+		t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+		return
+	}
+	tests.AssertEqual(t, unserialized.Value, target)
+}
+
+func TestBSONNative(t *testing.T) {
+	cases := []struct {
+		value    interface{}
+		expected bson.RawValue
+	}{
+		{bsonEnvelope[nullable.Int64]{Value: nullable.NullInt64()}, bson.RawValue{Type: bson.TypeNull}},
+		{bsonEnvelope[nullable.Int64]{Value: nullable.Int64From(-42)}, rawBSONValue(t, int64(-42))},
+		{bsonEnvelope[nullable.Uint64]{Value: nullable.Uint64From(42)}, rawBSONValue(t, int64(42))},
+		{bsonEnvelope[nullable.Uint64]{Value: nullable.Uint64From(math.MaxUint64)}, rawBSONValue(t, "18446744073709551615")},
+		{bsonEnvelope[nullable.Bool]{Value: nullable.BoolFrom(true)}, rawBSONValue(t, true)},
+		{bsonEnvelope[nullable.String]{Value: nullable.StringFrom("Hello World!")}, rawBSONValue(t, "Hello World!")},
+		{bsonEnvelope[nullable.Float64]{Value: nullable.Float64From(3.14)}, rawBSONValue(t, 3.14)},
+		{bsonEnvelope[nullable.Time]{Value: nullable.TimeFrom(time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC))}, rawBSONValue(t, time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC))},
+	}
+	for _, c := range cases {
+		serialized, err := bson.Marshal(c.value)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, bson.Raw(serialized).Lookup("value"), c.expected)
+	}
+
+	// BSON null must reset a valid value
+	serialized, err := bson.Marshal(bson.M{"value": nil})
+	tests.AssertEqual(t, err, nil)
+	unserialized := bsonEnvelope[nullable.Int64]{Value: nullable.Int64From(42)}
+	tests.AssertEqual(t, bson.Unmarshal(serialized, &unserialized), nil)
+	tests.AssertEqual(t, unserialized.Value.IsNull(), true)
+
+	serialized, err = bson.Marshal(bson.M{"value": "not a number"})
+	tests.AssertEqual(t, err, nil)
+	var malformed bsonEnvelope[nullable.Uint64]
+	if err := bson.Unmarshal(serialized, &malformed); err == nil {
+		t.Error("unmarshalling malformed number must fail")
+	}
+}
+
+func TestBSONNullable(t *testing.T) {
+	marshalUnmarshalBSON(t, nullable.NullableFrom(int32(-1234567)))
+	marshalUnmarshalBSON(t, nullable.NullableFrom([]string{"a", "b"}))
+	marshalUnmarshalBSON(t, nullable.Null[string]())
+}
+
+func rawBSONValue(t *testing.T, value interface{}) bson.RawValue {
+	valueType, data, err := bson.MarshalValue(value)
+	if err != nil {
+		t.Fatalf("Failed to marshal %T because: %s", value, err)
+	}
+	return bson.RawValue{Type: valueType, Value: data}
+}
@@ -1,49 +1,214 @@
 package nullable
 
 import (
+	"cmp"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
 
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 )
 
 // Byte SQL type that can retrieve NULL value
 type Byte struct {
-	realValue byte
-	isValid   bool
+	core[byte]
 }
 
 // NewByte creates a new nullable single byte
 func NewByte(value *byte) Byte {
-	if value == nil {
-		return Byte{
-			realValue: 0,
-			isValid:   false,
+	return Byte{core: newCore(value)}
+}
+
+// ByteFrom creates a new valid nullable single byte from value
+func ByteFrom(value byte) Byte {
+	return NewByte(&value)
+}
+
+// NullByte creates a new NULL single byte
+func NullByte() Byte {
+	return NewByte(nil)
+}
+
+// ByteZeroAsNull creates a new nullable single byte that is NULL when value is the zero value
+func ByteZeroAsNull(value byte) Byte {
+	if value == 0 {
+		return NullByte()
+	}
+	return ByteFrom(value)
+}
+
+// ByteFromSQL creates a new nullable single byte from sql.NullByte
+func ByteFromSQL(value sql.NullByte) Byte {
+	if !value.Valid {
+		return NullByte()
+	}
+	return ByteFrom(value.Byte)
+}
+
+// CoalesceByte returns the first valid value, or NULL when all of them are NULL
+func CoalesceByte(values ...Byte) Byte {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return Byte{}
+}
+
+// MinByte returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinByte(values ...Byte) Byte {
+	var result Byte
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
 		}
 	}
-	return Byte{
-		realValue: *value,
-		isValid:   true,
+	return result
+}
+
+// MaxByte returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxByte(values ...Byte) Byte {
+	var result Byte
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// BytePtr returns n.Get(), handy where a function value is needed
+func BytePtr(n Byte) *byte {
+	return n.Get()
+}
+
+// ByteValue returns n.GetOrZero(), zero value when NULL
+func ByteValue(n Byte) byte {
+	return n.GetOrZero()
+}
+
+// ParseByte parses text like UnmarshalText does, empty text is NULL
+func ParseByte(text string) (Byte, error) {
+	var n Byte
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Byte{}, err
+	}
+	return n, nil
+}
+
+// MustParseByte is ParseByte that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseByte(text string) Byte {
+	n, err := ParseByte(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseByte(%q): %v", text, err))
 	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL Byte, the one a declared
+// Byte starts with, so pooled values can be reused
+func (n *Byte) Reset() {
+	*n = Byte{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Byte) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Byte) NullIfZero() {
+	if n.isValid && n.realValue == 0 {
+		n.SetNull()
+	}
+}
+
+// MustGet either single byte or panic when NULL
+func (n Byte) MustGet() byte {
+	return n.mustGet("Byte")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Byte) Clone() Byte {
+	return n
 }
 
-// Get either nil or single byte
-func (n Byte) Get() *byte {
+// String returns single byte in its natural text form, or "<null>" when NULL
+func (n Byte) String() string {
 	if !n.isValid {
-		return nil
+		return nullString
 	}
-	return &n.realValue
+	return strconv.FormatUint(uint64(n.realValue), 10)
 }
 
-// Set either nil or single byte
-func (n *Byte) Set(value *byte) {
-	n.isValid = (value != nil)
-	if n.isValid {
-		n.realValue = *value
-	} else {
-		n.realValue = 0
+// DebugString returns Byte(value) or Byte(NULL), telling NULL apart from a
+// zero value in logs
+func (n Byte) DebugString() string {
+	return debugString("Byte", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same single byte
+func (n Byte) Equal(other Byte) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Byte) Changed(old Byte) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any single byte.
+func (n Byte) Compare(other Byte) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
 	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// ToSQL converts current value to sql.NullByte
+func (n Byte) ToSQL() sql.NullByte {
+	return sql.NullByte{Byte: n.realValue, Valid: n.isValid}
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL one byte, telling NULL apart from zero in cache keys
+func (n Byte) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagByte, n.isValid, []byte{n.realValue})
 }
 
 // MarshalJSON converts current value to JSON
@@ -53,8 +218,11 @@ func (n Byte) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON writes JSON to this type
 func (n *Byte) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
 		n.isValid = false
 		n.realValue = 0
 		return nil
@@ -62,6 +230,138 @@ func (n *Byte) UnmarshalJSON(data []byte) error {
 
 	var parsed byte
 	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Byte) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendUint(nil, uint64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Byte) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseUint(string(text), 10, 8)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = byte(parsed)
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Byte) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Byte) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Byte) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return strconv.FormatUint(uint64(n.realValue), 10), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Byte) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Byte) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed byte
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n Byte) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue, start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Byte) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseUint(strings.TrimSpace(text), 10, 8)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = byte(parsed)
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Byte) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Byte) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed byte
+	if err := dec.Decode(&parsed); err != nil {
 		return err
 	}
 
@@ -70,20 +370,79 @@ func (n *Byte) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Byte) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Byte) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed byte
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Byte) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Byte) GobDecode(data []byte) error {
+	var parsed byte
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
 // Scan implements scanner interface
 func (n *Byte) Scan(value interface{}) error {
+	value = scanValue(value)
 	if value == nil {
 		n.realValue, n.isValid = 0, false
 		return nil
 	}
 
+	if floatValue, ok := value.(float64); ok {
+		parsed, err := scanFloatUint(floatValue, 8, "byte")
+		if err != nil {
+			return scanError(err)
+		}
+		n.realValue, n.isValid = byte(parsed), true
+		return nil
+	}
+
 	switch value.(type) {
 	case int:
 		n.realValue = byte(value.(int))
 	default:
 		var buffer []byte
 		if err := convertAssign(&buffer, value); err != nil {
-			return err
+			return scanError(err)
 		}
 		n.realValue = buffer[0]
 	}
@@ -108,14 +467,29 @@ func (Byte) GormDataType() string {
 }
 
 // GormDBDataType gorm db data type
-func (Byte) GormDBDataType(db *gorm.DB, field *schema.Field) string {
-	switch db.Dialector.Name() {
+func (n Byte) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Byte) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Byte) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
 	case "sqlite":
 		return "TINYINT UNSIGNED"
 	case "mysql":
 		return "BINARY"
-	case "postgres":
+	case "postgres", "cockroachdb":
 		return "bytea"
+	case "sqlserver":
+		return "BINARY(1)"
+	case "clickhouse":
+		return "Nullable(FixedString(1))"
 	}
 	return ""
 }
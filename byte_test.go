@@ -1,6 +1,9 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/tee8z/nullable"
@@ -23,6 +26,19 @@ func TestScanByte(t *testing.T) {
 	tests.AssertEqual(t, nullableByte.Get(), nil)
 }
 
+func TestScanFloatByte(t *testing.T) {
+	var scanned nullable.Byte
+	tests.AssertEqual(t, scanned.Scan(float64(42)), nil)
+	tests.AssertEqual(t, scanned, nullable.ByteFrom(42))
+
+	if err := scanned.Scan(float64(42.5)); err == nil {
+		t.Error("scanning float64 with fractional part into Byte must fail")
+	}
+	if err := scanned.Scan(float64(256)); err == nil {
+		t.Error("scanning overflowing float64 into Byte must fail")
+	}
+}
+
 func TestNewByte(t *testing.T) {
 	basicByte1 := byte(0)
 	nullableByte1 := nullable.NewByte(&basicByte1)
@@ -60,6 +76,268 @@ func TestSetByte(t *testing.T) {
 	tests.AssertEqual(t, nullableByte.Get(), nil)
 }
 
+func TestByteFrom(t *testing.T) {
+	var basic byte = 0x7f
+	tests.AssertEqual(t, nullable.ByteFrom(basic), nullable.NewByte(&basic))
+	tests.AssertEqual(t, nullable.ByteFrom(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullByte(), nullable.NewByte(nil))
+	tests.AssertEqual(t, nullable.NullByte().IsNull(), true)
+}
+
+func TestCoalesceByte(t *testing.T) {
+	var basic byte = 0x7f
+	var zero byte = 0
+	tests.AssertEqual(t, nullable.CoalesceByte(), nullable.Byte{})
+	tests.AssertEqual(t, nullable.CoalesceByte(nullable.NullByte(), nullable.NullByte()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceByte(nullable.NullByte(), nullable.ByteFrom(basic), nullable.ByteFrom(zero)), nullable.ByteFrom(basic))
+	tests.AssertEqual(t, nullable.CoalesceByte(nullable.ByteFrom(zero), nullable.ByteFrom(basic)), nullable.ByteFrom(zero))
+}
+
+func TestIsZeroByte(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullByte().IsZero(), true)
+	tests.AssertEqual(t, nullable.ByteFrom(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.ByteFrom(0x7f).IsZero(), false)
+}
+
+func TestCloneByte(t *testing.T) {
+	tests.AssertEqual(t, nullable.ByteFrom(0x7f).Clone(), nullable.ByteFrom(0x7f))
+	tests.AssertEqual(t, nullable.NullByte().Clone(), nullable.NullByte())
+}
+
+func TestUnwrapByte(t *testing.T) {
+	var basic byte = 0x7f
+	value, ok := nullable.ByteFrom(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullByte().Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Byte
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseByte(t *testing.T) {
+	parsed, err := nullable.ParseByte("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullByte())
+
+	text, err := nullable.ByteFrom(0x7f).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseByte(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.ByteFrom(0x7f)), true)
+
+	for _, text := range []string{"abc", "-1", "1.5"} {
+		if _, err := nullable.ParseByte(text); err == nil {
+			t.Errorf("parsing %q as single byte must fail", text)
+		}
+	}
+}
+
+func TestMustParseByte(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseByte(""), nullable.NullByte())
+
+	text, err := nullable.ByteFrom(0x7f).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseByte(string(text)).Equal(nullable.ByteFrom(0x7f)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseByte of malformed text must panic")
+		}
+	}()
+	nullable.MustParseByte("abc")
+}
+
+func TestPtrByte(t *testing.T) {
+	var basic byte = 0x7f
+	tests.AssertEqual(t, nullable.BytePtr(nullable.NewByte(&basic)), basic)
+	tests.AssertEqual(t, nullable.BytePtr(nullable.NewByte(nil)), nil)
+	tests.AssertEqual(t, nullable.ByteValue(nullable.NewByte(&basic)), basic)
+	tests.AssertEqual(t, nullable.ByteValue(nullable.NewByte(nil)), 0)
+}
+
+func TestSetValueByte(t *testing.T) {
+	var basic byte = 0x7f
+	var nullableByte nullable.Byte
+	nullableByte.SetValue(basic)
+	tests.AssertEqual(t, nullableByte, nullable.ByteFrom(basic))
+
+	nullableByte.SetNull()
+	tests.AssertEqual(t, nullableByte, nullable.NullByte())
+}
+
+func TestZeroAsNullByte(t *testing.T) {
+	var basic byte = 0x7f
+	var zero byte = 0
+	tests.AssertEqual(t, nullable.ByteZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.ByteZeroAsNull(basic), nullable.ByteFrom(basic))
+
+	nullableByte := nullable.ByteFrom(zero)
+	nullableByte.NullIfZero()
+	tests.AssertEqual(t, nullableByte, nullable.NullByte())
+
+	nullableByte = nullable.ByteFrom(basic)
+	nullableByte.NullIfZero()
+	tests.AssertEqual(t, nullableByte, nullable.ByteFrom(basic))
+}
+
+func TestByteFromSQL(t *testing.T) {
+	var basic byte = 0x7f
+	tests.AssertEqual(t, nullable.ByteFromSQL(sql.NullByte{Byte: basic, Valid: true}), nullable.ByteFrom(basic))
+	tests.AssertEqual(t, nullable.ByteFromSQL(sql.NullByte{Byte: basic}), nullable.NullByte())
+
+	tests.AssertEqual(t, nullable.ByteFrom(basic).ToSQL(), sql.NullByte{Byte: basic, Valid: true})
+	tests.AssertEqual(t, nullable.NullByte().ToSQL(), sql.NullByte{})
+}
+
+func TestIsNullByte(t *testing.T) {
+	var zero nullable.Byte
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic byte = 0x7f
+	nullableByte := nullable.NewByte(&basic)
+	tests.AssertEqual(t, nullableByte.IsNull(), false)
+	tests.AssertEqual(t, nullableByte.IsValid(), true)
+
+	nullableByte.Set(nil)
+	tests.AssertEqual(t, nullableByte.IsNull(), true)
+	tests.AssertEqual(t, nullableByte.IsValid(), false)
+}
+
+func TestGetOrByte(t *testing.T) {
+	var zero nullable.Byte
+	var fallback byte = 0x7f
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), 0)
+
+	var basic byte = 0x7f
+	nullableByte := nullable.NewByte(&basic)
+	tests.AssertEqual(t, nullableByte.GetOr(0), basic)
+	tests.AssertEqual(t, nullableByte.GetOrZero(), basic)
+}
+
+func TestMustGetByte(t *testing.T) {
+	var basic byte = 0x7f
+	nullableByte := nullable.NewByte(&basic)
+	tests.AssertEqual(t, nullableByte.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Byte")
+	}()
+	nullableByte.Set(nil)
+	nullableByte.MustGet()
+	t.Error("MustGet on NULL Byte must panic")
+}
+
+func TestTextByte(t *testing.T) {
+	var basic byte = 0x7f
+	marshalUnmarshalText(t, nullable.NewByte(&basic))
+
+	marshalUnmarshalText(t, nullable.NewByte(nil))
+}
+
+func TestCSVByte(t *testing.T) {
+	var basic byte = 0x7f
+	marshalUnmarshalCSV(t, nullable.NewByte(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewByte(nil))
+}
+
+func TestYAMLByte(t *testing.T) {
+	var basic byte = 0x7f
+	marshalUnmarshalYAML(t, nullable.NewByte(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewByte(nil))
+}
+
+func TestMsgpackByte(t *testing.T) {
+	var basic byte = 0x7f
+	marshalUnmarshalMsgpack(t, nullable.NewByte(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewByte(nil))
+}
+
+func TestBSONByte(t *testing.T) {
+	var basic byte = 0x7f
+	marshalUnmarshalBSON(t, nullable.NewByte(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewByte(nil))
+}
+
+func TestGobByte(t *testing.T) {
+	var basic byte = 0x7f
+	marshalUnmarshalGob(t, nullable.NewByte(&basic))
+
+	var zero byte = 0
+	marshalUnmarshalGob(t, nullable.NewByte(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewByte(nil))
+}
+
+func TestStringerByte(t *testing.T) {
+	var basic byte = 0x7f
+	nullableByte := nullable.NewByte(&basic)
+	tests.AssertEqual(t, nullableByte.String(), "127")
+	tests.AssertEqual(t, fmt.Sprint(nullableByte), "127")
+
+	nullableByte.Set(nil)
+	tests.AssertEqual(t, nullableByte.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableByte), "<null>")
+}
+
+func TestChangedByte(t *testing.T) {
+	var basic byte = 0x7f
+	value, null := nullable.NewByte(&basic), nullable.NewByte(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.ByteFrom(0)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewByte(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewByte(nil)), false)
+}
+
+func TestEqualByte(t *testing.T) {
+	var basic byte = 0x7f
+	var zero byte = 0
+	tests.AssertEqual(t, nullable.NewByte(nil).Equal(nullable.NewByte(nil)), true)
+	tests.AssertEqual(t, nullable.NewByte(nil).Equal(nullable.NewByte(&basic)), false)
+	tests.AssertEqual(t, nullable.NewByte(&basic).Equal(nullable.NewByte(nil)), false)
+	tests.AssertEqual(t, nullable.NewByte(&basic).Equal(nullable.NewByte(&basic)), true)
+	tests.AssertEqual(t, nullable.NewByte(&basic).Equal(nullable.NewByte(&zero)), false)
+	tests.AssertEqual(t, nullable.NewByte(&zero).Equal(nullable.NewByte(nil)), false)
+}
+
+func TestCompareByte(t *testing.T) {
+	var lesser byte = 0x01
+	var greater byte = 0x7f
+	null := nullable.NewByte(nil)
+	small := nullable.NewByte(&lesser)
+	big := nullable.NewByte(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewByte(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewByte(&greater)), 0)
+
+	values := []nullable.Byte{big, null, small}
+	slices.SortFunc(values, nullable.Byte.Compare)
+	tests.AssertEqual(t, values, []nullable.Byte{null, small, big})
+}
+
+func TestXMLByte(t *testing.T) {
+	var basic byte = 0x7f
+	marshalUnmarshalXML(t, nullable.NewByte(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewByte(nil))
+}
+
 func TestJSONByte(t *testing.T) {
 	basicByte1 := byte(0)
 	marshalUnmarshalJSON(t, nullable.NewByte(&basicByte1))
@@ -1,67 +1,329 @@
 package nullable
 
 import (
+	"bytes"
 	"database/sql/driver"
+	"encoding/base64"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"log/slog"
+	"slices"
+	"strings"
 
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 )
 
 // Bytes SQL type that can retrieve NULL value
 type Bytes struct {
-	realValue []byte
-	isValid   bool
+	core[[]byte]
 }
 
 // NewBytes creates a new nullable array of bytes
 func NewBytes(value *[]byte) Bytes {
+	return Bytes{core: newCore(value)}
+}
+
+// BytesFrom creates a new valid nullable array of bytes from value, nil is
+// an empty array
+func BytesFrom(value []byte) Bytes {
 	if value == nil {
-		return Bytes{
-			realValue: []byte{},
-			isValid:   false,
+		value = []byte{}
+	}
+	return NewBytes(&value)
+}
+
+// NullBytes creates a new NULL array of bytes
+func NullBytes() Bytes {
+	return NewBytes(nil)
+}
+
+// BytesZeroAsNull creates a new nullable array of bytes that is NULL when value is the zero value
+func BytesZeroAsNull(value []byte) Bytes {
+	if len(value) == 0 {
+		return NullBytes()
+	}
+	return BytesFrom(value)
+}
+
+// CoalesceBytes returns the first valid value, or NULL when all of them are NULL
+func CoalesceBytes(values ...Bytes) Bytes {
+	for _, value := range values {
+		if value.isValid {
+			return value
 		}
 	}
-	return Bytes{
-		realValue: *value,
-		isValid:   true,
+	return Bytes{}
+}
+
+// BytesPtr returns n.Get(), handy where a function value is needed
+func BytesPtr(n Bytes) *[]byte {
+	return n.Get()
+}
+
+// BytesValue returns n.GetOrZero(), zero value when NULL
+func BytesValue(n Bytes) []byte {
+	return n.GetOrZero()
+}
+
+// ParseBytes parses text like UnmarshalText does, empty text is NULL
+func ParseBytes(text string) (Bytes, error) {
+	var n Bytes
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Bytes{}, err
+	}
+	return n, nil
+}
+
+// MustParseBytes is ParseBytes that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseBytes(text string) Bytes {
+	n, err := ParseBytes(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseBytes(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL Bytes, the one a declared
+// Bytes starts with, letting go of the held byte slice so pooled values can be
+// reused
+func (n *Bytes) Reset() {
+	*n = Bytes{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Bytes) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
 	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Bytes) NullIfZero() {
+	if n.isValid && len(n.realValue) == 0 {
+		n.SetNull()
+	}
+}
+
+// GetOrZero either zero value or array of bytes
+func (n Bytes) GetOrZero() []byte {
+	return n.GetOr([]byte{})
 }
 
-// Get either nil or array of bytes
-func (n Bytes) Get() *[]byte {
+// MustGet either array of bytes or panic when NULL
+func (n Bytes) MustGet() []byte {
+	return n.mustGet("Bytes")
+}
+
+// Clone returns a copy of the value that shares no memory with n
+func (n Bytes) Clone() Bytes {
+	n.realValue = slices.Clone(n.realValue)
+	return n
+}
+
+// String returns array of bytes in its natural text form, or "<null>" when NULL
+func (n Bytes) String() string {
 	if !n.isValid {
-		return nil
+		return nullString
 	}
-	return &n.realValue
+	return base64.StdEncoding.EncodeToString(n.realValue)
 }
 
-// Set either nil or array of bytes
-func (n *Bytes) Set(value *[]byte) {
-	n.isValid = (value != nil)
-	if n.isValid {
-		n.realValue = *value
-	} else {
-		n.realValue = []byte{}
+// DebugString returns Bytes(value) or Bytes(NULL), telling NULL apart from a
+// zero value in logs
+func (n Bytes) DebugString() string {
+	return debugString("Bytes", n.isValid, n.String())
+}
+
+// LogValue implements slog.LogValuer, logging NULL as nil and the value as
+// base64 text like String
+func (n Bytes) LogValue() slog.Value {
+	return logText(n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same array of bytes
+func (n Bytes) Equal(other Bytes) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
 	}
+	return bytes.Equal(n.realValue, other.realValue)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Bytes) Changed(old Bytes) bool {
+	return !n.Equal(old)
 }
 
-// MarshalJSON converts current value to JSON
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any array of bytes.
+func (n Bytes) Compare(other Bytes) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
+	}
+	return bytes.Compare(n.realValue, other.realValue)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL the bytes, telling NULL apart from zero in cache keys
+func (n Bytes) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagBytes, n.isValid, n.realValue)
+}
+
+// MarshalJSON converts current value to JSON, a base64 string. A valid nil
+// array is "" like an empty one, since null would read back as NULL.
 func (n Bytes) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	if n.realValue == nil {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(n.realValue)
 }
 
 // UnmarshalJSON writes JSON to this type
 func (n *Bytes) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
 		n.isValid = false
-		n.realValue = []byte{}
+		n.realValue = nil
 		return nil
 	}
 
 	var parsed []byte
 	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Bytes) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(base64.StdEncoding.EncodeToString(n.realValue)), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Bytes) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	parsed, err := base64.StdEncoding.DecodeString(string(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Bytes) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Bytes) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Bytes) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return copyBytes(n.realValue), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Bytes) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return base64.StdEncoding.EncodeToString(n.realValue), nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Bytes) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	var encoded string
+	if err := value.Decode(&encoded); err != nil {
+		return err
+	}
+	parsed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n Bytes) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(base64.StdEncoding.EncodeToString(n.realValue), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Bytes) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	parsed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(text))
+	if err != nil {
 		return err
 	}
 
@@ -70,14 +332,106 @@ func (n *Bytes) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// EncodeMsgpack converts current value to MessagePack
+func (n Bytes) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Bytes) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	var parsed []byte
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Bytes) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Bytes) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	var parsed []byte
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Bytes) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type. gob writes an empty array like a nil
+// one, so a valid array decodes as empty, never as nil.
+func (n *Bytes) GobDecode(data []byte) error {
+	var parsed []byte
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+	if parsed == nil {
+		parsed = []byte{}
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
 // Scan implements scanner interface
 func (n *Bytes) Scan(value interface{}) error {
+	value = scanValue(value)
 	if value == nil {
-		n.realValue, n.isValid = []byte{}, false
+		n.realValue, n.isValid = nil, false
 		return nil
 	}
+
+	// convertAssign copies the driver buffer, so it can't be changed behind our back
+	var scanned []byte
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
+	}
+	n.realValue = scanned
+
 	n.isValid = true
-	return convertAssign(&n.realValue, value)
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -94,12 +448,35 @@ func (Bytes) GormDataType() string {
 }
 
 // GormDBDataType gorm db data type
-func (Bytes) GormDBDataType(db *gorm.DB, field *schema.Field) string {
-	switch db.Dialector.Name() {
+func (n Bytes) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Bytes) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Bytes) columnType(dialect string, field *schema.Field) string {
+	size := fieldSize(field)
+	switch dialect {
 	case "sqlite", "mysql":
+		if size > 0 {
+			return fmt.Sprintf("VARBINARY(%d)", size)
+		}
 		return "BLOB"
-	case "postgres":
+	case "postgres", "cockroachdb":
 		return "bytea"
+	case "sqlserver":
+		// VARBINARY takes at most 8000 bytes before it has to be MAX
+		if size > 0 && size <= 8000 {
+			return fmt.Sprintf("VARBINARY(%d)", size)
+		}
+		return "VARBINARY(MAX)"
+	case "clickhouse":
+		return "Nullable(String)"
 	}
 	return ""
 }
@@ -1,9 +1,14 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/tee8z/nullable"
+	"gorm.io/gorm/schema"
 	"gorm.io/gorm/utils/tests"
 )
 
@@ -23,6 +28,28 @@ func TestScanBytes(t *testing.T) {
 	tests.AssertEqual(t, nullableBytes.Get(), nil)
 }
 
+func TestScanBytesCopiesBuffer(t *testing.T) {
+	nullableBytes := nullable.NewBytes(nil)
+
+	driverBuffer := []byte{0x0, 0x7f, 0xff}
+	tests.AssertEqual(t, nullableBytes.Scan(driverBuffer), nil)
+
+	// Driver reusing its buffer must not affect nullable bytes
+	driverBuffer[0] = 0x21
+	tests.AssertEqual(t, nullableBytes.Get(), []byte{0x0, 0x7f, 0xff})
+}
+
+func TestScanRawBytesBytes(t *testing.T) {
+	nullableBytes := nullable.NewBytes(nil)
+
+	driverBuffer := sql.RawBytes([]byte{0x0, 0x7f, 0xff})
+	tests.AssertEqual(t, nullableBytes.Scan(driverBuffer), nil)
+
+	// sql.RawBytes is only valid until the next row overwrites it
+	copy(driverBuffer, []byte{0x21, 0x21, 0x21})
+	tests.AssertEqual(t, nullableBytes.Get(), []byte{0x0, 0x7f, 0xff})
+}
+
 func TestNewBytes(t *testing.T) {
 	basicBytes1 := []byte{}
 	nullableBytes1 := nullable.NewBytes(&basicBytes1)
@@ -81,6 +108,266 @@ func TestMutateBytes(t *testing.T) {
 	tests.AssertEqual(t, len(*nullableBytes.Get()), 3)
 }
 
+func TestBytesFrom(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	tests.AssertEqual(t, nullable.BytesFrom(basic), nullable.NewBytes(&basic))
+	tests.AssertEqual(t, nullable.BytesFrom(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullBytes(), nullable.NewBytes(nil))
+	tests.AssertEqual(t, nullable.NullBytes().IsNull(), true)
+}
+
+func TestCoalesceBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	var zero []byte = []byte{}
+	tests.AssertEqual(t, nullable.CoalesceBytes(), nullable.Bytes{})
+	tests.AssertEqual(t, nullable.CoalesceBytes(nullable.NullBytes(), nullable.NullBytes()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceBytes(nullable.NullBytes(), nullable.BytesFrom(basic), nullable.BytesFrom(zero)), nullable.BytesFrom(basic))
+	tests.AssertEqual(t, nullable.CoalesceBytes(nullable.BytesFrom(zero), nullable.BytesFrom(basic)), nullable.BytesFrom(zero))
+}
+
+func TestIsZeroBytes(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullBytes().IsZero(), true)
+	tests.AssertEqual(t, nullable.BytesFrom([]byte{}).IsZero(), false)
+	tests.AssertEqual(t, nullable.BytesFrom([]byte{0x0, 0x7f, 0xff}).IsZero(), false)
+}
+
+func TestCloneBytes(t *testing.T) {
+	original := nullable.BytesFrom([]byte{0x0, 0x7f, 0xff})
+	clone := original.Clone()
+	tests.AssertEqual(t, clone, original)
+
+	clone.MustGet()[0]++
+	tests.AssertEqual(t, original, nullable.BytesFrom([]byte{0x0, 0x7f, 0xff}))
+	tests.AssertEqual(t, clone.Equal(original), false)
+
+	tests.AssertEqual(t, nullable.NullBytes().Clone(), nullable.NullBytes())
+}
+
+func TestUnwrapBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	value, ok := nullable.BytesFrom(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullBytes().Unwrap()
+	tests.AssertEqual(t, value == nil, true)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Bytes
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value == nil, true)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseBytes(t *testing.T) {
+	parsed, err := nullable.ParseBytes("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullBytes())
+
+	text, err := nullable.BytesFrom([]byte{0x0, 0x7f, 0xff}).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseBytes(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.BytesFrom([]byte{0x0, 0x7f, 0xff})), true)
+
+	for _, text := range []string{"abc"} {
+		if _, err := nullable.ParseBytes(text); err == nil {
+			t.Errorf("parsing %q as array of bytes must fail", text)
+		}
+	}
+}
+
+func TestMustParseBytes(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseBytes(""), nullable.NullBytes())
+
+	text, err := nullable.BytesFrom([]byte{0x0, 0x7f, 0xff}).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseBytes(string(text)).Equal(nullable.BytesFrom([]byte{0x0, 0x7f, 0xff})), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseBytes of malformed text must panic")
+		}
+	}()
+	nullable.MustParseBytes("abc")
+}
+
+func TestPtrBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	tests.AssertEqual(t, nullable.BytesPtr(nullable.NewBytes(&basic)), basic)
+	tests.AssertEqual(t, nullable.BytesPtr(nullable.NewBytes(nil)), nil)
+	tests.AssertEqual(t, nullable.BytesValue(nullable.NewBytes(&basic)), basic)
+	tests.AssertEqual(t, nullable.BytesValue(nullable.NewBytes(nil)), []byte{})
+}
+
+func TestSetValueBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	var nullableBytes nullable.Bytes
+	nullableBytes.SetValue(basic)
+	tests.AssertEqual(t, nullableBytes, nullable.BytesFrom(basic))
+
+	nullableBytes.SetNull()
+	tests.AssertEqual(t, nullableBytes, nullable.NullBytes())
+}
+
+func TestZeroAsNullBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	var zero []byte = []byte{}
+	tests.AssertEqual(t, nullable.BytesZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.BytesZeroAsNull(basic), nullable.BytesFrom(basic))
+
+	nullableBytes := nullable.BytesFrom(zero)
+	nullableBytes.NullIfZero()
+	tests.AssertEqual(t, nullableBytes, nullable.NullBytes())
+
+	nullableBytes = nullable.BytesFrom(basic)
+	nullableBytes.NullIfZero()
+	tests.AssertEqual(t, nullableBytes, nullable.BytesFrom(basic))
+}
+
+func TestIsNullBytes(t *testing.T) {
+	var zero nullable.Bytes
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	nullableBytes := nullable.NewBytes(&basic)
+	tests.AssertEqual(t, nullableBytes.IsNull(), false)
+	tests.AssertEqual(t, nullableBytes.IsValid(), true)
+
+	nullableBytes.Set(nil)
+	tests.AssertEqual(t, nullableBytes.IsNull(), true)
+	tests.AssertEqual(t, nullableBytes.IsValid(), false)
+}
+
+func TestGetOrBytes(t *testing.T) {
+	var zero nullable.Bytes
+	var fallback []byte = []byte{0x0, 0x7f, 0xff}
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), []byte{})
+
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	nullableBytes := nullable.NewBytes(&basic)
+	tests.AssertEqual(t, nullableBytes.GetOr([]byte{}), basic)
+	tests.AssertEqual(t, nullableBytes.GetOrZero(), basic)
+}
+
+func TestMustGetBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	nullableBytes := nullable.NewBytes(&basic)
+	tests.AssertEqual(t, nullableBytes.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Bytes")
+	}()
+	nullableBytes.Set(nil)
+	nullableBytes.MustGet()
+	t.Error("MustGet on NULL Bytes must panic")
+}
+
+func TestTextBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	marshalUnmarshalText(t, nullable.NewBytes(&basic))
+
+	marshalUnmarshalText(t, nullable.NewBytes(nil))
+}
+
+func TestCSVBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	marshalUnmarshalCSV(t, nullable.NewBytes(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewBytes(nil))
+}
+
+func TestYAMLBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	marshalUnmarshalYAML(t, nullable.NewBytes(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewBytes(nil))
+}
+
+func TestMsgpackBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	marshalUnmarshalMsgpack(t, nullable.NewBytes(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewBytes(nil))
+}
+
+func TestBSONBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	marshalUnmarshalBSON(t, nullable.NewBytes(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewBytes(nil))
+}
+
+func TestGobBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	marshalUnmarshalGob(t, nullable.NewBytes(&basic))
+
+	var zero []byte = []byte{}
+	marshalUnmarshalGob(t, nullable.NewBytes(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewBytes(nil))
+}
+
+func TestStringerBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	nullableBytes := nullable.NewBytes(&basic)
+	tests.AssertEqual(t, nullableBytes.String(), "AH//")
+	tests.AssertEqual(t, fmt.Sprint(nullableBytes), "AH//")
+
+	nullableBytes.Set(nil)
+	tests.AssertEqual(t, nullableBytes.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableBytes), "<null>")
+}
+
+func TestChangedBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	value, null := nullable.NewBytes(&basic), nullable.NewBytes(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.BytesFrom([]byte{})), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewBytes(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewBytes(nil)), false)
+}
+
+func TestEqualBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	var zero []byte = []byte{}
+	tests.AssertEqual(t, nullable.NewBytes(nil).Equal(nullable.NewBytes(nil)), true)
+	tests.AssertEqual(t, nullable.NewBytes(nil).Equal(nullable.NewBytes(&basic)), false)
+	tests.AssertEqual(t, nullable.NewBytes(&basic).Equal(nullable.NewBytes(nil)), false)
+	tests.AssertEqual(t, nullable.NewBytes(&basic).Equal(nullable.NewBytes(&basic)), true)
+	tests.AssertEqual(t, nullable.NewBytes(&basic).Equal(nullable.NewBytes(&zero)), false)
+	tests.AssertEqual(t, nullable.NewBytes(&zero).Equal(nullable.NewBytes(nil)), false)
+}
+
+func TestCompareBytes(t *testing.T) {
+	var lesser []byte = []byte{0x0, 0x7f}
+	var greater []byte = []byte{0x0, 0x7f, 0xff}
+	null := nullable.NewBytes(nil)
+	small := nullable.NewBytes(&lesser)
+	big := nullable.NewBytes(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewBytes(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewBytes(&greater)), 0)
+
+	values := []nullable.Bytes{big, null, small}
+	slices.SortFunc(values, nullable.Bytes.Compare)
+	tests.AssertEqual(t, values, []nullable.Bytes{null, small, big})
+}
+
+func TestXMLBytes(t *testing.T) {
+	var basic []byte = []byte{0x0, 0x7f, 0xff}
+	marshalUnmarshalXML(t, nullable.NewBytes(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewBytes(nil))
+}
+
 func TestJSONBytes(t *testing.T) {
 	basicBytes1 := []byte{0x0, 0x7f, 0xff}
 	marshalUnmarshalJSON(t, nullable.NewBytes(&basicBytes1))
@@ -91,6 +378,20 @@ func TestJSONBytes(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewBytes(nil))
 }
 
+func TestMarshalJSONBytesBase64(t *testing.T) {
+	basicBytes := []byte{0x0, 0x7f, 0xff}
+	serialized, err := json.Marshal(nullable.NewBytes(&basicBytes))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `"AH//"`)
+
+	var unserialized nullable.Bytes
+	tests.AssertEqual(t, json.Unmarshal([]byte(`"AH//"`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.Get(), basicBytes)
+
+	tests.AssertEqual(t, json.Unmarshal([]byte("null"), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.IsNull(), true)
+}
+
 func TestBytes(t *testing.T) {
 	type TestNullableByteArray struct {
 		ID       uint
@@ -141,3 +442,24 @@ func TestBytes(t *testing.T) {
 	}
 	tests.AssertEqual(t, result3, &unknownUser)
 }
+
+func TestBytesDBDataType(t *testing.T) {
+	sized := &schema.Field{Size: 16}
+	huge := &schema.Field{Size: 10000}
+	cases := []struct {
+		dialect  string
+		field    *schema.Field
+		expected string
+	}{
+		{"mysql", &schema.Field{}, "BLOB"},
+		{"mysql", sized, "VARBINARY(16)"},
+		{"sqlite", sized, "VARBINARY(16)"},
+		{"postgres", sized, "bytea"},
+		{"sqlserver", sized, "VARBINARY(16)"},
+		{"sqlserver", huge, "VARBINARY(MAX)"},
+		{"clickhouse", sized, "Nullable(String)"},
+	}
+	for _, c := range cases {
+		tests.AssertEqual(t, nullable.Bytes{}.GormDBDataType(DialectDB(c.dialect), c.field), c.expected)
+	}
+}
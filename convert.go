@@ -0,0 +1,1097 @@
+package nullable
+
+import "fmt"
+
+// integer is any type the conversions between integer nullables deal with
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// convertInteger converts value to To, failing when it doesn't fit
+func convertInteger[To, From integer](value From) (To, error) {
+	converted := To(value)
+	if From(converted) != value || (converted < 0) != (value < 0) {
+		return 0, withSentinel(ErrOverflow, fmt.Errorf("nullable: %d overflows %T", value, converted))
+	}
+	return converted, nil
+}
+
+// ToInt8 converts to Int8, failing when the value doesn't fit. NULL stays NULL.
+func (n Int) ToInt8() (Int8, error) {
+	if !n.isValid {
+		return NullInt8(), nil
+	}
+	value, err := convertInteger[int8](n.realValue)
+	if err != nil {
+		return Int8{}, err
+	}
+	return Int8From(value), nil
+}
+
+// ToInt16 converts to Int16, failing when the value doesn't fit. NULL stays NULL.
+func (n Int) ToInt16() (Int16, error) {
+	if !n.isValid {
+		return NullInt16(), nil
+	}
+	value, err := convertInteger[int16](n.realValue)
+	if err != nil {
+		return Int16{}, err
+	}
+	return Int16From(value), nil
+}
+
+// ToInt32 converts to Int32, failing when the value doesn't fit. NULL stays NULL.
+func (n Int) ToInt32() (Int32, error) {
+	if !n.isValid {
+		return NullInt32(), nil
+	}
+	value, err := convertInteger[int32](n.realValue)
+	if err != nil {
+		return Int32{}, err
+	}
+	return Int32From(value), nil
+}
+
+// ToInt64 converts to Int64, failing when the value doesn't fit. NULL stays NULL.
+func (n Int) ToInt64() (Int64, error) {
+	if !n.isValid {
+		return NullInt64(), nil
+	}
+	value, err := convertInteger[int64](n.realValue)
+	if err != nil {
+		return Int64{}, err
+	}
+	return Int64From(value), nil
+}
+
+// ToUint converts to Uint, failing when the value doesn't fit. NULL stays NULL.
+func (n Int) ToUint() (Uint, error) {
+	if !n.isValid {
+		return NullUint(), nil
+	}
+	value, err := convertInteger[uint](n.realValue)
+	if err != nil {
+		return Uint{}, err
+	}
+	return UintFrom(value), nil
+}
+
+// ToUint8 converts to Uint8, failing when the value doesn't fit. NULL stays NULL.
+func (n Int) ToUint8() (Uint8, error) {
+	if !n.isValid {
+		return NullUint8(), nil
+	}
+	value, err := convertInteger[uint8](n.realValue)
+	if err != nil {
+		return Uint8{}, err
+	}
+	return Uint8From(value), nil
+}
+
+// ToUint16 converts to Uint16, failing when the value doesn't fit. NULL stays NULL.
+func (n Int) ToUint16() (Uint16, error) {
+	if !n.isValid {
+		return NullUint16(), nil
+	}
+	value, err := convertInteger[uint16](n.realValue)
+	if err != nil {
+		return Uint16{}, err
+	}
+	return Uint16From(value), nil
+}
+
+// ToUint32 converts to Uint32, failing when the value doesn't fit. NULL stays NULL.
+func (n Int) ToUint32() (Uint32, error) {
+	if !n.isValid {
+		return NullUint32(), nil
+	}
+	value, err := convertInteger[uint32](n.realValue)
+	if err != nil {
+		return Uint32{}, err
+	}
+	return Uint32From(value), nil
+}
+
+// ToUint64 converts to Uint64, failing when the value doesn't fit. NULL stays NULL.
+func (n Int) ToUint64() (Uint64, error) {
+	if !n.isValid {
+		return NullUint64(), nil
+	}
+	value, err := convertInteger[uint64](n.realValue)
+	if err != nil {
+		return Uint64{}, err
+	}
+	return Uint64From(value), nil
+}
+
+// ToInt converts to Int, failing when the value doesn't fit. NULL stays NULL.
+func (n Int8) ToInt() (Int, error) {
+	if !n.isValid {
+		return NullInt(), nil
+	}
+	value, err := convertInteger[int](n.realValue)
+	if err != nil {
+		return Int{}, err
+	}
+	return IntFrom(value), nil
+}
+
+// ToInt16 converts to Int16, failing when the value doesn't fit. NULL stays NULL.
+func (n Int8) ToInt16() (Int16, error) {
+	if !n.isValid {
+		return NullInt16(), nil
+	}
+	value, err := convertInteger[int16](n.realValue)
+	if err != nil {
+		return Int16{}, err
+	}
+	return Int16From(value), nil
+}
+
+// ToInt32 converts to Int32, failing when the value doesn't fit. NULL stays NULL.
+func (n Int8) ToInt32() (Int32, error) {
+	if !n.isValid {
+		return NullInt32(), nil
+	}
+	value, err := convertInteger[int32](n.realValue)
+	if err != nil {
+		return Int32{}, err
+	}
+	return Int32From(value), nil
+}
+
+// ToInt64 converts to Int64, failing when the value doesn't fit. NULL stays NULL.
+func (n Int8) ToInt64() (Int64, error) {
+	if !n.isValid {
+		return NullInt64(), nil
+	}
+	value, err := convertInteger[int64](n.realValue)
+	if err != nil {
+		return Int64{}, err
+	}
+	return Int64From(value), nil
+}
+
+// ToUint converts to Uint, failing when the value doesn't fit. NULL stays NULL.
+func (n Int8) ToUint() (Uint, error) {
+	if !n.isValid {
+		return NullUint(), nil
+	}
+	value, err := convertInteger[uint](n.realValue)
+	if err != nil {
+		return Uint{}, err
+	}
+	return UintFrom(value), nil
+}
+
+// ToUint8 converts to Uint8, failing when the value doesn't fit. NULL stays NULL.
+func (n Int8) ToUint8() (Uint8, error) {
+	if !n.isValid {
+		return NullUint8(), nil
+	}
+	value, err := convertInteger[uint8](n.realValue)
+	if err != nil {
+		return Uint8{}, err
+	}
+	return Uint8From(value), nil
+}
+
+// ToUint16 converts to Uint16, failing when the value doesn't fit. NULL stays NULL.
+func (n Int8) ToUint16() (Uint16, error) {
+	if !n.isValid {
+		return NullUint16(), nil
+	}
+	value, err := convertInteger[uint16](n.realValue)
+	if err != nil {
+		return Uint16{}, err
+	}
+	return Uint16From(value), nil
+}
+
+// ToUint32 converts to Uint32, failing when the value doesn't fit. NULL stays NULL.
+func (n Int8) ToUint32() (Uint32, error) {
+	if !n.isValid {
+		return NullUint32(), nil
+	}
+	value, err := convertInteger[uint32](n.realValue)
+	if err != nil {
+		return Uint32{}, err
+	}
+	return Uint32From(value), nil
+}
+
+// ToUint64 converts to Uint64, failing when the value doesn't fit. NULL stays NULL.
+func (n Int8) ToUint64() (Uint64, error) {
+	if !n.isValid {
+		return NullUint64(), nil
+	}
+	value, err := convertInteger[uint64](n.realValue)
+	if err != nil {
+		return Uint64{}, err
+	}
+	return Uint64From(value), nil
+}
+
+// ToInt converts to Int, failing when the value doesn't fit. NULL stays NULL.
+func (n Int16) ToInt() (Int, error) {
+	if !n.isValid {
+		return NullInt(), nil
+	}
+	value, err := convertInteger[int](n.realValue)
+	if err != nil {
+		return Int{}, err
+	}
+	return IntFrom(value), nil
+}
+
+// ToInt8 converts to Int8, failing when the value doesn't fit. NULL stays NULL.
+func (n Int16) ToInt8() (Int8, error) {
+	if !n.isValid {
+		return NullInt8(), nil
+	}
+	value, err := convertInteger[int8](n.realValue)
+	if err != nil {
+		return Int8{}, err
+	}
+	return Int8From(value), nil
+}
+
+// ToInt32 converts to Int32, failing when the value doesn't fit. NULL stays NULL.
+func (n Int16) ToInt32() (Int32, error) {
+	if !n.isValid {
+		return NullInt32(), nil
+	}
+	value, err := convertInteger[int32](n.realValue)
+	if err != nil {
+		return Int32{}, err
+	}
+	return Int32From(value), nil
+}
+
+// ToInt64 converts to Int64, failing when the value doesn't fit. NULL stays NULL.
+func (n Int16) ToInt64() (Int64, error) {
+	if !n.isValid {
+		return NullInt64(), nil
+	}
+	value, err := convertInteger[int64](n.realValue)
+	if err != nil {
+		return Int64{}, err
+	}
+	return Int64From(value), nil
+}
+
+// ToUint converts to Uint, failing when the value doesn't fit. NULL stays NULL.
+func (n Int16) ToUint() (Uint, error) {
+	if !n.isValid {
+		return NullUint(), nil
+	}
+	value, err := convertInteger[uint](n.realValue)
+	if err != nil {
+		return Uint{}, err
+	}
+	return UintFrom(value), nil
+}
+
+// ToUint8 converts to Uint8, failing when the value doesn't fit. NULL stays NULL.
+func (n Int16) ToUint8() (Uint8, error) {
+	if !n.isValid {
+		return NullUint8(), nil
+	}
+	value, err := convertInteger[uint8](n.realValue)
+	if err != nil {
+		return Uint8{}, err
+	}
+	return Uint8From(value), nil
+}
+
+// ToUint16 converts to Uint16, failing when the value doesn't fit. NULL stays NULL.
+func (n Int16) ToUint16() (Uint16, error) {
+	if !n.isValid {
+		return NullUint16(), nil
+	}
+	value, err := convertInteger[uint16](n.realValue)
+	if err != nil {
+		return Uint16{}, err
+	}
+	return Uint16From(value), nil
+}
+
+// ToUint32 converts to Uint32, failing when the value doesn't fit. NULL stays NULL.
+func (n Int16) ToUint32() (Uint32, error) {
+	if !n.isValid {
+		return NullUint32(), nil
+	}
+	value, err := convertInteger[uint32](n.realValue)
+	if err != nil {
+		return Uint32{}, err
+	}
+	return Uint32From(value), nil
+}
+
+// ToUint64 converts to Uint64, failing when the value doesn't fit. NULL stays NULL.
+func (n Int16) ToUint64() (Uint64, error) {
+	if !n.isValid {
+		return NullUint64(), nil
+	}
+	value, err := convertInteger[uint64](n.realValue)
+	if err != nil {
+		return Uint64{}, err
+	}
+	return Uint64From(value), nil
+}
+
+// ToInt converts to Int, failing when the value doesn't fit. NULL stays NULL.
+func (n Int32) ToInt() (Int, error) {
+	if !n.isValid {
+		return NullInt(), nil
+	}
+	value, err := convertInteger[int](n.realValue)
+	if err != nil {
+		return Int{}, err
+	}
+	return IntFrom(value), nil
+}
+
+// ToInt8 converts to Int8, failing when the value doesn't fit. NULL stays NULL.
+func (n Int32) ToInt8() (Int8, error) {
+	if !n.isValid {
+		return NullInt8(), nil
+	}
+	value, err := convertInteger[int8](n.realValue)
+	if err != nil {
+		return Int8{}, err
+	}
+	return Int8From(value), nil
+}
+
+// ToInt16 converts to Int16, failing when the value doesn't fit. NULL stays NULL.
+func (n Int32) ToInt16() (Int16, error) {
+	if !n.isValid {
+		return NullInt16(), nil
+	}
+	value, err := convertInteger[int16](n.realValue)
+	if err != nil {
+		return Int16{}, err
+	}
+	return Int16From(value), nil
+}
+
+// ToInt64 converts to Int64, failing when the value doesn't fit. NULL stays NULL.
+func (n Int32) ToInt64() (Int64, error) {
+	if !n.isValid {
+		return NullInt64(), nil
+	}
+	value, err := convertInteger[int64](n.realValue)
+	if err != nil {
+		return Int64{}, err
+	}
+	return Int64From(value), nil
+}
+
+// ToUint converts to Uint, failing when the value doesn't fit. NULL stays NULL.
+func (n Int32) ToUint() (Uint, error) {
+	if !n.isValid {
+		return NullUint(), nil
+	}
+	value, err := convertInteger[uint](n.realValue)
+	if err != nil {
+		return Uint{}, err
+	}
+	return UintFrom(value), nil
+}
+
+// ToUint8 converts to Uint8, failing when the value doesn't fit. NULL stays NULL.
+func (n Int32) ToUint8() (Uint8, error) {
+	if !n.isValid {
+		return NullUint8(), nil
+	}
+	value, err := convertInteger[uint8](n.realValue)
+	if err != nil {
+		return Uint8{}, err
+	}
+	return Uint8From(value), nil
+}
+
+// ToUint16 converts to Uint16, failing when the value doesn't fit. NULL stays NULL.
+func (n Int32) ToUint16() (Uint16, error) {
+	if !n.isValid {
+		return NullUint16(), nil
+	}
+	value, err := convertInteger[uint16](n.realValue)
+	if err != nil {
+		return Uint16{}, err
+	}
+	return Uint16From(value), nil
+}
+
+// ToUint32 converts to Uint32, failing when the value doesn't fit. NULL stays NULL.
+func (n Int32) ToUint32() (Uint32, error) {
+	if !n.isValid {
+		return NullUint32(), nil
+	}
+	value, err := convertInteger[uint32](n.realValue)
+	if err != nil {
+		return Uint32{}, err
+	}
+	return Uint32From(value), nil
+}
+
+// ToUint64 converts to Uint64, failing when the value doesn't fit. NULL stays NULL.
+func (n Int32) ToUint64() (Uint64, error) {
+	if !n.isValid {
+		return NullUint64(), nil
+	}
+	value, err := convertInteger[uint64](n.realValue)
+	if err != nil {
+		return Uint64{}, err
+	}
+	return Uint64From(value), nil
+}
+
+// ToInt converts to Int, failing when the value doesn't fit. NULL stays NULL.
+func (n Int64) ToInt() (Int, error) {
+	if !n.isValid {
+		return NullInt(), nil
+	}
+	value, err := convertInteger[int](n.realValue)
+	if err != nil {
+		return Int{}, err
+	}
+	return IntFrom(value), nil
+}
+
+// ToInt8 converts to Int8, failing when the value doesn't fit. NULL stays NULL.
+func (n Int64) ToInt8() (Int8, error) {
+	if !n.isValid {
+		return NullInt8(), nil
+	}
+	value, err := convertInteger[int8](n.realValue)
+	if err != nil {
+		return Int8{}, err
+	}
+	return Int8From(value), nil
+}
+
+// ToInt16 converts to Int16, failing when the value doesn't fit. NULL stays NULL.
+func (n Int64) ToInt16() (Int16, error) {
+	if !n.isValid {
+		return NullInt16(), nil
+	}
+	value, err := convertInteger[int16](n.realValue)
+	if err != nil {
+		return Int16{}, err
+	}
+	return Int16From(value), nil
+}
+
+// ToInt32 converts to Int32, failing when the value doesn't fit. NULL stays NULL.
+func (n Int64) ToInt32() (Int32, error) {
+	if !n.isValid {
+		return NullInt32(), nil
+	}
+	value, err := convertInteger[int32](n.realValue)
+	if err != nil {
+		return Int32{}, err
+	}
+	return Int32From(value), nil
+}
+
+// ToUint converts to Uint, failing when the value doesn't fit. NULL stays NULL.
+func (n Int64) ToUint() (Uint, error) {
+	if !n.isValid {
+		return NullUint(), nil
+	}
+	value, err := convertInteger[uint](n.realValue)
+	if err != nil {
+		return Uint{}, err
+	}
+	return UintFrom(value), nil
+}
+
+// ToUint8 converts to Uint8, failing when the value doesn't fit. NULL stays NULL.
+func (n Int64) ToUint8() (Uint8, error) {
+	if !n.isValid {
+		return NullUint8(), nil
+	}
+	value, err := convertInteger[uint8](n.realValue)
+	if err != nil {
+		return Uint8{}, err
+	}
+	return Uint8From(value), nil
+}
+
+// ToUint16 converts to Uint16, failing when the value doesn't fit. NULL stays NULL.
+func (n Int64) ToUint16() (Uint16, error) {
+	if !n.isValid {
+		return NullUint16(), nil
+	}
+	value, err := convertInteger[uint16](n.realValue)
+	if err != nil {
+		return Uint16{}, err
+	}
+	return Uint16From(value), nil
+}
+
+// ToUint32 converts to Uint32, failing when the value doesn't fit. NULL stays NULL.
+func (n Int64) ToUint32() (Uint32, error) {
+	if !n.isValid {
+		return NullUint32(), nil
+	}
+	value, err := convertInteger[uint32](n.realValue)
+	if err != nil {
+		return Uint32{}, err
+	}
+	return Uint32From(value), nil
+}
+
+// ToUint64 converts to Uint64, failing when the value doesn't fit. NULL stays NULL.
+func (n Int64) ToUint64() (Uint64, error) {
+	if !n.isValid {
+		return NullUint64(), nil
+	}
+	value, err := convertInteger[uint64](n.realValue)
+	if err != nil {
+		return Uint64{}, err
+	}
+	return Uint64From(value), nil
+}
+
+// ToInt converts to Int, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint) ToInt() (Int, error) {
+	if !n.isValid {
+		return NullInt(), nil
+	}
+	value, err := convertInteger[int](n.realValue)
+	if err != nil {
+		return Int{}, err
+	}
+	return IntFrom(value), nil
+}
+
+// ToInt8 converts to Int8, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint) ToInt8() (Int8, error) {
+	if !n.isValid {
+		return NullInt8(), nil
+	}
+	value, err := convertInteger[int8](n.realValue)
+	if err != nil {
+		return Int8{}, err
+	}
+	return Int8From(value), nil
+}
+
+// ToInt16 converts to Int16, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint) ToInt16() (Int16, error) {
+	if !n.isValid {
+		return NullInt16(), nil
+	}
+	value, err := convertInteger[int16](n.realValue)
+	if err != nil {
+		return Int16{}, err
+	}
+	return Int16From(value), nil
+}
+
+// ToInt32 converts to Int32, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint) ToInt32() (Int32, error) {
+	if !n.isValid {
+		return NullInt32(), nil
+	}
+	value, err := convertInteger[int32](n.realValue)
+	if err != nil {
+		return Int32{}, err
+	}
+	return Int32From(value), nil
+}
+
+// ToInt64 converts to Int64, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint) ToInt64() (Int64, error) {
+	if !n.isValid {
+		return NullInt64(), nil
+	}
+	value, err := convertInteger[int64](n.realValue)
+	if err != nil {
+		return Int64{}, err
+	}
+	return Int64From(value), nil
+}
+
+// ToUint8 converts to Uint8, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint) ToUint8() (Uint8, error) {
+	if !n.isValid {
+		return NullUint8(), nil
+	}
+	value, err := convertInteger[uint8](n.realValue)
+	if err != nil {
+		return Uint8{}, err
+	}
+	return Uint8From(value), nil
+}
+
+// ToUint16 converts to Uint16, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint) ToUint16() (Uint16, error) {
+	if !n.isValid {
+		return NullUint16(), nil
+	}
+	value, err := convertInteger[uint16](n.realValue)
+	if err != nil {
+		return Uint16{}, err
+	}
+	return Uint16From(value), nil
+}
+
+// ToUint32 converts to Uint32, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint) ToUint32() (Uint32, error) {
+	if !n.isValid {
+		return NullUint32(), nil
+	}
+	value, err := convertInteger[uint32](n.realValue)
+	if err != nil {
+		return Uint32{}, err
+	}
+	return Uint32From(value), nil
+}
+
+// ToUint64 converts to Uint64, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint) ToUint64() (Uint64, error) {
+	if !n.isValid {
+		return NullUint64(), nil
+	}
+	value, err := convertInteger[uint64](n.realValue)
+	if err != nil {
+		return Uint64{}, err
+	}
+	return Uint64From(value), nil
+}
+
+// ToInt converts to Int, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint8) ToInt() (Int, error) {
+	if !n.isValid {
+		return NullInt(), nil
+	}
+	value, err := convertInteger[int](n.realValue)
+	if err != nil {
+		return Int{}, err
+	}
+	return IntFrom(value), nil
+}
+
+// ToInt8 converts to Int8, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint8) ToInt8() (Int8, error) {
+	if !n.isValid {
+		return NullInt8(), nil
+	}
+	value, err := convertInteger[int8](n.realValue)
+	if err != nil {
+		return Int8{}, err
+	}
+	return Int8From(value), nil
+}
+
+// ToInt16 converts to Int16, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint8) ToInt16() (Int16, error) {
+	if !n.isValid {
+		return NullInt16(), nil
+	}
+	value, err := convertInteger[int16](n.realValue)
+	if err != nil {
+		return Int16{}, err
+	}
+	return Int16From(value), nil
+}
+
+// ToInt32 converts to Int32, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint8) ToInt32() (Int32, error) {
+	if !n.isValid {
+		return NullInt32(), nil
+	}
+	value, err := convertInteger[int32](n.realValue)
+	if err != nil {
+		return Int32{}, err
+	}
+	return Int32From(value), nil
+}
+
+// ToInt64 converts to Int64, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint8) ToInt64() (Int64, error) {
+	if !n.isValid {
+		return NullInt64(), nil
+	}
+	value, err := convertInteger[int64](n.realValue)
+	if err != nil {
+		return Int64{}, err
+	}
+	return Int64From(value), nil
+}
+
+// ToUint converts to Uint, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint8) ToUint() (Uint, error) {
+	if !n.isValid {
+		return NullUint(), nil
+	}
+	value, err := convertInteger[uint](n.realValue)
+	if err != nil {
+		return Uint{}, err
+	}
+	return UintFrom(value), nil
+}
+
+// ToUint16 converts to Uint16, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint8) ToUint16() (Uint16, error) {
+	if !n.isValid {
+		return NullUint16(), nil
+	}
+	value, err := convertInteger[uint16](n.realValue)
+	if err != nil {
+		return Uint16{}, err
+	}
+	return Uint16From(value), nil
+}
+
+// ToUint32 converts to Uint32, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint8) ToUint32() (Uint32, error) {
+	if !n.isValid {
+		return NullUint32(), nil
+	}
+	value, err := convertInteger[uint32](n.realValue)
+	if err != nil {
+		return Uint32{}, err
+	}
+	return Uint32From(value), nil
+}
+
+// ToUint64 converts to Uint64, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint8) ToUint64() (Uint64, error) {
+	if !n.isValid {
+		return NullUint64(), nil
+	}
+	value, err := convertInteger[uint64](n.realValue)
+	if err != nil {
+		return Uint64{}, err
+	}
+	return Uint64From(value), nil
+}
+
+// ToInt converts to Int, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint16) ToInt() (Int, error) {
+	if !n.isValid {
+		return NullInt(), nil
+	}
+	value, err := convertInteger[int](n.realValue)
+	if err != nil {
+		return Int{}, err
+	}
+	return IntFrom(value), nil
+}
+
+// ToInt8 converts to Int8, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint16) ToInt8() (Int8, error) {
+	if !n.isValid {
+		return NullInt8(), nil
+	}
+	value, err := convertInteger[int8](n.realValue)
+	if err != nil {
+		return Int8{}, err
+	}
+	return Int8From(value), nil
+}
+
+// ToInt16 converts to Int16, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint16) ToInt16() (Int16, error) {
+	if !n.isValid {
+		return NullInt16(), nil
+	}
+	value, err := convertInteger[int16](n.realValue)
+	if err != nil {
+		return Int16{}, err
+	}
+	return Int16From(value), nil
+}
+
+// ToInt32 converts to Int32, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint16) ToInt32() (Int32, error) {
+	if !n.isValid {
+		return NullInt32(), nil
+	}
+	value, err := convertInteger[int32](n.realValue)
+	if err != nil {
+		return Int32{}, err
+	}
+	return Int32From(value), nil
+}
+
+// ToInt64 converts to Int64, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint16) ToInt64() (Int64, error) {
+	if !n.isValid {
+		return NullInt64(), nil
+	}
+	value, err := convertInteger[int64](n.realValue)
+	if err != nil {
+		return Int64{}, err
+	}
+	return Int64From(value), nil
+}
+
+// ToUint converts to Uint, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint16) ToUint() (Uint, error) {
+	if !n.isValid {
+		return NullUint(), nil
+	}
+	value, err := convertInteger[uint](n.realValue)
+	if err != nil {
+		return Uint{}, err
+	}
+	return UintFrom(value), nil
+}
+
+// ToUint8 converts to Uint8, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint16) ToUint8() (Uint8, error) {
+	if !n.isValid {
+		return NullUint8(), nil
+	}
+	value, err := convertInteger[uint8](n.realValue)
+	if err != nil {
+		return Uint8{}, err
+	}
+	return Uint8From(value), nil
+}
+
+// ToUint32 converts to Uint32, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint16) ToUint32() (Uint32, error) {
+	if !n.isValid {
+		return NullUint32(), nil
+	}
+	value, err := convertInteger[uint32](n.realValue)
+	if err != nil {
+		return Uint32{}, err
+	}
+	return Uint32From(value), nil
+}
+
+// ToUint64 converts to Uint64, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint16) ToUint64() (Uint64, error) {
+	if !n.isValid {
+		return NullUint64(), nil
+	}
+	value, err := convertInteger[uint64](n.realValue)
+	if err != nil {
+		return Uint64{}, err
+	}
+	return Uint64From(value), nil
+}
+
+// ToInt converts to Int, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint32) ToInt() (Int, error) {
+	if !n.isValid {
+		return NullInt(), nil
+	}
+	value, err := convertInteger[int](n.realValue)
+	if err != nil {
+		return Int{}, err
+	}
+	return IntFrom(value), nil
+}
+
+// ToInt8 converts to Int8, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint32) ToInt8() (Int8, error) {
+	if !n.isValid {
+		return NullInt8(), nil
+	}
+	value, err := convertInteger[int8](n.realValue)
+	if err != nil {
+		return Int8{}, err
+	}
+	return Int8From(value), nil
+}
+
+// ToInt16 converts to Int16, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint32) ToInt16() (Int16, error) {
+	if !n.isValid {
+		return NullInt16(), nil
+	}
+	value, err := convertInteger[int16](n.realValue)
+	if err != nil {
+		return Int16{}, err
+	}
+	return Int16From(value), nil
+}
+
+// ToInt32 converts to Int32, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint32) ToInt32() (Int32, error) {
+	if !n.isValid {
+		return NullInt32(), nil
+	}
+	value, err := convertInteger[int32](n.realValue)
+	if err != nil {
+		return Int32{}, err
+	}
+	return Int32From(value), nil
+}
+
+// ToInt64 converts to Int64, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint32) ToInt64() (Int64, error) {
+	if !n.isValid {
+		return NullInt64(), nil
+	}
+	value, err := convertInteger[int64](n.realValue)
+	if err != nil {
+		return Int64{}, err
+	}
+	return Int64From(value), nil
+}
+
+// ToUint converts to Uint, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint32) ToUint() (Uint, error) {
+	if !n.isValid {
+		return NullUint(), nil
+	}
+	value, err := convertInteger[uint](n.realValue)
+	if err != nil {
+		return Uint{}, err
+	}
+	return UintFrom(value), nil
+}
+
+// ToUint8 converts to Uint8, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint32) ToUint8() (Uint8, error) {
+	if !n.isValid {
+		return NullUint8(), nil
+	}
+	value, err := convertInteger[uint8](n.realValue)
+	if err != nil {
+		return Uint8{}, err
+	}
+	return Uint8From(value), nil
+}
+
+// ToUint16 converts to Uint16, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint32) ToUint16() (Uint16, error) {
+	if !n.isValid {
+		return NullUint16(), nil
+	}
+	value, err := convertInteger[uint16](n.realValue)
+	if err != nil {
+		return Uint16{}, err
+	}
+	return Uint16From(value), nil
+}
+
+// ToUint64 converts to Uint64, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint32) ToUint64() (Uint64, error) {
+	if !n.isValid {
+		return NullUint64(), nil
+	}
+	value, err := convertInteger[uint64](n.realValue)
+	if err != nil {
+		return Uint64{}, err
+	}
+	return Uint64From(value), nil
+}
+
+// ToInt converts to Int, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint64) ToInt() (Int, error) {
+	if !n.isValid {
+		return NullInt(), nil
+	}
+	value, err := convertInteger[int](n.realValue)
+	if err != nil {
+		return Int{}, err
+	}
+	return IntFrom(value), nil
+}
+
+// ToInt8 converts to Int8, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint64) ToInt8() (Int8, error) {
+	if !n.isValid {
+		return NullInt8(), nil
+	}
+	value, err := convertInteger[int8](n.realValue)
+	if err != nil {
+		return Int8{}, err
+	}
+	return Int8From(value), nil
+}
+
+// ToInt16 converts to Int16, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint64) ToInt16() (Int16, error) {
+	if !n.isValid {
+		return NullInt16(), nil
+	}
+	value, err := convertInteger[int16](n.realValue)
+	if err != nil {
+		return Int16{}, err
+	}
+	return Int16From(value), nil
+}
+
+// ToInt32 converts to Int32, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint64) ToInt32() (Int32, error) {
+	if !n.isValid {
+		return NullInt32(), nil
+	}
+	value, err := convertInteger[int32](n.realValue)
+	if err != nil {
+		return Int32{}, err
+	}
+	return Int32From(value), nil
+}
+
+// ToInt64 converts to Int64, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint64) ToInt64() (Int64, error) {
+	if !n.isValid {
+		return NullInt64(), nil
+	}
+	value, err := convertInteger[int64](n.realValue)
+	if err != nil {
+		return Int64{}, err
+	}
+	return Int64From(value), nil
+}
+
+// ToUint converts to Uint, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint64) ToUint() (Uint, error) {
+	if !n.isValid {
+		return NullUint(), nil
+	}
+	value, err := convertInteger[uint](n.realValue)
+	if err != nil {
+		return Uint{}, err
+	}
+	return UintFrom(value), nil
+}
+
+// ToUint8 converts to Uint8, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint64) ToUint8() (Uint8, error) {
+	if !n.isValid {
+		return NullUint8(), nil
+	}
+	value, err := convertInteger[uint8](n.realValue)
+	if err != nil {
+		return Uint8{}, err
+	}
+	return Uint8From(value), nil
+}
+
+// ToUint16 converts to Uint16, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint64) ToUint16() (Uint16, error) {
+	if !n.isValid {
+		return NullUint16(), nil
+	}
+	value, err := convertInteger[uint16](n.realValue)
+	if err != nil {
+		return Uint16{}, err
+	}
+	return Uint16From(value), nil
+}
+
+// ToUint32 converts to Uint32, failing when the value doesn't fit. NULL stays NULL.
+func (n Uint64) ToUint32() (Uint32, error) {
+	if !n.isValid {
+		return NullUint32(), nil
+	}
+	value, err := convertInteger[uint32](n.realValue)
+	if err != nil {
+		return Uint32{}, err
+	}
+	return Uint32From(value), nil
+}
@@ -0,0 +1,73 @@
+package nullable_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestConvertNarrowing(t *testing.T) {
+	fits, err := nullable.Uint64From(math.MaxInt32).ToInt32()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, fits, nullable.Int32From(math.MaxInt32))
+
+	if _, err := nullable.Uint64From(math.MaxInt32 + 1).ToInt32(); err == nil {
+		t.Error("converting MaxInt32 + 1 into Int32 must fail")
+	}
+	if _, err := nullable.Uint64From(math.MaxUint64).ToInt64(); err == nil {
+		t.Error("converting MaxUint64 into Int64 must fail")
+	}
+
+	smallest, err := nullable.Int64From(math.MinInt8).ToInt8()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, smallest, nullable.Int8From(math.MinInt8))
+
+	if _, err := nullable.Int64From(math.MinInt8 - 1).ToInt8(); err == nil {
+		t.Error("converting MinInt8 - 1 into Int8 must fail")
+	}
+	if _, err := nullable.Int16From(256).ToUint8(); err == nil {
+		t.Error("converting 256 into Uint8 must fail")
+	}
+}
+
+func TestConvertSign(t *testing.T) {
+	if _, err := nullable.Int8From(-1).ToUint64(); err == nil {
+		t.Error("converting -1 into Uint64 must fail")
+	}
+	if _, err := nullable.Int64From(math.MinInt64).ToUint(); err == nil {
+		t.Error("converting MinInt64 into Uint must fail")
+	}
+	if _, err := nullable.Uint8From(math.MaxUint8).ToInt8(); err == nil {
+		t.Error("converting MaxUint8 into Int8 must fail")
+	}
+
+	zero, err := nullable.Int32From(0).ToUint16()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, zero, nullable.Uint16From(0))
+}
+
+func TestConvertWidening(t *testing.T) {
+	widened, err := nullable.Int16From(math.MinInt16).ToInt64()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, widened, nullable.Int64From(math.MinInt16))
+
+	unsigned, err := nullable.Uint32From(math.MaxUint32).ToUint64()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, unsigned, nullable.Uint64From(math.MaxUint32))
+
+	signed, err := nullable.Uint32From(math.MaxUint32).ToInt64()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, signed, nullable.Int64From(math.MaxUint32))
+}
+
+func TestConvertNull(t *testing.T) {
+	converted, err := nullable.NullUint64().ToInt8()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, converted, nullable.NullInt8())
+
+	widened, err := nullable.NullInt16().ToInt64()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, widened, nullable.NullInt64())
+}
@@ -0,0 +1,48 @@
+package nullable
+
+import (
+	"encoding/hex"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// copyNull is NULL in the text format of PostgreSQL COPY
+const copyNull = `\N`
+
+// copyEscaper escapes text with backslashes the way COPY FROM reads it back,
+// so a tab or newline inside the value doesn't end the field or row
+var copyEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"\t", `\t`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\b", `\b`,
+	"\f", `\f`,
+	"\v", `\v`,
+)
+
+// copyText escapes text for a field of COPY text format
+func copyText(text string) string {
+	return copyEscaper.Replace(text)
+}
+
+// copyBytes writes value in the hex format of bytea, its backslash escaped
+// for COPY
+func copyBytes(value []byte) string {
+	return `\\x` + hex.EncodeToString(value)
+}
+
+// copyFloat writes value for a real or double precision column, which
+// unlike JSON can hold NaN and the infinities
+func copyFloat(value float64, bitSize int) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "Infinity"
+	case math.IsInf(value, -1):
+		return "-Infinity"
+	}
+	return strconv.FormatFloat(value, 'g', -1, bitSize)
+}
@@ -0,0 +1,117 @@
+package nullable_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/shopspring/decimal"
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+type copyTexter interface {
+	CopyText() (string, bool)
+}
+
+func TestCopyText(t *testing.T) {
+	cases := []struct {
+		value  copyTexter
+		text   string
+		isNull bool
+	}{
+		{nullable.StringFrom("plain"), "plain", false},
+		{nullable.StringFrom("tab\there"), `tab\there`, false},
+		{nullable.StringFrom("two\nlines\r\n"), `two\nlines\r\n`, false},
+		{nullable.StringFrom(`C:\temp`), `C:\\temp`, false},
+		{nullable.StringFrom(`\N`), `\\N`, false},
+		{nullable.StringFrom(""), "", false},
+		{nullable.NullString(), `\N`, true},
+		{nullable.StringTrimmedFrom("a\tb"), `a\tb`, false},
+		{nullable.BytesFrom([]byte{0x0, 0x9, 0xa, 0x5c, 0xff}), `\\x00090a5cff`, false},
+		{nullable.BytesFrom([]byte{}), `\\x`, false},
+		{nullable.NullBytes(), `\N`, true},
+		{nullable.JSONFrom(json.RawMessage("{\n\t\"path\": \"a\\\\b\"\n}")), `{\n\t"path": "a\\\\b"\n}`, false},
+		{nullable.BoolFrom(true), "true", false},
+		{nullable.Int8From(-128), "-128", false},
+		{nullable.Uint64From(math.MaxUint64), "18446744073709551615", false},
+		{nullable.Float64From(0.1), "0.1", false},
+		{nullable.Float64From(math.Inf(-1)), "-Infinity", false},
+		{nullable.Float32From(float32(math.NaN())), "NaN", false},
+		{nullable.DecimalFrom(decimal.RequireFromString("12.50")), "12.50", false},
+		{nullable.TimeFrom(time.Date(2021, time.March, 4, 5, 6, 7, 8, time.FixedZone("", 3600))), "2021-03-04T04:06:07.000000008Z", false},
+		{nullable.DateFrom(time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)), "2021-03-04", false},
+		{nullable.Int64ArrayFrom([]int64{1, -2}), "{1,-2}", false},
+		{nullable.NullUint64(), `\N`, true},
+	}
+	for _, c := range cases {
+		text, isNull := c.value.CopyText()
+		tests.AssertEqual(t, text, c.text)
+		tests.AssertEqual(t, isNull, c.isNull)
+	}
+}
+
+func TestCopy(t *testing.T) {
+	if !SupportedDriver("postgres") {
+		t.Skip("COPY needs a PostgreSQL connection")
+	}
+
+	sqlDB, err := DB.DB()
+	if err != nil {
+		t.Fatalf("Cannot get database connection: %v", err)
+	}
+	conn, err := sqlDB.Conn(context.Background())
+	if err != nil {
+		t.Fatalf("Cannot get database connection: %v", err)
+	}
+	defer conn.Close()
+
+	rows := []struct {
+		note    nullable.String
+		payload nullable.Bytes
+	}{
+		{nullable.StringFrom("tab\tnew\nline\\"), nullable.BytesFrom([]byte{0x0, 0x9, 0x5c})},
+		{nullable.StringFrom(`\N`), nullable.BytesFrom([]byte{})},
+		{nullable.NullString(), nullable.NullBytes()},
+	}
+	var input strings.Builder
+	for _, row := range rows {
+		note, _ := row.note.CopyText()
+		payload, _ := row.payload.CopyText()
+		input.WriteString(note + "\t" + payload + "\n")
+	}
+
+	err = conn.Raw(func(driverConn interface{}) error {
+		pgConn := driverConn.(*stdlib.Conn).Conn().PgConn()
+		ctx := context.Background()
+		if _, err := pgConn.Exec(ctx, "CREATE TEMPORARY TABLE test_nullable_copy (position serial, note text, payload bytea)").ReadAll(); err != nil {
+			return err
+		}
+		_, err := pgConn.CopyFrom(ctx, strings.NewReader(input.String()), "COPY test_nullable_copy (note, payload) FROM STDIN")
+		return err
+	})
+	if err != nil {
+		t.Fatalf("COPY failed: %v", err)
+	}
+	defer conn.ExecContext(context.Background(), "DROP TABLE test_nullable_copy")
+
+	result, err := conn.QueryContext(context.Background(), "SELECT note, payload FROM test_nullable_copy ORDER BY position")
+	if err != nil {
+		t.Fatalf("Cannot read copied rows: %v", err)
+	}
+	defer result.Close()
+	for _, row := range rows {
+		var note nullable.String
+		var payload nullable.Bytes
+		if !result.Next() {
+			t.Fatalf("Missing copied row of %v", row.note)
+		}
+		tests.AssertEqual(t, result.Scan(&note, &payload), nil)
+		tests.AssertEqual(t, note, row.note)
+		tests.AssertEqual(t, payload, row.payload)
+	}
+}
@@ -0,0 +1,131 @@
+package nullable
+
+import (
+	"log/slog"
+	"strconv"
+)
+
+// core holds the value and NULL state every nullable type embeds, with the
+// accessors they share. Types that check, copy or truncate the value on the
+// way in or out override Get or Set, and then Swap as well, since Swap here
+// only sees the methods of core.
+type core[T any] struct {
+	realValue T
+	isValid   bool
+}
+
+// newCore creates a core holding *value, NULL when value is nil
+func newCore[T any](value *T) core[T] {
+	if value == nil {
+		return core[T]{}
+	}
+	return core[T]{realValue: *value, isValid: true}
+}
+
+// Get either nil or value
+func (n core[T]) Get() *T {
+	if !n.isValid {
+		return nil
+	}
+	return &n.realValue
+}
+
+// Unwrap returns value and true, or zero value and false when NULL, the
+// comma-ok way without the allocation of Get
+func (n core[T]) Unwrap() (T, bool) {
+	if !n.isValid {
+		var zero T
+		return zero, false
+	}
+	return n.realValue, true
+}
+
+// Set either nil or value
+func (n *core[T]) Set(value *T) {
+	*n = newCore(value)
+}
+
+// Swap sets either nil or value like Set does and returns the previous
+// value, nil when it was NULL
+func (n *core[T]) Swap(value *T) *T {
+	previous := n.Get()
+	n.Set(value)
+	return previous
+}
+
+// SetValue sets value and marks it as not NULL
+func (n *core[T]) SetValue(value T) {
+	n.realValue = value
+	n.isValid = true
+}
+
+// SetNull marks the value as NULL
+func (n *core[T]) SetNull() {
+	*n = core[T]{}
+}
+
+// IsValid reports whether the value is not NULL
+func (n core[T]) IsValid() bool {
+	return n.isValid
+}
+
+// IsNull reports whether the value is NULL
+func (n core[T]) IsNull() bool {
+	return !n.isValid
+}
+
+// IsZero reports whether the value is NULL, so `json:",omitzero"` omits it.
+// A valid zero value is not zero here.
+func (n core[T]) IsZero() bool {
+	return !n.isValid
+}
+
+// Validate runs fns on the value unless NULL and joins their errors, nil
+// when all of them pass or the value is NULL
+func (n core[T]) Validate(fns ...func(T) error) error {
+	return validate(n.isValid, n.realValue, fns)
+}
+
+// GetOr either fallback or value
+func (n core[T]) GetOr(fallback T) T {
+	if !n.isValid {
+		return fallback
+	}
+	return n.realValue
+}
+
+// GetOrZero either zero value or value
+func (n core[T]) GetOrZero() T {
+	var zero T
+	return n.GetOr(zero)
+}
+
+// mustGet is MustGet, panicking with name when NULL
+func (n core[T]) mustGet(name string) T {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL " + name)
+	}
+	return n.realValue
+}
+
+// LogValue implements slog.LogValuer, logging NULL as nil and the value as
+// slog.AnyValue does, except a float32 keeps the digits of String, 3.14
+// rather than 3.140000104904175
+func (n core[T]) LogValue() slog.Value {
+	if !n.isValid {
+		return slog.AnyValue(nil)
+	}
+	if value, ok := any(n.realValue).(float32); ok {
+		rounded, _ := strconv.ParseFloat(strconv.FormatFloat(float64(value), 'g', -1, 32), 64)
+		return slog.Float64Value(rounded)
+	}
+	return slog.AnyValue(n.realValue)
+}
+
+// logText is LogValue for types logged as their text, nil when NULL
+func logText(isValid bool, text string) slog.Value {
+	if !isValid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(text)
+}
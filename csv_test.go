@@ -0,0 +1,74 @@
+package nullable_test
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+type csvMarshaler interface {
+	MarshalCSV() (string, error)
+}
+
+func marshalUnmarshalCSV[T csvMarshaler, P interface {
+	*T
+	UnmarshalCSV(string) error
+}](t *testing.T, target T) {
+	cell, err := target.MarshalCSV()
+	if err != nil {
+		t.Fatalf("Failed to marshal %T because: %s", target, err)
+		return
+	}
+
+	var unserialized T
+	if err := P(&unserialized).UnmarshalCSV(cell); err != nil {
+		t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+		return
+	}
+	tests.AssertEqual(t, unserialized, target)
+}
+
+func TestCSVReport(t *testing.T) {
+	report := "name,age,score\ncat,9,3.5\ndog,,\n"
+	records, err := csv.NewReader(strings.NewReader(report)).ReadAll()
+	tests.AssertEqual(t, err, nil)
+
+	type row struct {
+		Name  nullable.String
+		Age   nullable.Uint8
+		Score nullable.Float64
+	}
+	var rows []row
+	for _, record := range records[1:] {
+		var r row
+		tests.AssertEqual(t, r.Name.UnmarshalCSV(record[0]), nil)
+		tests.AssertEqual(t, r.Age.UnmarshalCSV(record[1]), nil)
+		tests.AssertEqual(t, r.Score.UnmarshalCSV(record[2]), nil)
+		rows = append(rows, r)
+	}
+	tests.AssertEqual(t, rows, []row{
+		{nullable.StringFrom("cat"), nullable.Uint8From(9), nullable.Float64From(3.5)},
+		{nullable.StringFrom("dog"), nullable.NullUint8(), nullable.NullFloat64()},
+	})
+
+	var output strings.Builder
+	writer := csv.NewWriter(&output)
+	for _, r := range rows {
+		name, _ := r.Name.MarshalCSV()
+		age, _ := r.Age.MarshalCSV()
+		score, _ := r.Score.MarshalCSV()
+		tests.AssertEqual(t, writer.Write([]string{name, age, score}), nil)
+	}
+	writer.Flush()
+	tests.AssertEqual(t, output.String(), "cat,9,3.5\ndog,,\n")
+}
+
+func TestUnmarshalCSVMalformed(t *testing.T) {
+	var age nullable.Uint8
+	if err := age.UnmarshalCSV("nine"); err == nil {
+		t.Error("unmarshalling malformed CSV cell must fail")
+	}
+}
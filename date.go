@@ -0,0 +1,551 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"strings"
+	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Date SQL type that can retrieve NULL value
+type Date struct {
+	core[time.Time]
+}
+
+// NewDate creates a new nullable date
+func NewDate(value *time.Time) Date {
+	if value == nil {
+		return Date{}
+	}
+	truncated := truncateDate(*value)
+	return Date{core: newCore(&truncated)}
+}
+
+// DateFrom creates a new valid nullable date from value
+func DateFrom(value time.Time) Date {
+	return NewDate(&value)
+}
+
+// NullDate creates a new NULL date
+func NullDate() Date {
+	return NewDate(nil)
+}
+
+// DateZeroAsNull creates a new nullable date that is NULL when value is the zero value
+func DateZeroAsNull(value time.Time) Date {
+	if value.IsZero() {
+		return NullDate()
+	}
+	return DateFrom(value)
+}
+
+// CoalesceDate returns the first valid value, or NULL when all of them are NULL
+func CoalesceDate(values ...Date) Date {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return Date{}
+}
+
+// MinDate returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinDate(values ...Date) Date {
+	var result Date
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// MaxDate returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxDate(values ...Date) Date {
+	var result Date
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// DatePtr returns n.Get(), handy where a function value is needed
+func DatePtr(n Date) *time.Time {
+	return n.Get()
+}
+
+// DateValue returns n.GetOrZero(), zero value when NULL
+func DateValue(n Date) time.Time {
+	return n.GetOrZero()
+}
+
+// ParseDate parses text like UnmarshalText does, empty text is NULL
+func ParseDate(text string) (Date, error) {
+	var n Date
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Date{}, err
+	}
+	return n, nil
+}
+
+// MustParseDate is ParseDate that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseDate(text string) Date {
+	n, err := ParseDate(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseDate(%q): %v", text, err))
+	}
+	return n
+}
+
+// Set either nil or date
+func (n *Date) Set(value *time.Time) {
+	n.isValid = (value != nil)
+	if n.isValid {
+		n.realValue = truncateDate(*value)
+	} else {
+		n.realValue = time.Time{}
+	}
+}
+
+// Swap sets either nil or date like Set does and returns the previous value,
+// nil when it was NULL
+func (n *Date) Swap(value *time.Time) *time.Time {
+	previous := n.Get()
+	n.Set(value)
+	return previous
+}
+
+// SetValue sets date and marks it as not NULL
+func (n *Date) SetValue(value time.Time) {
+	n.realValue = truncateDate(value)
+	n.isValid = true
+}
+
+// Reset sets the value back to the zero NULL Date, the one a declared
+// Date starts with, so pooled values can be reused
+func (n *Date) Reset() {
+	*n = Date{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Date) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Date) NullIfZero() {
+	if n.isValid && n.realValue.IsZero() {
+		n.SetNull()
+	}
+}
+
+// MustGet either date or panic when NULL
+func (n Date) MustGet() time.Time {
+	return n.mustGet("Date")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Date) Clone() Date {
+	return n
+}
+
+// String returns date in its natural text form, or "<null>" when NULL
+func (n Date) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return n.realValue.Format(time.DateOnly)
+}
+
+// DebugString returns Date(value) or Date(NULL), telling NULL apart from a
+// zero value in logs
+func (n Date) DebugString() string {
+	return debugString("Date", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same date
+func (n Date) Equal(other Date) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue.Equal(other.realValue)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Date) Changed(old Date) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any date.
+func (n Date) Compare(other Date) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
+	}
+	return n.realValue.Compare(other.realValue)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL the instant like Equal compares it, telling NULL apart from zero in
+// cache keys
+func (n Date) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagDate, n.isValid, hashTime(n.realValue))
+}
+
+// MarshalJSON converts current value to JSON
+func (n Date) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.realValue.Format(time.DateOnly))
+}
+
+// UnmarshalJSON writes JSON to this type
+func (n *Date) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return jsonError(err)
+	}
+	parsed, err := parseDate(text)
+	if err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Date) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(n.realValue.Format(time.DateOnly)), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Date) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
+
+	parsed, err := parseDate(string(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Date) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Date) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Date) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return n.realValue.Format(time.DateOnly), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Date) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue.Format(time.DateOnly), nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Date) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
+
+	var text string
+	if err := value.Decode(&text); err != nil {
+		return err
+	}
+	parsed, err := parseDate(text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n Date) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue.Format(time.DateOnly), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Date) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
+
+	parsed, err := parseDate(strings.TrimSpace(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Date) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(n.realValue.Format(time.DateOnly))
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Date) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
+
+	text, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+	parsed, err := parseDate(text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Date) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue.Format(time.DateOnly))
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Date) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
+
+	if t != bson.TypeString {
+		return bsonTypeError(t, "Date")
+	}
+	text, _, ok := bsoncore.ReadString(data)
+	if !ok {
+		return bsonTypeError(t, "Date")
+	}
+	parsed, err := parseDate(text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Date) GobEncode() ([]byte, error) {
+	if !n.isValid {
+		return gobEncode(false, nil)
+	}
+	return gobEncode(true, n.realValue.Format(time.DateOnly))
+}
+
+// GobDecode writes gob to this type
+func (n *Date) GobDecode(data []byte) error {
+	var text string
+	isValid, err := gobDecode(data, &text)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
+
+	parsed, err := parseDate(text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// Scan implements scanner interface, any time of day is dropped
+func (n *Date) Scan(value interface{}) error {
+	value = scanValue(value)
+	if value == nil {
+		n.realValue, n.isValid = time.Time{}, false
+		return nil
+	}
+
+	var scanned time.Time
+	switch value := plainValue(value).(type) {
+	case time.Time:
+		scanned = value
+	case []byte:
+		parsed, err := parseScannedTime(string(value))
+		if err != nil {
+			return scanError(err)
+		}
+		scanned = parsed
+	case string:
+		parsed, err := parseScannedTime(value)
+		if err != nil {
+			return scanError(err)
+		}
+		scanned = parsed
+	default:
+		if err := convertAssign(&scanned, value); err != nil {
+			return scanError(err)
+		}
+	}
+	n.realValue = truncateDate(scanned)
+
+	n.isValid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n Date) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue.Format(time.DateOnly), nil
+}
+
+// GormDataType gorm common data type
+func (Date) GormDataType() string {
+	return "date_null"
+}
+
+// GormDBDataType gorm db data type
+func (n Date) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Date) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Date) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
+	case "sqlite", "mysql", "sqlserver":
+		return "DATE"
+	case "postgres", "cockroachdb":
+		return "date"
+	case "clickhouse":
+		return "Nullable(Date32)"
+	}
+	return ""
+}
+
+// truncateDate keeps year, month, and day of value as midnight UTC
+func truncateDate(value time.Time) time.Time {
+	if value.IsZero() {
+		return time.Time{}
+	}
+	year, month, day := value.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// parseDate parses "2006-01-02"
+func parseDate(text string) (time.Time, error) {
+	return time.Parse(time.DateOnly, text)
+}
@@ -0,0 +1,376 @@
+package nullable_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanDate(t *testing.T) {
+	nullableDate := nullable.NewDate(nil)
+
+	tests.AssertEqual(t, nullableDate.Scan(time.Date(2021, time.March, 4, 23, 59, 59, 0, time.UTC)), nil)
+	tests.AssertEqual(t, nullableDate.Get(), time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC))
+
+	tests.AssertEqual(t, nullableDate.Scan("2021-03-05"), nil)
+	tests.AssertEqual(t, nullableDate.Get(), time.Date(2021, time.March, 5, 0, 0, 0, 0, time.UTC))
+
+	tests.AssertEqual(t, nullableDate.Scan([]byte("2021-03-06 00:00:00")), nil)
+	tests.AssertEqual(t, nullableDate.Get(), time.Date(2021, time.March, 6, 0, 0, 0, 0, time.UTC))
+
+	// Date of the time in its own location, not in UTC
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	tests.AssertEqual(t, nullableDate.Scan(time.Date(2021, time.March, 7, 1, 0, 0, 0, jakarta)), nil)
+	tests.AssertEqual(t, nullableDate.Get(), time.Date(2021, time.March, 7, 0, 0, 0, 0, time.UTC))
+
+	if err := nullableDate.Scan("tomorrow"); err == nil {
+		t.Error("scanning malformed date must fail")
+	}
+
+	tests.AssertEqual(t, nullableDate.Scan(nil), nil)
+	tests.AssertEqual(t, nullableDate.Get(), nil)
+}
+
+func TestNewDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	nullableDate1 := nullable.NewDate(&basic)
+	tests.AssertEqual(t, nullableDate1.Get(), basic)
+
+	nullableDate2 := nullable.NewDate(nil)
+	tests.AssertEqual(t, nullableDate2.Get(), nil)
+}
+
+func TestSetDate(t *testing.T) {
+	nullableDate := nullable.NewDate(nil)
+	tests.AssertEqual(t, nullableDate.Get(), nil)
+
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	nullableDate.Set(&basic)
+	tests.AssertEqual(t, nullableDate.Get(), basic)
+
+	nullableDate.Set(nil)
+	tests.AssertEqual(t, nullableDate.Get(), nil)
+}
+
+func TestDateFrom(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	tests.AssertEqual(t, nullable.DateFrom(basic), nullable.NewDate(&basic))
+	tests.AssertEqual(t, nullable.DateFrom(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullDate(), nullable.NewDate(nil))
+	tests.AssertEqual(t, nullable.NullDate().IsNull(), true)
+}
+
+func TestCoalesceDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	var zero time.Time = time.Time{}
+	tests.AssertEqual(t, nullable.CoalesceDate(), nullable.Date{})
+	tests.AssertEqual(t, nullable.CoalesceDate(nullable.NullDate(), nullable.NullDate()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceDate(nullable.NullDate(), nullable.DateFrom(basic), nullable.DateFrom(zero)), nullable.DateFrom(basic))
+	tests.AssertEqual(t, nullable.CoalesceDate(nullable.DateFrom(zero), nullable.DateFrom(basic)), nullable.DateFrom(zero))
+}
+
+func TestIsZeroDate(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullDate().IsZero(), true)
+	tests.AssertEqual(t, nullable.DateFrom(time.Time{}).IsZero(), false)
+	tests.AssertEqual(t, nullable.DateFrom(time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)).IsZero(), false)
+}
+
+func TestCloneDate(t *testing.T) {
+	tests.AssertEqual(t, nullable.DateFrom(time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)).Clone(), nullable.DateFrom(time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)))
+	tests.AssertEqual(t, nullable.NullDate().Clone(), nullable.NullDate())
+}
+
+func TestUnwrapDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	value, ok := nullable.DateFrom(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullDate().Unwrap()
+	tests.AssertEqual(t, value, time.Time{})
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Date
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, time.Time{})
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseDate(t *testing.T) {
+	parsed, err := nullable.ParseDate("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullDate())
+
+	text, err := nullable.DateFrom(time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseDate(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.DateFrom(time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC))), true)
+
+	for _, text := range []string{"abc"} {
+		if _, err := nullable.ParseDate(text); err == nil {
+			t.Errorf("parsing %q as date must fail", text)
+		}
+	}
+}
+
+func TestMustParseDate(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseDate(""), nullable.NullDate())
+
+	text, err := nullable.DateFrom(time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseDate(string(text)).Equal(nullable.DateFrom(time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC))), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseDate of malformed text must panic")
+		}
+	}()
+	nullable.MustParseDate("abc")
+}
+
+func TestPtrDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	tests.AssertEqual(t, nullable.DatePtr(nullable.NewDate(&basic)), basic)
+	tests.AssertEqual(t, nullable.DatePtr(nullable.NewDate(nil)), nil)
+	tests.AssertEqual(t, nullable.DateValue(nullable.NewDate(&basic)), basic)
+	tests.AssertEqual(t, nullable.DateValue(nullable.NewDate(nil)), time.Time{})
+}
+
+func TestSetValueDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	var nullableDate nullable.Date
+	nullableDate.SetValue(basic)
+	tests.AssertEqual(t, nullableDate, nullable.DateFrom(basic))
+
+	nullableDate.SetNull()
+	tests.AssertEqual(t, nullableDate, nullable.NullDate())
+}
+
+func TestZeroAsNullDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	var zero time.Time = time.Time{}
+	tests.AssertEqual(t, nullable.DateZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.DateZeroAsNull(basic), nullable.DateFrom(basic))
+
+	nullableDate := nullable.DateFrom(zero)
+	nullableDate.NullIfZero()
+	tests.AssertEqual(t, nullableDate, nullable.NullDate())
+
+	nullableDate = nullable.DateFrom(basic)
+	nullableDate.NullIfZero()
+	tests.AssertEqual(t, nullableDate, nullable.DateFrom(basic))
+}
+
+func TestIsNullDate(t *testing.T) {
+	var zero nullable.Date
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	nullableDate := nullable.NewDate(&basic)
+	tests.AssertEqual(t, nullableDate.IsNull(), false)
+	tests.AssertEqual(t, nullableDate.IsValid(), true)
+
+	nullableDate.Set(nil)
+	tests.AssertEqual(t, nullableDate.IsNull(), true)
+	tests.AssertEqual(t, nullableDate.IsValid(), false)
+}
+
+func TestGetOrDate(t *testing.T) {
+	var zero nullable.Date
+	var fallback time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), time.Time{})
+
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	nullableDate := nullable.NewDate(&basic)
+	tests.AssertEqual(t, nullableDate.GetOr(time.Time{}), basic)
+	tests.AssertEqual(t, nullableDate.GetOrZero(), basic)
+}
+
+func TestMustGetDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	nullableDate := nullable.NewDate(&basic)
+	tests.AssertEqual(t, nullableDate.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Date")
+	}()
+	nullableDate.Set(nil)
+	nullableDate.MustGet()
+	t.Error("MustGet on NULL Date must panic")
+}
+
+func TestTextDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	marshalUnmarshalText(t, nullable.NewDate(&basic))
+
+	marshalUnmarshalText(t, nullable.NewDate(nil))
+}
+
+func TestCSVDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	marshalUnmarshalCSV(t, nullable.NewDate(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewDate(nil))
+}
+
+func TestYAMLDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	marshalUnmarshalYAML(t, nullable.NewDate(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewDate(nil))
+}
+
+func TestMsgpackDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	marshalUnmarshalMsgpack(t, nullable.NewDate(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewDate(nil))
+}
+
+func TestBSONDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	marshalUnmarshalBSON(t, nullable.NewDate(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewDate(nil))
+}
+
+func TestGobDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	marshalUnmarshalGob(t, nullable.NewDate(&basic))
+
+	var zero time.Time = time.Time{}
+	marshalUnmarshalGob(t, nullable.NewDate(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewDate(nil))
+}
+
+func TestStringerDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	nullableDate := nullable.NewDate(&basic)
+	tests.AssertEqual(t, nullableDate.String(), "2021-03-04")
+	tests.AssertEqual(t, fmt.Sprint(nullableDate), "2021-03-04")
+
+	nullableDate.Set(nil)
+	tests.AssertEqual(t, nullableDate.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableDate), "<null>")
+}
+
+func TestChangedDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	value, null := nullable.NewDate(&basic), nullable.NewDate(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.DateFrom(time.Time{})), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewDate(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewDate(nil)), false)
+}
+
+func TestEqualDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	var zero time.Time = time.Time{}
+	tests.AssertEqual(t, nullable.NewDate(nil).Equal(nullable.NewDate(nil)), true)
+	tests.AssertEqual(t, nullable.NewDate(nil).Equal(nullable.NewDate(&basic)), false)
+	tests.AssertEqual(t, nullable.NewDate(&basic).Equal(nullable.NewDate(nil)), false)
+	tests.AssertEqual(t, nullable.NewDate(&basic).Equal(nullable.NewDate(&basic)), true)
+	tests.AssertEqual(t, nullable.NewDate(&basic).Equal(nullable.NewDate(&zero)), false)
+	tests.AssertEqual(t, nullable.NewDate(&zero).Equal(nullable.NewDate(nil)), false)
+
+	// Time of day is never part of a date
+	evening := time.Date(2021, time.March, 4, 21, 30, 0, 0, time.UTC)
+	tests.AssertEqual(t, nullable.NewDate(&evening).Equal(nullable.NewDate(&basic)), true)
+	tests.AssertEqual(t, nullable.NewDate(&evening).Compare(nullable.NewDate(&basic)), 0)
+	tests.AssertEqual(t, nullable.NewDate(&evening), nullable.NewDate(&basic))
+}
+
+func TestCompareDate(t *testing.T) {
+	var lesser time.Time = time.Date(2020, time.December, 31, 0, 0, 0, 0, time.UTC)
+	var greater time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	null := nullable.NewDate(nil)
+	small := nullable.NewDate(&lesser)
+	big := nullable.NewDate(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewDate(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewDate(&greater)), 0)
+
+	values := []nullable.Date{big, null, small}
+	slices.SortFunc(values, nullable.Date.Compare)
+	tests.AssertEqual(t, values, []nullable.Date{null, small, big})
+}
+
+func TestXMLDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	marshalUnmarshalXML(t, nullable.NewDate(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewDate(nil))
+}
+
+func TestJSONDate(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	marshalUnmarshalJSON(t, nullable.NewDate(&basic))
+
+	marshalUnmarshalJSON(t, nullable.NewDate(nil))
+
+	serialized, err := nullable.NewDate(&basic).MarshalJSON()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `"2021-03-04"`)
+
+	var unserialized nullable.Date
+	tests.AssertEqual(t, unserialized.UnmarshalJSON([]byte(`"2021-03-04"`)), nil)
+	tests.AssertEqual(t, unserialized.Get(), basic)
+
+	if err := unserialized.UnmarshalJSON([]byte(`"2021-03-04T05:06:07Z"`)); err == nil {
+		t.Error("unmarshalling timestamp into date must fail")
+	}
+	if err := unserialized.UnmarshalJSON([]byte(`"04/03/2021"`)); err == nil {
+		t.Error("unmarshalling malformed date must fail")
+	}
+}
+
+func TestDate(t *testing.T) {
+	type TestNullableDate struct {
+		ID       uint
+		Name     string
+		Birthday nullable.Date
+	}
+
+	DB.Migrator().DropTable(&TestNullableDate{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableDate{}); err != nil {
+		t.Errorf("failed to migrate nullable date, got error: %v", err)
+	}
+
+	var basic time.Time = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	present := TestNullableDate{
+		Name:     "present",
+		Birthday: nullable.NewDate(&basic),
+	}
+	DB.Create(&present)
+
+	missing := TestNullableDate{
+		Name:     "missing",
+		Birthday: nullable.NewDate(nil),
+	}
+	DB.Create(&missing)
+
+	var result1 TestNullableDate
+	if err := DB.First(&result1, "name = ?", "present").Error; err != nil {
+		t.Fatal("Cannot read date test record of \"present\"")
+	}
+	tests.AssertEqual(t, result1, present)
+
+	var result2 TestNullableDate
+	if err := DB.First(&result2, "name = ?", "missing").Error; err != nil {
+		t.Fatal("Cannot read date test record of \"missing\"")
+	}
+	tests.AssertEqual(t, result2, missing)
+}
@@ -0,0 +1,66 @@
+package nullable_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+type debugStringer interface {
+	DebugString() string
+}
+
+func TestDebugString(t *testing.T) {
+	paid := orderPaid
+	cases := []struct {
+		value    debugStringer
+		expected string
+	}{
+		{nullable.Uint64From(42), "Uint64(42)"},
+		{nullable.Uint64From(0), "Uint64(0)"},
+		{nullable.NullUint64(), "Uint64(NULL)"},
+		{nullable.Uint64From(math.MaxUint64), "Uint64(18446744073709551615)"},
+		{nullable.Int8From(-1), "Int8(-1)"},
+		{nullable.BoolFrom(false), "Bool(false)"},
+		{nullable.NullBool(), "Bool(NULL)"},
+		{nullable.StringFrom(""), `String("")`},
+		{nullable.StringFrom("<null>"), `String("<null>")`},
+		{nullable.NullString(), "String(NULL)"},
+		{nullable.StringTrimmedFrom("a "), `StringTrimmed("a ")`},
+		{nullable.NullStringTrimmed(), "StringTrimmed(NULL)"},
+		{nullable.StringEmptyForNullFrom(""), `StringEmptyForNull("")`},
+		{nullable.NullStringEmptyForNull(), "StringEmptyForNull(NULL)"},
+		{nullable.TimeFrom(time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)), "Time(2021-03-04T05:06:07Z)"},
+		{nullable.NullTime(), "Time(NULL)"},
+		{nullable.DurationFrom(90 * time.Minute), "Duration(1h30m0s)"},
+		{nullable.DurationISOFrom(90 * time.Minute), "DurationISO(PT1H30M)"},
+		{nullable.NullDurationISO(), "DurationISO(NULL)"},
+		{nullable.BigIntFromInt64(-7), "BigInt(-7)"},
+		{nullable.MustParseMoney("12.34 USD"), "Money(12.34 USD)"},
+		{nullable.PointFrom(13.4, 52.5), "Point(SRID=4326;POINT(13.4 52.5))"},
+		{nullable.NullPoint(), "Point(NULL)"},
+		{nullable.RuneFrom('A'), "Rune('A')"},
+		{nullable.NullRune(), "Rune(NULL)"},
+		{nullable.Int64ArrayFrom([]int64{}), "Int64Array({})"},
+		{nullable.NullInt64Array(), "Int64Array(NULL)"},
+		{nullable.NullableFrom(3), "Nullable[int](3)"},
+		{nullable.NewNullable[int](nil), "Nullable[int](NULL)"},
+		{nullable.SliceFrom([]string{"a"}), "Slice[string]([a])"},
+		{nullable.NullSlice[string](), "Slice[string](NULL)"},
+		{nullable.MapOfFrom(map[string]int{"b": 2, "a": 1}), "MapOf[string,int](map[a:1 b:2])"},
+		{nullable.NullMapOf[string, int](), "MapOf[string,int](NULL)"},
+		{nullable.NewEnum(orderStatuses, &paid), `Enum[nullable_test.orderStatus]("paid")`},
+		{nullable.NullEnum(orderStatuses), "Enum[nullable_test.orderStatus](NULL)"},
+	}
+	for _, c := range cases {
+		tests.AssertEqual(t, c.value.DebugString(), c.expected)
+	}
+
+	// String and the other paths are left alone
+	tests.AssertEqual(t, fmt.Sprint(nullable.NullUint64()), "<null>")
+	tests.AssertEqual(t, nullable.StringFrom("").String(), "")
+}
@@ -0,0 +1,539 @@
+package nullable
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"log/slog"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Decimal SQL type that can retrieve NULL value
+type Decimal struct {
+	core[decimal.Decimal]
+}
+
+// NewDecimal creates a new nullable decimal
+func NewDecimal(value *decimal.Decimal) Decimal {
+	return Decimal{core: newCore(value)}
+}
+
+// DecimalFrom creates a new valid nullable decimal from value
+func DecimalFrom(value decimal.Decimal) Decimal {
+	return NewDecimal(&value)
+}
+
+// NullDecimal creates a new NULL decimal
+func NullDecimal() Decimal {
+	return NewDecimal(nil)
+}
+
+// DecimalZeroAsNull creates a new nullable decimal that is NULL when value is the zero value
+func DecimalZeroAsNull(value decimal.Decimal) Decimal {
+	if value.IsZero() {
+		return NullDecimal()
+	}
+	return DecimalFrom(value)
+}
+
+// CoalesceDecimal returns the first valid value, or NULL when all of them are NULL
+func CoalesceDecimal(values ...Decimal) Decimal {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return Decimal{}
+}
+
+// MinDecimal returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinDecimal(values ...Decimal) Decimal {
+	var result Decimal
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// MaxDecimal returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxDecimal(values ...Decimal) Decimal {
+	var result Decimal
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// DecimalPtr returns n.Get(), handy where a function value is needed
+func DecimalPtr(n Decimal) *decimal.Decimal {
+	return n.Get()
+}
+
+// DecimalValue returns n.GetOrZero(), zero value when NULL
+func DecimalValue(n Decimal) decimal.Decimal {
+	return n.GetOrZero()
+}
+
+// ParseDecimal parses text like UnmarshalText does, empty text is NULL
+func ParseDecimal(text string) (Decimal, error) {
+	var n Decimal
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Decimal{}, err
+	}
+	return n, nil
+}
+
+// MustParseDecimal is ParseDecimal that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseDecimal(text string) Decimal {
+	n, err := ParseDecimal(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseDecimal(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL Decimal, the one a declared
+// Decimal starts with, letting go of the held decimal so pooled values can be
+// reused
+func (n *Decimal) Reset() {
+	*n = Decimal{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Decimal) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Decimal) NullIfZero() {
+	if n.isValid && n.realValue.IsZero() {
+		n.SetNull()
+	}
+}
+
+// MustGet either decimal or panic when NULL
+func (n Decimal) MustGet() decimal.Decimal {
+	return n.mustGet("Decimal")
+}
+
+// Clone returns a copy of the value that shares no memory with n
+func (n Decimal) Clone() Decimal {
+	n.realValue = n.realValue.Copy()
+	return n
+}
+
+// String returns decimal in its natural text form, or "<null>" when NULL
+func (n Decimal) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return decimalText(n.realValue)
+}
+
+// DebugString returns Decimal(value) or Decimal(NULL), telling NULL apart from a
+// zero value in logs
+func (n Decimal) DebugString() string {
+	return debugString("Decimal", n.isValid, n.String())
+}
+
+// LogValue implements slog.LogValuer, logging NULL as nil and the value as
+// its digits as a String, so no precision is lost
+func (n Decimal) LogValue() slog.Value {
+	return logText(n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same decimal
+func (n Decimal) Equal(other Decimal) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue.Equal(other.realValue)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Decimal) Changed(old Decimal) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any decimal.
+func (n Decimal) Compare(other Decimal) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
+	}
+	return n.realValue.Cmp(other.realValue)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL its digits without trailing zeros, so 1.0 and 1.00 hash alike,
+// telling NULL apart from zero in cache keys
+func (n Decimal) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagDecimal, n.isValid, []byte(n.realValue.String()))
+}
+
+// MarshalJSON converts current value to JSON
+func (n Decimal) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	// Emit a bare JSON number regardless of decimal.MarshalJSONWithoutQuotes
+	return []byte(decimalText(n.realValue)), nil
+}
+
+// UnmarshalJSON writes JSON to this type
+func (n *Decimal) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = decimal.Decimal{}
+		return nil
+	}
+
+	// decimal.Decimal accepts both quoted and unquoted numbers
+	var parsed decimal.Decimal
+	if err := parsed.UnmarshalJSON(data); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Decimal) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(decimalText(n.realValue)), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Decimal) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = decimal.Decimal{}
+		return nil
+	}
+
+	parsed, err := decimal.NewFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Decimal) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Decimal) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Decimal) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return decimalText(n.realValue), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Decimal) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Decimal) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = decimal.Decimal{}
+		return nil
+	}
+
+	var parsed decimal.Decimal
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n Decimal) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(decimalText(n.realValue), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Decimal) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = decimal.Decimal{}
+		return nil
+	}
+
+	parsed, err := decimal.NewFromString(strings.TrimSpace(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Decimal) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(decimalText(n.realValue))
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Decimal) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = decimal.Decimal{}
+		return nil
+	}
+
+	text, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+	parsed, err := decimal.NewFromString(text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Decimal) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	parsed, err := primitive.ParseDecimal128(decimalText(n.realValue))
+	if err != nil {
+		return 0, nil, err
+	}
+	return bson.MarshalValue(parsed)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Decimal) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = decimal.Decimal{}
+		return nil
+	}
+
+	var text string
+	switch t {
+	case bson.TypeDecimal128:
+		value, _, ok := bsoncore.ReadDecimal128(data)
+		if !ok {
+			return bsonTypeError(t, "Decimal")
+		}
+		text = value.String()
+	case bson.TypeString:
+		var ok bool
+		if text, _, ok = bsoncore.ReadString(data); !ok {
+			return bsonTypeError(t, "Decimal")
+		}
+	default:
+		return bsonTypeError(t, "Decimal")
+	}
+
+	parsed, err := decimal.NewFromString(text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Decimal) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Decimal) GobDecode(data []byte) error {
+	var parsed decimal.Decimal
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = decimal.Decimal{}
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// Scan implements scanner interface
+func (n *Decimal) Scan(value interface{}) error {
+	return scanError(n.ScanContext(context.Background(), value))
+}
+
+// ScanContext is Scan that gives up with ctx.Err(), leaving the value
+// unchanged, when ctx is done before parsing starts
+func (n *Decimal) ScanContext(ctx context.Context, value interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	value = scanValue(value)
+	if value == nil {
+		n.realValue, n.isValid = decimal.Decimal{}, false
+		return nil
+	}
+
+	// decimal.Decimal understands string, []byte, int64, and float64
+	var scanned decimal.Decimal
+	if err := scanned.Scan(plainValue(value)); err != nil {
+		return scanError(err)
+	}
+	n.realValue = scanned
+
+	n.isValid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n Decimal) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	// String keeps the full precision, unlike float64
+	return decimalText(n.realValue), nil
+}
+
+// decimalText formats value keeping its trailing zeros, so 1.10 is written
+// back as 1.10 and not as the 1.1 decimal.Decimal.String gives
+func decimalText(value decimal.Decimal) string {
+	if value.Exponent() >= 0 {
+		return value.String()
+	}
+	return value.StringFixed(-value.Exponent())
+}
+
+// GormDataType gorm common data type
+func (Decimal) GormDataType() string {
+	return "decimal_null"
+}
+
+// GormDBDataType gorm db data type
+func (n Decimal) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Decimal) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Decimal) columnType(dialect string, field *schema.Field) string {
+	precision, scale := 65, 30
+	if field != nil && field.Precision > 0 {
+		precision, scale = field.Precision, field.Scale
+	}
+
+	switch dialect {
+	case "sqlite", "mysql":
+		return fmt.Sprintf("DECIMAL(%d,%d)", precision, scale)
+	case "postgres", "cockroachdb":
+		if field == nil || field.Precision == 0 {
+			return "numeric"
+		}
+		return fmt.Sprintf("numeric(%d,%d)", precision, scale)
+	case "sqlserver":
+		if field == nil || field.Precision == 0 {
+			// SQL Server caps DECIMAL precision at 38
+			return "DECIMAL(38,18)"
+		}
+		return fmt.Sprintf("DECIMAL(%d,%d)", precision, scale)
+	case "clickhouse":
+		if field == nil || field.Precision == 0 {
+			return "Nullable(Decimal(38,18))"
+		}
+		return fmt.Sprintf("Nullable(Decimal(%d,%d))", precision, scale)
+	}
+	return ""
+}
@@ -0,0 +1,373 @@
+package nullable_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/schema"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanDecimal(t *testing.T) {
+	nullableDecimal := nullable.NewDecimal(nil)
+
+	tests.AssertEqual(t, nullableDecimal.Scan("12345678901234567890.123456789"), nil)
+	tests.AssertEqual(t, nullableDecimal.Get().String(), "12345678901234567890.123456789")
+
+	tests.AssertEqual(t, nullableDecimal.Scan([]byte("-0.001")), nil)
+	tests.AssertEqual(t, nullableDecimal.Get().String(), "-0.001")
+
+	tests.AssertEqual(t, nullableDecimal.Scan(int64(42)), nil)
+	tests.AssertEqual(t, nullableDecimal.Get().String(), "42")
+
+	tests.AssertEqual(t, nullableDecimal.Scan(12.34), nil)
+	tests.AssertEqual(t, nullableDecimal.Get().String(), "12.34")
+
+	if err := nullableDecimal.Scan("twelve"); err == nil {
+		t.Error("scanning malformed decimal must fail")
+	}
+
+	tests.AssertEqual(t, nullableDecimal.Scan(nil), nil)
+	tests.AssertEqual(t, nullableDecimal.Get(), nil)
+}
+
+func TestNewDecimal(t *testing.T) {
+	basicDecimal := decimal.RequireFromString("12.34")
+	nullableDecimal1 := nullable.NewDecimal(&basicDecimal)
+	tests.AssertEqual(t, nullableDecimal1.Get(), basicDecimal)
+
+	nullableDecimal2 := nullable.NewDecimal(nil)
+	tests.AssertEqual(t, nullableDecimal2.Get(), nil)
+}
+
+func TestSetDecimal(t *testing.T) {
+	nullableDecimal := nullable.NewDecimal(nil)
+	tests.AssertEqual(t, nullableDecimal.Get(), nil)
+
+	basicDecimal := decimal.RequireFromString("12.34")
+	nullableDecimal.Set(&basicDecimal)
+	tests.AssertEqual(t, nullableDecimal.Get(), basicDecimal)
+
+	nullableDecimal.Set(nil)
+	tests.AssertEqual(t, nullableDecimal.Get(), nil)
+}
+
+func TestScanContextDecimal(t *testing.T) {
+	nullableDecimal := nullable.NullDecimal()
+	tests.AssertEqual(t, nullableDecimal.ScanContext(context.Background(), "12.34"), nil)
+	tests.AssertEqual(t, nullableDecimal, nullable.DecimalFrom(decimal.RequireFromString("12.34")))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests.AssertEqual(t, nullableDecimal.ScanContext(ctx, "56.78"), context.Canceled)
+	tests.AssertEqual(t, nullableDecimal, nullable.DecimalFrom(decimal.RequireFromString("12.34")))
+
+	tests.AssertEqual(t, nullableDecimal.ScanContext(context.Background(), nil), nil)
+	tests.AssertEqual(t, nullableDecimal.IsNull(), true)
+}
+
+func TestScanJSONNumberDecimal(t *testing.T) {
+	nullableDecimal := nullable.NewDecimal(nil)
+
+	tests.AssertEqual(t, nullableDecimal.Scan(json.Number("12345678901234567890.123456789")), nil)
+	tests.AssertEqual(t, nullableDecimal.Get().String(), "12345678901234567890.123456789")
+}
+
+func TestValueDecimal(t *testing.T) {
+	basicDecimal := decimal.RequireFromString("0.1000000000000000000000000001")
+	value, err := nullable.NewDecimal(&basicDecimal).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, "0.1000000000000000000000000001")
+
+	value, err = nullable.NewDecimal(nil).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
+func TestTextDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	marshalUnmarshalText(t, nullable.NewDecimal(&basic))
+
+	marshalUnmarshalText(t, nullable.NewDecimal(nil))
+}
+
+func TestCSVDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	marshalUnmarshalCSV(t, nullable.NewDecimal(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewDecimal(nil))
+}
+
+func TestYAMLDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	marshalUnmarshalYAML(t, nullable.NewDecimal(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewDecimal(nil))
+}
+
+func TestMsgpackDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	marshalUnmarshalMsgpack(t, nullable.NewDecimal(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewDecimal(nil))
+}
+
+func TestBSONDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	marshalUnmarshalBSON(t, nullable.NewDecimal(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewDecimal(nil))
+}
+
+func TestGobDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	marshalUnmarshalGob(t, nullable.NewDecimal(&basic))
+
+	// decimal.Decimal{} decodes with its big.Int allocated, so compare values
+	var zero decimal.Decimal = decimal.Decimal{}
+	serialized, err := nullable.NewDecimal(&zero).GobEncode()
+	tests.AssertEqual(t, err, nil)
+	var decoded nullable.Decimal
+	tests.AssertEqual(t, decoded.GobDecode(serialized), nil)
+	tests.AssertEqual(t, decoded.Equal(nullable.NewDecimal(&zero)), true)
+
+	marshalUnmarshalGob(t, nullable.NewDecimal(nil))
+}
+
+func TestStringerDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	nullableDecimal := nullable.NewDecimal(&basic)
+	tests.AssertEqual(t, nullableDecimal.String(), "12.34")
+	tests.AssertEqual(t, fmt.Sprint(nullableDecimal), "12.34")
+
+	nullableDecimal.Set(nil)
+	tests.AssertEqual(t, nullableDecimal.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableDecimal), "<null>")
+}
+
+func TestChangedDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	value, null := nullable.NewDecimal(&basic), nullable.NewDecimal(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.DecimalFrom(decimal.Decimal{})), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewDecimal(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewDecimal(nil)), false)
+}
+
+func TestEqualDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	var zero decimal.Decimal = decimal.Decimal{}
+	tests.AssertEqual(t, nullable.NewDecimal(nil).Equal(nullable.NewDecimal(nil)), true)
+	tests.AssertEqual(t, nullable.NewDecimal(nil).Equal(nullable.NewDecimal(&basic)), false)
+	tests.AssertEqual(t, nullable.NewDecimal(&basic).Equal(nullable.NewDecimal(nil)), false)
+	tests.AssertEqual(t, nullable.NewDecimal(&basic).Equal(nullable.NewDecimal(&basic)), true)
+	tests.AssertEqual(t, nullable.NewDecimal(&basic).Equal(nullable.NewDecimal(&zero)), false)
+	tests.AssertEqual(t, nullable.NewDecimal(&zero).Equal(nullable.NewDecimal(nil)), false)
+}
+
+func TestCompareDecimal(t *testing.T) {
+	var lesser decimal.Decimal = decimal.RequireFromString("-12.34")
+	var greater decimal.Decimal = decimal.RequireFromString("12.34")
+	null := nullable.NewDecimal(nil)
+	small := nullable.NewDecimal(&lesser)
+	big := nullable.NewDecimal(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewDecimal(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewDecimal(&greater)), 0)
+
+	values := []nullable.Decimal{big, null, small}
+	slices.SortFunc(values, nullable.Decimal.Compare)
+	tests.AssertEqual(t, values, []nullable.Decimal{null, small, big})
+}
+
+func TestXMLDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	marshalUnmarshalXML(t, nullable.NewDecimal(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewDecimal(nil))
+}
+
+func TestDecimalFrom(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	tests.AssertEqual(t, nullable.DecimalFrom(basic), nullable.NewDecimal(&basic))
+	tests.AssertEqual(t, nullable.DecimalFrom(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullDecimal(), nullable.NewDecimal(nil))
+	tests.AssertEqual(t, nullable.NullDecimal().IsNull(), true)
+}
+
+func TestCoalesceDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	var zero decimal.Decimal = decimal.Decimal{}
+	tests.AssertEqual(t, nullable.CoalesceDecimal(), nullable.Decimal{})
+	tests.AssertEqual(t, nullable.CoalesceDecimal(nullable.NullDecimal(), nullable.NullDecimal()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceDecimal(nullable.NullDecimal(), nullable.DecimalFrom(basic), nullable.DecimalFrom(zero)), nullable.DecimalFrom(basic))
+	tests.AssertEqual(t, nullable.CoalesceDecimal(nullable.DecimalFrom(zero), nullable.DecimalFrom(basic)), nullable.DecimalFrom(zero))
+}
+
+func TestIsZeroDecimal(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullDecimal().IsZero(), true)
+	tests.AssertEqual(t, nullable.DecimalFrom(decimal.Decimal{}).IsZero(), false)
+	tests.AssertEqual(t, nullable.DecimalFrom(decimal.RequireFromString("12.34")).IsZero(), false)
+}
+
+func TestCloneDecimal(t *testing.T) {
+	tests.AssertEqual(t, nullable.DecimalFrom(decimal.RequireFromString("12.34")).Clone(), nullable.DecimalFrom(decimal.RequireFromString("12.34")))
+	tests.AssertEqual(t, nullable.NullDecimal().Clone(), nullable.NullDecimal())
+}
+
+func TestUnwrapDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	value, ok := nullable.DecimalFrom(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullDecimal().Unwrap()
+	tests.AssertEqual(t, value, decimal.Decimal{})
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Decimal
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, decimal.Decimal{})
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseDecimal(t *testing.T) {
+	parsed, err := nullable.ParseDecimal("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullDecimal())
+
+	text, err := nullable.DecimalFrom(decimal.RequireFromString("12.34")).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseDecimal(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.DecimalFrom(decimal.RequireFromString("12.34"))), true)
+
+	for _, text := range []string{"abc"} {
+		if _, err := nullable.ParseDecimal(text); err == nil {
+			t.Errorf("parsing %q as decimal must fail", text)
+		}
+	}
+}
+
+func TestMustParseDecimal(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseDecimal(""), nullable.NullDecimal())
+
+	text, err := nullable.DecimalFrom(decimal.RequireFromString("12.34")).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseDecimal(string(text)).Equal(nullable.DecimalFrom(decimal.RequireFromString("12.34"))), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseDecimal of malformed text must panic")
+		}
+	}()
+	nullable.MustParseDecimal("abc")
+}
+
+func TestPtrDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	tests.AssertEqual(t, nullable.DecimalPtr(nullable.NewDecimal(&basic)), basic)
+	tests.AssertEqual(t, nullable.DecimalPtr(nullable.NewDecimal(nil)), nil)
+	tests.AssertEqual(t, nullable.DecimalValue(nullable.NewDecimal(&basic)), basic)
+	tests.AssertEqual(t, nullable.DecimalValue(nullable.NewDecimal(nil)), decimal.Decimal{})
+}
+
+func TestSetValueDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	var nullableDecimal nullable.Decimal
+	nullableDecimal.SetValue(basic)
+	tests.AssertEqual(t, nullableDecimal, nullable.DecimalFrom(basic))
+
+	nullableDecimal.SetNull()
+	tests.AssertEqual(t, nullableDecimal, nullable.NullDecimal())
+}
+
+func TestZeroAsNullDecimal(t *testing.T) {
+	var basic decimal.Decimal = decimal.RequireFromString("12.34")
+	var zero decimal.Decimal = decimal.Decimal{}
+	tests.AssertEqual(t, nullable.DecimalZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.DecimalZeroAsNull(basic), nullable.DecimalFrom(basic))
+
+	nullableDecimal := nullable.DecimalFrom(zero)
+	nullableDecimal.NullIfZero()
+	tests.AssertEqual(t, nullableDecimal, nullable.NullDecimal())
+
+	nullableDecimal = nullable.DecimalFrom(basic)
+	nullableDecimal.NullIfZero()
+	tests.AssertEqual(t, nullableDecimal, nullable.DecimalFrom(basic))
+}
+
+func TestJSONDecimal(t *testing.T) {
+	basicDecimal := decimal.RequireFromString("12.34")
+	marshalUnmarshalJSON(t, nullable.NewDecimal(&basicDecimal))
+
+	marshalUnmarshalJSON(t, nullable.NewDecimal(nil))
+
+	serialized, err := nullable.NewDecimal(&basicDecimal).MarshalJSON()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "12.34")
+
+	var unserialized nullable.Decimal
+	tests.AssertEqual(t, unserialized.UnmarshalJSON([]byte(`"12.34"`)), nil)
+	tests.AssertEqual(t, unserialized.Get(), basicDecimal)
+}
+
+func TestDecimalDBDataType(t *testing.T) {
+	field := &schema.Field{Precision: 10, Scale: 2}
+	switch DB.Dialector.Name() {
+	case "sqlite", "mysql":
+		tests.AssertEqual(t, nullable.Decimal{}.GormDBDataType(DB, &schema.Field{}), "DECIMAL(65,30)")
+		tests.AssertEqual(t, nullable.Decimal{}.GormDBDataType(DB, field), "DECIMAL(10,2)")
+	case "postgres":
+		tests.AssertEqual(t, nullable.Decimal{}.GormDBDataType(DB, &schema.Field{}), "numeric")
+		tests.AssertEqual(t, nullable.Decimal{}.GormDBDataType(DB, field), "numeric(10,2)")
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	type TestNullableDecimal struct {
+		ID      uint
+		Name    string
+		Balance nullable.Decimal `gorm:"precision:20;scale:2"`
+	}
+
+	DB.Migrator().DropTable(&TestNullableDecimal{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableDecimal{}); err != nil {
+		t.Errorf("failed to migrate nullable decimal, got error: %v", err)
+	}
+
+	balance := decimal.RequireFromString("1234.56")
+	rich := TestNullableDecimal{
+		Name:    "rich",
+		Balance: nullable.NewDecimal(&balance),
+	}
+	DB.Create(&rich)
+
+	unknown := TestNullableDecimal{
+		Name:    "unknown",
+		Balance: nullable.NewDecimal(nil),
+	}
+	DB.Create(&unknown)
+
+	var result1 TestNullableDecimal
+	if err := DB.First(&result1, "name = ?", "rich").Error; err != nil {
+		t.Fatal("Cannot read decimal test record of \"rich\"")
+	}
+	tests.AssertEqual(t, result1.Balance.Get().Equal(balance), true)
+
+	var result2 TestNullableDecimal
+	if err := DB.First(&result2, "name = ?", "unknown").Error; err != nil {
+		t.Fatal("Cannot read decimal test record of \"unknown\"")
+	}
+	tests.AssertEqual(t, result2, unknown)
+}
@@ -0,0 +1,70 @@
+package nullable
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// CockroachDB is a GORM plugin telling nullable types that a DB connected
+// through the PostgreSQL dialector is really CockroachDB, so they pick its
+// column types and bind uint64 as is:
+//
+//	db, err := gorm.Open(postgres.Open(dsn))
+//	err = db.Use(nullable.CockroachDB{})
+//
+// A dialector whose Name is "cockroachdb" needs no plugin.
+type CockroachDB struct{}
+
+// Name returns the name the plugin is registered under
+func (CockroachDB) Name() string {
+	return "nullable:cockroachdb"
+}
+
+// Initialize is a no-op, being registered is all the plugin does
+func (CockroachDB) Initialize(*gorm.DB) error {
+	return nil
+}
+
+// dialectName returns the dialect of db, "cockroachdb" for a postgres DB
+// the CockroachDB plugin is registered on.
+func dialectName(db *gorm.DB) string {
+	name := db.Dialector.Name()
+	if name != "postgres" {
+		return name
+	}
+	if _, ok := db.Plugins[CockroachDB{}.Name()]; ok {
+		return "cockroachdb"
+	}
+	return name
+}
+
+// fieldSize returns the `size` GORM tag of field, 0 when absent
+func fieldSize(field *schema.Field) int {
+	if field == nil {
+		return 0
+	}
+	return field.Size
+}
+
+// fieldPrecision returns the `precision` GORM tag of field, 0 when absent
+func fieldPrecision(field *schema.Field) int {
+	if field == nil {
+		return 0
+	}
+	return field.Precision
+}
+
+// nullDefault returns what makes a column NULL with a NULL default, for
+// column types such as MySQL TIMESTAMP that otherwise get a non-NULL one.
+// A field with its own default keeps it, and a NOT NULL field gets nothing.
+func nullDefault(field *schema.Field) string {
+	switch {
+	case field == nil:
+		return " NULL DEFAULT NULL"
+	case field.NotNull:
+		return ""
+	case field.HasDefaultValue:
+		return " NULL"
+	}
+	return " NULL DEFAULT NULL"
+}
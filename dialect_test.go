@@ -0,0 +1,384 @@
+package nullable_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"gorm.io/gorm/schema"
+	"gorm.io/gorm/utils/tests"
+)
+
+type namedDialector struct {
+	gorm.Dialector
+	name string
+}
+
+func (d namedDialector) Name() string {
+	return d.name
+}
+
+// DialectDB returns a connection-less DB reporting name as its dialect,
+// good enough for the GormValue and GormDBDataType switches.
+func DialectDB(name string) *gorm.DB {
+	return &gorm.DB{Config: &gorm.Config{Dialector: namedDialector{DB.Dialector, name}}}
+}
+
+type gormDataTyper interface {
+	GormDBDataType(db *gorm.DB, field *schema.Field) string
+}
+
+type gormValuer interface {
+	GormValue(ctx context.Context, db *gorm.DB) clause.Expr
+}
+
+func TestSQLServerDataType(t *testing.T) {
+	db := DialectDB("sqlserver")
+	cases := []struct {
+		value    gormDataTyper
+		expected string
+	}{
+		{nullable.Bool{}, "BIT"},
+		{nullable.Byte{}, "BINARY(1)"},
+		{nullable.Bytes{}, "VARBINARY(MAX)"},
+		{nullable.Float32{}, "REAL"},
+		{nullable.Float64{}, "FLOAT"},
+		{nullable.Int{}, "BIGINT"},
+		{nullable.Int8{}, "SMALLINT"},
+		{nullable.Int16{}, "SMALLINT"},
+		{nullable.Int32{}, "INT"},
+		{nullable.Int64{}, "BIGINT"},
+		{nullable.String{}, "NVARCHAR(MAX)"},
+		{nullable.StringTrimmed{}, "NVARCHAR(MAX)"},
+		{nullable.StringEmptyForNull{}, "NVARCHAR(MAX)"},
+		{nullable.Time{}, "DATETIME2"},
+		{nullable.Uint{}, "DECIMAL(20,0)"},
+		{nullable.Uint8{}, "TINYINT"},
+		{nullable.Uint16{}, "INT"},
+		{nullable.Uint32{}, "BIGINT"},
+		{nullable.Uint64{}, "DECIMAL(20,0)"},
+		{nullable.UUID{}, "CHAR(36)"},
+		{nullable.Decimal{}, "DECIMAL(38,18)"},
+		{nullable.Duration{}, "BIGINT"},
+		{nullable.DurationISO{}, "BIGINT"},
+		{nullable.JSON{}, "NVARCHAR(MAX)"},
+		{nullable.Date{}, "DATE"},
+		{nullable.IP{}, "VARCHAR(45)"},
+		{nullable.URL{}, "TEXT"},
+		{nullable.BigInt{}, "DECIMAL(38,0)"},
+		{nullable.Slice[string]{}, "NVARCHAR(MAX)"},
+		{nullable.MapOf[string, string]{}, "NVARCHAR(MAX)"},
+		{nullable.Int64Array{}, "NVARCHAR(MAX)"},
+		{nullable.Uint64Array{}, "NVARCHAR(MAX)"},
+		{nullable.Money{}, "NVARCHAR(100)"},
+		{nullable.Point{}, "NVARCHAR(100)"},
+		{nullable.Rune{}, "INT"},
+		{nullable.Enum[string]{}, "NVARCHAR(255)"},
+	}
+	for _, c := range cases {
+		tests.AssertEqual(t, c.value.GormDBDataType(db, &schema.Field{}), c.expected)
+	}
+	tests.AssertEqual(t, nullable.Decimal{}.GormDBDataType(db, &schema.Field{Precision: 10, Scale: 2}), "DECIMAL(10,2)")
+}
+
+func TestSQLServerGormValue(t *testing.T) {
+	db := DialectDB("sqlserver")
+	cases := []struct {
+		value    gormValuer
+		expected interface{}
+	}{
+		{nullable.Int8From(-100), int64(-100)},
+		{nullable.Int16From(-12345), int64(-12345)},
+		{nullable.Int32From(-1234567), int64(-1234567)},
+		{nullable.UintFrom(50000000000), int64(50000000000)},
+		{nullable.Uint8From(200), int64(200)},
+		{nullable.Uint16From(60000), int64(60000)},
+		{nullable.Uint32From(4000000000), int64(4000000000)},
+		{nullable.Uint64From(18446744073709551615), "18446744073709551615"},
+	}
+	for _, c := range cases {
+		expr := c.value.GormValue(context.Background(), db)
+		tests.AssertEqual(t, expr.SQL, "?")
+		// AssertEqual falls back to comparing the printed values, 200 and "200" alike
+		if !reflect.DeepEqual(expr.Vars, []interface{}{c.expected}) {
+			t.Errorf("%T binds %#v, expected %#v", c.value, expr.Vars, []interface{}{c.expected})
+		}
+	}
+
+	expr := nullable.NullUint64().GormValue(context.Background(), db)
+	tests.AssertEqual(t, expr.SQL, "?")
+	tests.AssertEqual(t, expr.Vars, []interface{}{nil})
+	tests.AssertEqual(t, db.Error, nil)
+}
+
+func TestClickHouseDataType(t *testing.T) {
+	db := DialectDB("clickhouse")
+	cases := []struct {
+		value    gormDataTyper
+		expected string
+	}{
+		{nullable.Bool{}, "Nullable(Bool)"},
+		{nullable.Byte{}, "Nullable(FixedString(1))"},
+		{nullable.Bytes{}, "Nullable(String)"},
+		{nullable.Float32{}, "Nullable(Float32)"},
+		{nullable.Float64{}, "Nullable(Float64)"},
+		{nullable.Int{}, "Nullable(Int64)"},
+		{nullable.Int8{}, "Nullable(Int8)"},
+		{nullable.Int16{}, "Nullable(Int16)"},
+		{nullable.Int32{}, "Nullable(Int32)"},
+		{nullable.Int64{}, "Nullable(Int64)"},
+		{nullable.String{}, "Nullable(String)"},
+		{nullable.StringTrimmed{}, "Nullable(String)"},
+		{nullable.StringEmptyForNull{}, "Nullable(String)"},
+		{nullable.Time{}, "Nullable(DateTime64(6))"},
+		{nullable.Uint{}, "Nullable(UInt64)"},
+		{nullable.Uint8{}, "Nullable(UInt8)"},
+		{nullable.Uint16{}, "Nullable(UInt16)"},
+		{nullable.Uint32{}, "Nullable(UInt32)"},
+		{nullable.Uint64{}, "Nullable(UInt64)"},
+		{nullable.UUID{}, "Nullable(UUID)"},
+		{nullable.Decimal{}, "Nullable(Decimal(38,18))"},
+		{nullable.Duration{}, "Nullable(Int64)"},
+		{nullable.DurationISO{}, "Nullable(Int64)"},
+		{nullable.JSON{}, "Nullable(String)"},
+		{nullable.Date{}, "Nullable(Date32)"},
+		{nullable.IP{}, "Nullable(String)"},
+		{nullable.URL{}, "Nullable(String)"},
+		{nullable.BigInt{}, "Nullable(Decimal(76,0))"},
+		{nullable.Slice[string]{}, "Nullable(String)"},
+		{nullable.MapOf[string, string]{}, "Nullable(String)"},
+		{nullable.Int64Array{}, "Nullable(String)"},
+		{nullable.Uint64Array{}, "Nullable(String)"},
+		{nullable.Money{}, "Nullable(String)"},
+		{nullable.Point{}, "Nullable(String)"},
+		{nullable.Rune{}, "Nullable(Int32)"},
+		{nullable.Enum[string]{}, "Nullable(String)"},
+	}
+	for _, c := range cases {
+		tests.AssertEqual(t, c.value.GormDBDataType(db, &schema.Field{}), c.expected)
+	}
+	tests.AssertEqual(t, nullable.Decimal{}.GormDBDataType(db, &schema.Field{Precision: 10, Scale: 2}), "Nullable(Decimal(10,2))")
+}
+
+func TestClickHouseGormValue(t *testing.T) {
+	db := DialectDB("clickhouse")
+	cases := []struct {
+		value    gormValuer
+		expected interface{}
+	}{
+		{nullable.Int8From(-100), int8(-100)},
+		{nullable.Int16From(-12345), int16(-12345)},
+		{nullable.Int32From(-1234567), int32(-1234567)},
+		{nullable.UintFrom(50000000000), uint64(50000000000)},
+		{nullable.Uint8From(200), uint8(200)},
+		{nullable.Uint16From(60000), uint16(60000)},
+		{nullable.Uint32From(4000000000), uint32(4000000000)},
+		{nullable.Uint64From(18446744073709551615), uint64(18446744073709551615)},
+		{nullable.NullUint(), nil},
+		{nullable.NullUint8(), nil},
+		{nullable.NullInt32(), nil},
+	}
+	for _, c := range cases {
+		expr := c.value.GormValue(context.Background(), db)
+		tests.AssertEqual(t, expr.SQL, "?")
+		tests.AssertEqual(t, expr.Vars, []interface{}{c.expected})
+	}
+}
+
+func TestUnknownDialectGormValue(t *testing.T) {
+	db := DialectDB("unknown")
+	cases := []struct {
+		value    gormValuer
+		expected interface{}
+	}{
+		{nullable.Int8From(-100), int64(-100)},
+		{nullable.Int16From(-12345), int64(-12345)},
+		{nullable.Int32From(-1234567), int64(-1234567)},
+		{nullable.UintFrom(50000000000), int64(50000000000)},
+		{nullable.Uint8From(200), int64(200)},
+		{nullable.Uint16From(60000), int64(60000)},
+		{nullable.Uint32From(4000000000), int64(4000000000)},
+		{nullable.Uint64From(18446744073709551615), "18446744073709551615"},
+		{nullable.NullUint64(), nil},
+		{nullable.NullInt8(), nil},
+	}
+	for _, c := range cases {
+		expr := c.value.GormValue(context.Background(), db)
+		tests.AssertEqual(t, expr.SQL, "?")
+		// AssertEqual falls back to comparing the printed values, 200 and "200" alike
+		if !reflect.DeepEqual(expr.Vars, []interface{}{c.expected}) {
+			t.Errorf("%T binds %#v, expected %#v", c.value, expr.Vars, []interface{}{c.expected})
+		}
+	}
+	tests.AssertEqual(t, db.Error, nil)
+}
+
+// PluginDB returns a connection-less DB reporting name as its dialect with
+// plugins registered on it
+func PluginDB(t *testing.T, name string, plugins ...gorm.Plugin) *gorm.DB {
+	db := DialectDB(name)
+	db.Plugins = map[string]gorm.Plugin{}
+	for _, plugin := range plugins {
+		if err := db.Use(plugin); err != nil {
+			t.Fatalf("failed to register %s, got error %v", plugin.Name(), err)
+		}
+	}
+	return db
+}
+
+func TestCockroachDBDataType(t *testing.T) {
+	cockroach := PluginDB(t, "postgres", nullable.CockroachDB{})
+	postgres := PluginDB(t, "postgres")
+	explicit := DialectDB("cockroachdb")
+	cases := []struct {
+		value     gormDataTyper
+		cockroach string
+		postgres  string
+	}{
+		{nullable.Bool{}, "boolean", "boolean"},
+		{nullable.Int{}, "INT8", "bigint"},
+		{nullable.Int8{}, "INT2", "smallint"},
+		{nullable.Int16{}, "INT2", "smallint"},
+		{nullable.Int32{}, "INT4", "integer"},
+		{nullable.Int64{}, "INT8", "bigint"},
+		{nullable.String{}, "text", "text"},
+		{nullable.StringTrimmed{}, "text", "text"},
+		{nullable.StringEmptyForNull{}, "text", "text"},
+		{nullable.Time{}, "timestamp", "timestamp"},
+		{nullable.Uint{}, "bit(64)", "bit(64)"},
+		{nullable.Uint8{}, "INT2", "smallint"},
+		{nullable.Uint16{}, "INT4", "integer"},
+		{nullable.Uint32{}, "INT8", "bigint"},
+		{nullable.Uint64{}, "DECIMAL(20,0)", "numeric"},
+		{nullable.UUID{}, "uuid", "uuid"},
+		{nullable.Duration{}, "INT8", "bigint"},
+		{nullable.DurationISO{}, "INT8", "bigint"},
+		{nullable.JSON{}, "jsonb", "jsonb"},
+		{nullable.Date{}, "date", "date"},
+		{nullable.IP{}, "inet", "inet"},
+		{nullable.URL{}, "text", "text"},
+		{nullable.BigInt{}, "numeric", "numeric"},
+		{nullable.Slice[string]{}, "jsonb", "jsonb"},
+		{nullable.MapOf[string, string]{}, "jsonb", "jsonb"},
+		{nullable.Int64Array{}, "bigint[]", "bigint[]"},
+		{nullable.Uint64Array{}, "DECIMAL(20,0)[]", "numeric[]"},
+		{nullable.Money{}, "text", "text"},
+		{nullable.Point{}, "GEOMETRY(POINT,4326)", "geometry(Point,4326)"},
+		{nullable.Rune{}, "INT4", "integer"},
+		{nullable.Enum[string]{}, "text", "text"},
+	}
+	for _, c := range cases {
+		tests.AssertEqual(t, c.value.GormDBDataType(cockroach, &schema.Field{}), c.cockroach)
+		tests.AssertEqual(t, c.value.GormDBDataType(explicit, &schema.Field{}), c.cockroach)
+		tests.AssertEqual(t, c.value.GormDBDataType(postgres, &schema.Field{}), c.postgres)
+	}
+}
+
+func TestCockroachDBGormValue(t *testing.T) {
+	db := PluginDB(t, "postgres", nullable.CockroachDB{})
+	var maxValue uint64 = 18446744073709551615
+	expr := nullable.NewUint64(&maxValue).GormValue(context.Background(), db)
+	tests.AssertEqual(t, expr.SQL, "?")
+	tests.AssertEqual(t, expr.Vars, []interface{}{maxValue})
+
+	expr = nullable.NullInt16().GormValue(context.Background(), db)
+	tests.AssertEqual(t, expr.Vars, []interface{}{nil})
+	tests.AssertEqual(t, db.Error, nil)
+}
+
+func TestMigrateNullableColumns(t *testing.T) {
+	type TestNullableColumns struct {
+		ID       uint
+		BigInt   nullable.BigInt
+		Bool     nullable.Bool
+		Bytes    nullable.Bytes
+		Date     nullable.Date
+		Decimal  nullable.Decimal
+		Duration nullable.Duration
+		Float64  nullable.Float64
+		Int64    nullable.Int64
+		IP       nullable.IP
+		JSON     nullable.JSON
+		String   nullable.String
+		Time     nullable.Time
+		Uint64   nullable.Uint64
+		URL      nullable.URL
+		UUID     nullable.UUID
+		Created  nullable.Time   `gorm:"default:CURRENT_TIMESTAMP"`
+		Required nullable.String `gorm:"not null;default:''"`
+	}
+
+	DB.Migrator().DropTable(&TestNullableColumns{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableColumns{}); err != nil {
+		t.Fatalf("failed to migrate nullable columns, got error: %v", err)
+	}
+
+	columnTypes, err := DB.Migrator().ColumnTypes(&TestNullableColumns{})
+	if err != nil {
+		t.Fatalf("failed to read column types, got error: %v", err)
+	}
+	for _, columnType := range columnTypes {
+		name := columnType.Name()
+		if name == "id" {
+			continue
+		}
+		isNullable, ok := columnType.Nullable()
+		if !ok {
+			t.Fatalf("driver does not report whether %q is nullable", name)
+		}
+		tests.AssertEqual(t, isNullable, name != "required")
+
+		defaultValue, hasDefault := columnType.DefaultValue()
+		switch name {
+		case "created":
+			tests.AssertEqual(t, defaultValue, "CURRENT_TIMESTAMP")
+		case "required":
+			tests.AssertEqual(t, hasDefault, true)
+		default:
+			if hasDefault && defaultValue != "" && defaultValue != "NULL" {
+				t.Errorf("column %q must default to NULL, got %q", name, defaultValue)
+			}
+		}
+	}
+
+	empty := TestNullableColumns{Required: nullable.StringFrom("set")}
+	if err := DB.Omit("Created").Create(&empty).Error; err != nil {
+		t.Fatalf("failed to create record, got error: %v", err)
+	}
+	var result TestNullableColumns
+	if err := DB.First(&result, empty.ID).Error; err != nil {
+		t.Fatalf("failed to read record, got error: %v", err)
+	}
+	tests.AssertEqual(t, result.Time.IsNull(), true)
+	tests.AssertEqual(t, result.Created.IsValid(), true)
+}
+
+func TestColumnType(t *testing.T) {
+	values := []interface {
+		gormDataTyper
+		ColumnType(dialect string) string
+	}{
+		nullable.BigInt{}, nullable.Bool{}, nullable.Byte{}, nullable.Bytes{}, nullable.Date{},
+		nullable.Decimal{}, nullable.Duration{}, nullable.Float32{}, nullable.Float64{},
+		nullable.Int{}, nullable.Int8{}, nullable.Int16{}, nullable.Int32{}, nullable.Int64{},
+		nullable.Int64Array{}, nullable.IP{}, nullable.JSON{}, nullable.String{},
+		nullable.StringTrimmed{}, nullable.Time{}, nullable.Uint{}, nullable.Uint8{},
+		nullable.Uint16{}, nullable.Uint32{}, nullable.Uint64{}, nullable.Uint64Array{},
+		nullable.URL{}, nullable.UUID{}, nullable.Slice[string]{}, nullable.Enum[string]{},
+		nullable.Money{}, nullable.Rune{}, nullable.DurationISO{}, nullable.MapOf[string, string]{},
+		nullable.StringEmptyForNull{}, nullable.Point{},
+	}
+	for _, value := range values {
+		for _, dialect := range []string{"mysql", "sqlite", "postgres", "cockroachdb", "sqlserver", "clickhouse"} {
+			tests.AssertEqual(t, value.ColumnType(dialect), value.GormDBDataType(DialectDB(dialect), &schema.Field{}))
+		}
+		tests.AssertEqual(t, value.ColumnType("oracle"), "")
+	}
+
+	tests.AssertEqual(t, nullable.Uint64{}.ColumnType("mysql"), "BIGINT UNSIGNED")
+	tests.AssertEqual(t, nullable.Uint64{}.ColumnType("postgres"), "numeric")
+	tests.AssertEqual(t, nullable.Time{}.ColumnType("mysql"), "TIMESTAMP NULL DEFAULT NULL")
+}
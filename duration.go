@@ -0,0 +1,485 @@
+package nullable
+
+import (
+	"cmp"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Duration SQL type that can retrieve NULL value
+type Duration struct {
+	core[time.Duration]
+}
+
+// NewDuration creates a new nullable duration
+func NewDuration(value *time.Duration) Duration {
+	return Duration{core: newCore(value)}
+}
+
+// DurationFrom creates a new valid nullable duration from value
+func DurationFrom(value time.Duration) Duration {
+	return NewDuration(&value)
+}
+
+// NullDuration creates a new NULL duration
+func NullDuration() Duration {
+	return NewDuration(nil)
+}
+
+// DurationZeroAsNull creates a new nullable duration that is NULL when value is the zero value
+func DurationZeroAsNull(value time.Duration) Duration {
+	if value == 0 {
+		return NullDuration()
+	}
+	return DurationFrom(value)
+}
+
+// CoalesceDuration returns the first valid value, or NULL when all of them are NULL
+func CoalesceDuration(values ...Duration) Duration {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return Duration{}
+}
+
+// MinDuration returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinDuration(values ...Duration) Duration {
+	var result Duration
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// MaxDuration returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxDuration(values ...Duration) Duration {
+	var result Duration
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// DurationPtr returns n.Get(), handy where a function value is needed
+func DurationPtr(n Duration) *time.Duration {
+	return n.Get()
+}
+
+// DurationValue returns n.GetOrZero(), zero value when NULL
+func DurationValue(n Duration) time.Duration {
+	return n.GetOrZero()
+}
+
+// ParseDuration parses text like UnmarshalText does, empty text is NULL
+func ParseDuration(text string) (Duration, error) {
+	var n Duration
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Duration{}, err
+	}
+	return n, nil
+}
+
+// MustParseDuration is ParseDuration that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseDuration(text string) Duration {
+	n, err := ParseDuration(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseDuration(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL Duration, the one a declared
+// Duration starts with, so pooled values can be reused
+func (n *Duration) Reset() {
+	*n = Duration{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Duration) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Duration) NullIfZero() {
+	if n.isValid && n.realValue == 0 {
+		n.SetNull()
+	}
+}
+
+// MustGet either duration or panic when NULL
+func (n Duration) MustGet() time.Duration {
+	return n.mustGet("Duration")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Duration) Clone() Duration {
+	return n
+}
+
+// String returns duration in its natural text form, or "<null>" when NULL
+func (n Duration) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return n.realValue.String()
+}
+
+// DebugString returns Duration(value) or Duration(NULL), telling NULL apart from a
+// zero value in logs
+func (n Duration) DebugString() string {
+	return debugString("Duration", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same duration
+func (n Duration) Equal(other Duration) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Duration) Changed(old Duration) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any duration.
+func (n Duration) Compare(other Duration) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL the nanoseconds, telling NULL apart from zero in cache keys
+func (n Duration) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagDuration, n.isValid, hashUint64(uint64(n.realValue)))
+}
+
+// MarshalJSON converts current value to JSON
+func (n Duration) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.realValue.String())
+}
+
+// UnmarshalJSON writes JSON to this type
+func (n *Duration) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed time.Duration
+	if strings.HasPrefix(strings.TrimSpace(string(data)), `"`) {
+		// Human readable form like "1h30m"
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return jsonError(err)
+		}
+		duration, err := time.ParseDuration(text)
+		if err != nil {
+			return jsonError(err)
+		}
+		parsed = duration
+	} else {
+		// Raw nanosecond count
+		var nanoseconds int64
+		if err := json.Unmarshal(data, &nanoseconds); err != nil {
+			return jsonError(err)
+		}
+		parsed = time.Duration(nanoseconds)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Duration) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(n.realValue.String()), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Duration) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := time.ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Duration) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Duration) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Duration) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return strconv.FormatInt(int64(n.realValue), 10), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Duration) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Duration) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed time.Duration
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n Duration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue.String(), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Duration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := time.ParseDuration(strings.TrimSpace(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Duration) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Duration) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed time.Duration
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Duration) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Duration) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed time.Duration
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Duration) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Duration) GobDecode(data []byte) error {
+	var parsed time.Duration
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// Scan implements scanner interface
+func (n *Duration) Scan(value interface{}) error {
+	value = scanValue(value)
+	if value == nil {
+		n.realValue, n.isValid = 0, false
+		return nil
+	}
+
+	var nanoseconds int64
+	if err := convertAssign(&nanoseconds, value); err != nil {
+		return scanError(err)
+	}
+	n.realValue = time.Duration(nanoseconds)
+
+	n.isValid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n Duration) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return int64(n.realValue), nil
+}
+
+// GormDataType gorm common data type
+func (Duration) GormDataType() string {
+	return "duration_null"
+}
+
+// GormDBDataType gorm db data type
+func (n Duration) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Duration) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Duration) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
+	case "sqlite", "mysql":
+		return "BIGINT"
+	case "postgres":
+		return "bigint"
+	case "sqlserver":
+		return "BIGINT"
+	case "clickhouse":
+		return "Nullable(Int64)"
+	case "cockroachdb":
+		return "INT8"
+	}
+	return ""
+}
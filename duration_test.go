@@ -0,0 +1,360 @@
+package nullable_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanDuration(t *testing.T) {
+	nullableDuration := nullable.NewDuration(nil)
+
+	tests.AssertEqual(t, nullableDuration.Scan(int64(90*time.Minute)), nil)
+	tests.AssertEqual(t, nullableDuration.Get(), 90*time.Minute)
+
+	tests.AssertEqual(t, nullableDuration.Scan("1500000000"), nil)
+	tests.AssertEqual(t, nullableDuration.Get(), 1500*time.Millisecond)
+
+	tests.AssertEqual(t, nullableDuration.Scan(nil), nil)
+	tests.AssertEqual(t, nullableDuration.Get(), nil)
+}
+
+func TestNewDuration(t *testing.T) {
+	basicDuration := 90 * time.Minute
+	nullableDuration1 := nullable.NewDuration(&basicDuration)
+	tests.AssertEqual(t, nullableDuration1.Get(), basicDuration)
+
+	nullableDuration2 := nullable.NewDuration(nil)
+	tests.AssertEqual(t, nullableDuration2.Get(), nil)
+}
+
+func TestSetDuration(t *testing.T) {
+	nullableDuration := nullable.NewDuration(nil)
+	tests.AssertEqual(t, nullableDuration.Get(), nil)
+
+	basicDuration := 90 * time.Minute
+	nullableDuration.Set(&basicDuration)
+	tests.AssertEqual(t, nullableDuration.Get(), basicDuration)
+
+	nullableDuration.Set(nil)
+	tests.AssertEqual(t, nullableDuration.Get(), nil)
+}
+
+func TestDurationFrom(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	tests.AssertEqual(t, nullable.DurationFrom(basic), nullable.NewDuration(&basic))
+	tests.AssertEqual(t, nullable.DurationFrom(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullDuration(), nullable.NewDuration(nil))
+	tests.AssertEqual(t, nullable.NullDuration().IsNull(), true)
+}
+
+func TestCoalesceDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	var zero time.Duration = 0
+	tests.AssertEqual(t, nullable.CoalesceDuration(), nullable.Duration{})
+	tests.AssertEqual(t, nullable.CoalesceDuration(nullable.NullDuration(), nullable.NullDuration()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceDuration(nullable.NullDuration(), nullable.DurationFrom(basic), nullable.DurationFrom(zero)), nullable.DurationFrom(basic))
+	tests.AssertEqual(t, nullable.CoalesceDuration(nullable.DurationFrom(zero), nullable.DurationFrom(basic)), nullable.DurationFrom(zero))
+}
+
+func TestIsZeroDuration(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullDuration().IsZero(), true)
+	tests.AssertEqual(t, nullable.DurationFrom(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.DurationFrom(90*time.Minute).IsZero(), false)
+}
+
+func TestCloneDuration(t *testing.T) {
+	tests.AssertEqual(t, nullable.DurationFrom(90*time.Minute).Clone(), nullable.DurationFrom(90*time.Minute))
+	tests.AssertEqual(t, nullable.NullDuration().Clone(), nullable.NullDuration())
+}
+
+func TestUnwrapDuration(t *testing.T) {
+	value, ok := nullable.DurationFrom(90 * time.Minute).Unwrap()
+	tests.AssertEqual(t, value, 90*time.Minute)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullDuration().Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Duration
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseDuration(t *testing.T) {
+	parsed, err := nullable.ParseDuration("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullDuration())
+
+	text, err := nullable.DurationFrom(90 * time.Minute).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseDuration(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.DurationFrom(90*time.Minute)), true)
+
+	for _, text := range []string{"abc"} {
+		if _, err := nullable.ParseDuration(text); err == nil {
+			t.Errorf("parsing %q as duration must fail", text)
+		}
+	}
+}
+
+func TestMustParseDuration(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseDuration(""), nullable.NullDuration())
+
+	text, err := nullable.DurationFrom(90 * time.Minute).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseDuration(string(text)).Equal(nullable.DurationFrom(90*time.Minute)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseDuration of malformed text must panic")
+		}
+	}()
+	nullable.MustParseDuration("abc")
+}
+
+func TestPtrDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	tests.AssertEqual(t, nullable.DurationPtr(nullable.NewDuration(&basic)), basic)
+	tests.AssertEqual(t, nullable.DurationPtr(nullable.NewDuration(nil)), nil)
+	tests.AssertEqual(t, nullable.DurationValue(nullable.NewDuration(&basic)), basic)
+	tests.AssertEqual(t, nullable.DurationValue(nullable.NewDuration(nil)), 0)
+}
+
+func TestSetValueDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	var nullableDuration nullable.Duration
+	nullableDuration.SetValue(basic)
+	tests.AssertEqual(t, nullableDuration, nullable.DurationFrom(basic))
+
+	nullableDuration.SetNull()
+	tests.AssertEqual(t, nullableDuration, nullable.NullDuration())
+}
+
+func TestZeroAsNullDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	var zero time.Duration = 0
+	tests.AssertEqual(t, nullable.DurationZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.DurationZeroAsNull(basic), nullable.DurationFrom(basic))
+
+	nullableDuration := nullable.DurationFrom(zero)
+	nullableDuration.NullIfZero()
+	tests.AssertEqual(t, nullableDuration, nullable.NullDuration())
+
+	nullableDuration = nullable.DurationFrom(basic)
+	nullableDuration.NullIfZero()
+	tests.AssertEqual(t, nullableDuration, nullable.DurationFrom(basic))
+}
+
+func TestIsNullDuration(t *testing.T) {
+	var zero nullable.Duration
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic time.Duration = 90 * time.Minute
+	nullableDuration := nullable.NewDuration(&basic)
+	tests.AssertEqual(t, nullableDuration.IsNull(), false)
+	tests.AssertEqual(t, nullableDuration.IsValid(), true)
+
+	nullableDuration.Set(nil)
+	tests.AssertEqual(t, nullableDuration.IsNull(), true)
+	tests.AssertEqual(t, nullableDuration.IsValid(), false)
+}
+
+func TestGetOrDuration(t *testing.T) {
+	var zero nullable.Duration
+	var fallback time.Duration = 90 * time.Minute
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), 0)
+
+	var basic time.Duration = 90 * time.Minute
+	nullableDuration := nullable.NewDuration(&basic)
+	tests.AssertEqual(t, nullableDuration.GetOr(0), basic)
+	tests.AssertEqual(t, nullableDuration.GetOrZero(), basic)
+}
+
+func TestMustGetDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	nullableDuration := nullable.NewDuration(&basic)
+	tests.AssertEqual(t, nullableDuration.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Duration")
+	}()
+	nullableDuration.Set(nil)
+	nullableDuration.MustGet()
+	t.Error("MustGet on NULL Duration must panic")
+}
+
+func TestTextDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	marshalUnmarshalText(t, nullable.NewDuration(&basic))
+
+	marshalUnmarshalText(t, nullable.NewDuration(nil))
+}
+
+func TestCSVDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	marshalUnmarshalCSV(t, nullable.NewDuration(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewDuration(nil))
+}
+
+func TestYAMLDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	marshalUnmarshalYAML(t, nullable.NewDuration(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewDuration(nil))
+}
+
+func TestMsgpackDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	marshalUnmarshalMsgpack(t, nullable.NewDuration(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewDuration(nil))
+}
+
+func TestBSONDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	marshalUnmarshalBSON(t, nullable.NewDuration(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewDuration(nil))
+}
+
+func TestGobDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	marshalUnmarshalGob(t, nullable.NewDuration(&basic))
+
+	var zero time.Duration = 0
+	marshalUnmarshalGob(t, nullable.NewDuration(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewDuration(nil))
+}
+
+func TestStringerDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	nullableDuration := nullable.NewDuration(&basic)
+	tests.AssertEqual(t, nullableDuration.String(), "1h30m0s")
+	tests.AssertEqual(t, fmt.Sprint(nullableDuration), "1h30m0s")
+
+	nullableDuration.Set(nil)
+	tests.AssertEqual(t, nullableDuration.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableDuration), "<null>")
+}
+
+func TestChangedDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	value, null := nullable.NewDuration(&basic), nullable.NewDuration(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.DurationFrom(0)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewDuration(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewDuration(nil)), false)
+}
+
+func TestEqualDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	var zero time.Duration = 0
+	tests.AssertEqual(t, nullable.NewDuration(nil).Equal(nullable.NewDuration(nil)), true)
+	tests.AssertEqual(t, nullable.NewDuration(nil).Equal(nullable.NewDuration(&basic)), false)
+	tests.AssertEqual(t, nullable.NewDuration(&basic).Equal(nullable.NewDuration(nil)), false)
+	tests.AssertEqual(t, nullable.NewDuration(&basic).Equal(nullable.NewDuration(&basic)), true)
+	tests.AssertEqual(t, nullable.NewDuration(&basic).Equal(nullable.NewDuration(&zero)), false)
+	tests.AssertEqual(t, nullable.NewDuration(&zero).Equal(nullable.NewDuration(nil)), false)
+}
+
+func TestCompareDuration(t *testing.T) {
+	var lesser time.Duration = time.Second
+	var greater time.Duration = 90 * time.Minute
+	null := nullable.NewDuration(nil)
+	small := nullable.NewDuration(&lesser)
+	big := nullable.NewDuration(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewDuration(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewDuration(&greater)), 0)
+
+	values := []nullable.Duration{big, null, small}
+	slices.SortFunc(values, nullable.Duration.Compare)
+	tests.AssertEqual(t, values, []nullable.Duration{null, small, big})
+}
+
+func TestXMLDuration(t *testing.T) {
+	var basic time.Duration = 90 * time.Minute
+	marshalUnmarshalXML(t, nullable.NewDuration(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewDuration(nil))
+}
+
+func TestJSONDuration(t *testing.T) {
+	basicDuration := 90 * time.Minute
+	marshalUnmarshalJSON(t, nullable.NewDuration(&basicDuration))
+
+	marshalUnmarshalJSON(t, nullable.NewDuration(nil))
+
+	serialized, err := nullable.NewDuration(&basicDuration).MarshalJSON()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `"1h30m0s"`)
+
+	var unserialized nullable.Duration
+	tests.AssertEqual(t, unserialized.UnmarshalJSON([]byte(`"1h30m"`)), nil)
+	tests.AssertEqual(t, unserialized.Get(), basicDuration)
+
+	tests.AssertEqual(t, unserialized.UnmarshalJSON([]byte("5400000000000")), nil)
+	tests.AssertEqual(t, unserialized.Get(), basicDuration)
+
+	if err := unserialized.UnmarshalJSON([]byte(`"ninety minutes"`)); err == nil {
+		t.Error("unmarshalling malformed duration must fail")
+	}
+	if err := unserialized.UnmarshalJSON([]byte("true")); err == nil {
+		t.Error("unmarshalling boolean into duration must fail")
+	}
+}
+
+func TestDuration(t *testing.T) {
+	type TestNullableDuration struct {
+		ID      uint
+		Name    string
+		Timeout nullable.Duration
+	}
+
+	DB.Migrator().DropTable(&TestNullableDuration{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableDuration{}); err != nil {
+		t.Errorf("failed to migrate nullable duration, got error: %v", err)
+	}
+
+	timeout := 90 * time.Second
+	slow := TestNullableDuration{
+		Name:    "slow",
+		Timeout: nullable.NewDuration(&timeout),
+	}
+	DB.Create(&slow)
+
+	forever := TestNullableDuration{
+		Name:    "forever",
+		Timeout: nullable.NewDuration(nil),
+	}
+	DB.Create(&forever)
+
+	var result1 TestNullableDuration
+	if err := DB.First(&result1, "name = ?", "slow").Error; err != nil {
+		t.Fatal("Cannot read duration test record of \"slow\"")
+	}
+	tests.AssertEqual(t, result1, slow)
+
+	var result2 TestNullableDuration
+	if err := DB.First(&result2, "name = ?", "forever").Error; err != nil {
+		t.Fatal("Cannot read duration test record of \"forever\"")
+	}
+	tests.AssertEqual(t, result2, forever)
+}
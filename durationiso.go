@@ -0,0 +1,374 @@
+package nullable
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// isoDuration lets DurationISO embed Duration under an unexported name, so
+// the embedded field does not clash with the promoted methods
+type isoDuration = Duration
+
+// DurationISO SQL type that can retrieve NULL value, stored as nanoseconds
+// like Duration but written to JSON, text, CSV, XML, and YAML as an ISO 8601
+// duration such as PT1H30M
+type DurationISO struct {
+	isoDuration
+}
+
+// NewDurationISO creates a new nullable ISO 8601 duration
+func NewDurationISO(value *time.Duration) DurationISO {
+	return DurationISO{NewDuration(value)}
+}
+
+// DurationISOFrom creates a new valid nullable ISO 8601 duration from value
+func DurationISOFrom(value time.Duration) DurationISO {
+	return NewDurationISO(&value)
+}
+
+// NullDurationISO creates a new NULL ISO 8601 duration
+func NullDurationISO() DurationISO {
+	return NewDurationISO(nil)
+}
+
+// ParseDurationISO parses an ISO 8601 duration like PT1H30M, empty text is
+// NULL
+func ParseDurationISO(text string) (DurationISO, error) {
+	var n DurationISO
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return DurationISO{}, err
+	}
+	return n, nil
+}
+
+// MustParseDurationISO is ParseDurationISO that panics when text is
+// malformed. It is meant for test fixtures and package-level values, never
+// for user input.
+func MustParseDurationISO(text string) DurationISO {
+	n, err := ParseDurationISO(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseDurationISO(%q): %v", text, err))
+	}
+	return n
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n DurationISO) Clone() DurationISO {
+	return n
+}
+
+// String returns duration in ISO 8601 form, or "<null>" when NULL
+func (n DurationISO) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return formatISODuration(n.realValue)
+}
+
+// DebugString returns DurationISO(PT1H30M) or DurationISO(NULL)
+func (n DurationISO) DebugString() string {
+	return debugString("DurationISO", n.isValid, n.String())
+}
+
+// LogValue implements slog.LogValuer, logging NULL as nil and the value as
+// ISO 8601 text like String
+func (n DurationISO) LogValue() slog.Value {
+	if !n.isValid {
+		return slog.AnyValue(nil)
+	}
+	return slog.StringValue(n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same duration
+func (n DurationISO) Equal(other DurationISO) bool {
+	return n.isoDuration.Equal(other.isoDuration)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n DurationISO) Changed(old DurationISO) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any duration.
+func (n DurationISO) Compare(other DurationISO) int {
+	return n.isoDuration.Compare(other.isoDuration)
+}
+
+// MarshalJSON converts current value to JSON, a string such as "PT1H30M"
+func (n DurationISO) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(formatISODuration(n.realValue))
+}
+
+// UnmarshalJSON writes JSON to this type, an ISO 8601 duration string
+func (n *DurationISO) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return jsonError(err)
+	}
+	parsed, err := parseISODuration(text)
+	if err != nil {
+		return jsonError(err)
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n DurationISO) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(formatISODuration(n.realValue)), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *DurationISO) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.SetNull()
+		return nil
+	}
+
+	parsed, err := parseISODuration(string(text))
+	if err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n DurationISO) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *DurationISO) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// MarshalYAML converts current value to YAML
+func (n DurationISO) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return formatISODuration(n.realValue), nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *DurationISO) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.SetNull()
+		return nil
+	}
+
+	var text string
+	if err := value.Decode(&text); err != nil {
+		return err
+	}
+	parsed, err := parseISODuration(text)
+	if err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n DurationISO) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(formatISODuration(n.realValue), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *DurationISO) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	parsed, err := parseISODuration(strings.TrimSpace(text))
+	if err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// formatISODuration writes value in hours, minutes, and seconds, such as
+// PT36H or -PT1.5S, since days and longer have no fixed length in ISO 8601
+func formatISODuration(value time.Duration) string {
+	if value == 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	// The magnitude is unsigned so that math.MinInt64 doesn't overflow
+	magnitude := uint64(value)
+	if value < 0 {
+		b.WriteByte('-')
+		magnitude = -magnitude
+	}
+	b.WriteString("PT")
+
+	hours := magnitude / uint64(time.Hour)
+	minutes := magnitude % uint64(time.Hour) / uint64(time.Minute)
+	seconds := magnitude % uint64(time.Minute) / uint64(time.Second)
+	fraction := magnitude % uint64(time.Second)
+	if hours > 0 {
+		b.WriteString(strconv.FormatUint(hours, 10) + "H")
+	}
+	if minutes > 0 {
+		b.WriteString(strconv.FormatUint(minutes, 10) + "M")
+	}
+	if seconds > 0 || fraction > 0 {
+		b.WriteString(strconv.FormatUint(seconds, 10))
+		if fraction > 0 {
+			b.WriteString("." + strings.TrimRight(fmt.Sprintf("%09d", fraction), "0"))
+		}
+		b.WriteByte('S')
+	}
+	return b.String()
+}
+
+// isoDurationUnits are the designators parseISODuration accepts, in the
+// order they have to come in. Days are taken as 24 hours and weeks as 7
+// days, years and months are refused.
+var isoDurationUnits = []struct {
+	designator byte
+	timePart   bool
+	unit       time.Duration
+}{
+	{'W', false, 7 * 24 * time.Hour},
+	{'D', false, 24 * time.Hour},
+	{'H', true, time.Hour},
+	{'M', true, time.Minute},
+	{'S', true, time.Second},
+}
+
+// parseISODuration parses an ISO 8601 duration like P1DT2H, PT0S, or
+// -PT0.25S. Any number may have a fraction, written after a dot or a comma.
+func parseISODuration(text string) (time.Duration, error) {
+	malformed := func(reason string) error {
+		return fmt.Errorf("nullable: invalid ISO 8601 duration %q: %s", text, reason)
+	}
+
+	rest := text
+	negative := strings.HasPrefix(rest, "-")
+	rest = strings.TrimPrefix(strings.TrimPrefix(rest, "-"), "+")
+	if !strings.HasPrefix(rest, "P") {
+		return 0, malformed("missing P")
+	}
+	rest = rest[1:]
+
+	// A negative duration may reach one nanosecond further than a positive one
+	limit := uint64(1<<63 - 1)
+	if negative {
+		limit++
+	}
+	var total uint64
+	timePart, next, components := false, 0, 0
+	for rest != "" {
+		if rest[0] == 'T' {
+			if timePart {
+				return 0, malformed("T given twice")
+			}
+			timePart, rest = true, rest[1:]
+			if rest == "" {
+				return 0, malformed("nothing after T")
+			}
+			continue
+		}
+
+		digits := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if digits <= 0 {
+			return 0, malformed("expected a number")
+		}
+		number := strings.Replace(rest[:digits], ",", ".", 1)
+		designator := rest[digits]
+		rest = rest[digits+1:]
+
+		index := -1
+		for i := next; i < len(isoDurationUnits); i++ {
+			if isoDurationUnits[i].designator == designator && isoDurationUnits[i].timePart == timePart {
+				index = i
+				break
+			}
+		}
+		if index < 0 {
+			if designator == 'Y' || (designator == 'M' && !timePart) {
+				return 0, malformed("years and months have no fixed length")
+			}
+			return 0, malformed(fmt.Sprintf("unexpected %q", designator))
+		}
+		unit := uint64(isoDurationUnits[index].unit)
+		next = index + 1
+		components++
+
+		whole, fraction, hasFraction := strings.Cut(number, ".")
+		if whole == "" && (!hasFraction || fraction == "") {
+			return 0, malformed("expected a number")
+		}
+		var count uint64
+		if whole != "" {
+			parsed, err := strconv.ParseUint(whole, 10, 64)
+			if err != nil || parsed > limit/unit {
+				return 0, withSentinel(ErrOverflow, malformed("out of range"))
+			}
+			count = parsed * unit
+		}
+		if hasFraction {
+			if fraction == "" || strings.ContainsAny(fraction, ".,") {
+				return 0, malformed("invalid fraction")
+			}
+			// Every unit is a whole number of seconds, so nine digits give
+			// nanoseconds and the ones after them are dropped
+			fraction = (fraction + "000000000")[:9]
+			nanoseconds, _ := strconv.ParseUint(fraction, 10, 64)
+			count += nanoseconds * (unit / uint64(time.Second))
+		}
+		if count > limit-total {
+			return 0, withSentinel(ErrOverflow, malformed("out of range"))
+		}
+		total += count
+	}
+	if components == 0 {
+		return 0, malformed("no duration given")
+	}
+
+	if negative {
+		return time.Duration(-total), nil
+	}
+	return time.Duration(total), nil
+}
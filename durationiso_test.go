@@ -0,0 +1,135 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestJSONDurationISO(t *testing.T) {
+	cases := []struct {
+		value      time.Duration
+		serialized string
+	}{
+		{0, `"PT0S"`},
+		{90 * time.Minute, `"PT1H30M"`},
+		{36 * time.Hour, `"PT36H"`},
+		{time.Hour + 5*time.Second, `"PT1H5S"`},
+		{1500 * time.Millisecond, `"PT1.5S"`},
+		{time.Nanosecond, `"PT0.000000001S"`},
+		{-250 * time.Millisecond, `"-PT0.25S"`},
+		{math.MinInt64, `"-PT2562047H47M16.854775808S"`},
+	}
+	for _, c := range cases {
+		serialized, err := json.Marshal(nullable.DurationISOFrom(c.value))
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(serialized), c.serialized)
+
+		var unserialized nullable.DurationISO
+		tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+		tests.AssertEqual(t, unserialized.MustGet(), c.value)
+	}
+
+	marshalUnmarshalJSON(t, nullable.NullDurationISO())
+}
+
+func TestParseDurationISO(t *testing.T) {
+	cases := []struct {
+		text     string
+		expected time.Duration
+	}{
+		{"PT0S", 0},
+		{"P0D", 0},
+		{"PT1H30M", 90 * time.Minute},
+		{"PT90M", 90 * time.Minute},
+		{"PT1.5S", 1500 * time.Millisecond},
+		{"PT0,5S", 500 * time.Millisecond},
+		{"PT.5S", 500 * time.Millisecond},
+		{"PT0.1234567899S", 123456789 * time.Nanosecond},
+		{"PT1.5H", 90 * time.Minute},
+		{"P1DT2H", 26 * time.Hour},
+		{"P1W", 7 * 24 * time.Hour},
+		{"+PT1M", time.Minute},
+		{"-P1DT1S", -24*time.Hour - time.Second},
+		{"PT2562047H47M16.854775807S", math.MaxInt64},
+	}
+	for _, c := range cases {
+		parsed, err := nullable.ParseDurationISO(c.text)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, parsed, nullable.DurationISOFrom(c.expected))
+	}
+
+	parsed, err := nullable.ParseDurationISO("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.IsNull(), true)
+
+	for _, text := range []string{"P", "PT", "1H", "PT1H30", "P1Y", "P1M", "PT1S1M", "P1DT", "PTT1H", "PT1.2.3S", "PT.S", "1h30m", "PT-1S", "pt1s"} {
+		if _, err := nullable.ParseDurationISO(text); err == nil {
+			t.Errorf("parsing %q must fail", text)
+		}
+	}
+
+	_, err = nullable.ParseDurationISO("PT2562048H")
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrOverflow), true)
+
+	tests.AssertEqual(t, nullable.MustParseDurationISO("PT1M"), nullable.DurationISOFrom(time.Minute))
+}
+
+func TestTextDurationISO(t *testing.T) {
+	marshalUnmarshalText(t, nullable.DurationISOFrom(90*time.Minute))
+	marshalUnmarshalText(t, nullable.NullDurationISO())
+	marshalUnmarshalCSV(t, nullable.DurationISOFrom(90*time.Minute))
+	marshalUnmarshalYAML(t, nullable.DurationISOFrom(90*time.Minute))
+	marshalUnmarshalXML(t, nullable.DurationISOFrom(90*time.Minute))
+	marshalUnmarshalXML(t, nullable.NullDurationISO())
+
+	text, err := nullable.DurationISOFrom(90 * time.Minute).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(text), "PT1H30M")
+	tests.AssertEqual(t, nullable.DurationISOFrom(90*time.Minute).String(), "PT1H30M")
+}
+
+func TestEqualDurationISO(t *testing.T) {
+	basic := nullable.DurationISOFrom(time.Minute)
+	tests.AssertEqual(t, basic.Equal(nullable.MustParseDurationISO("PT60S")), true)
+	tests.AssertEqual(t, basic.Changed(nullable.NullDurationISO()), true)
+	tests.AssertEqual(t, basic.Compare(nullable.DurationISOFrom(time.Hour)), -1)
+	tests.AssertEqual(t, basic.Clone(), basic)
+}
+
+func TestDurationISO(t *testing.T) {
+	type TestNullableDurationISO struct {
+		ID      uint
+		Name    string
+		Timeout nullable.DurationISO
+	}
+
+	DB.Migrator().DropTable(&TestNullableDurationISO{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableDurationISO{}); err != nil {
+		t.Errorf("failed to migrate nullable ISO duration, got error: %v", err)
+	}
+
+	slow := TestNullableDurationISO{Name: "slow", Timeout: nullable.DurationISOFrom(90 * time.Second)}
+	DB.Create(&slow)
+
+	forever := TestNullableDurationISO{Name: "forever", Timeout: nullable.NullDurationISO()}
+	DB.Create(&forever)
+
+	for _, expected := range []TestNullableDurationISO{slow, forever} {
+		var result TestNullableDurationISO
+		if err := DB.First(&result, "name = ?", expected.Name).Error; err != nil {
+			t.Fatalf("Cannot read ISO duration test record of %q", expected.Name)
+		}
+		tests.AssertEqual(t, result, expected)
+	}
+
+	// Stored as nanoseconds like Duration
+	var nanoseconds int64
+	DB.Raw("SELECT timeout FROM test_nullable_duration_isos WHERE name = ?", "slow").Scan(&nanoseconds)
+	tests.AssertEqual(t, nanoseconds, int64(90*time.Second))
+}
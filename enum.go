@@ -0,0 +1,432 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"log/slog"
+	"reflect"
+	"slices"
+	"strconv"
+	"sync"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// enumValues keeps allowed values given to RegisterEnum by enum type
+var enumValues sync.Map
+
+// RegisterEnum sets allowed values of T for every Enum[T] constructed
+// without its own, such as the zero value GORM and encoding/json scan into.
+func RegisterEnum[T ~string](allowed ...T) {
+	enumValues.Store(reflect.TypeFor[T](), slices.Clone(allowed))
+}
+
+// Enum SQL type that can retrieve NULL value or one of allowed values.
+// Scan and every unmarshal or decode method reject anything else, NULL is
+// always accepted.
+type Enum[T ~string] struct {
+	core[T]
+	allowed []T
+}
+
+// NewEnum creates a new nullable enum limited to allowed values, falling
+// back to the ones given to RegisterEnum when allowed is empty. It panics
+// when value is not allowed, since that is a mistake in the calling code.
+func NewEnum[T ~string](allowed []T, value *T) Enum[T] {
+	n := Enum[T]{allowed: slices.Clone(allowed)}
+	if err := n.Set(value); err != nil {
+		panic(err)
+	}
+	return n
+}
+
+// NullEnum creates a new NULL enum limited to allowed values
+func NullEnum[T ~string](allowed []T) Enum[T] {
+	return NewEnum(allowed, nil)
+}
+
+// EnumPtr returns n.Get(), handy where a function value is needed
+func EnumPtr[T ~string](n Enum[T]) *T {
+	return n.Get()
+}
+
+// EnumValue returns n.GetOrZero(), empty string when NULL
+func EnumValue[T ~string](n Enum[T]) T {
+	return n.GetOrZero()
+}
+
+// Allowed returns the values this enum accepts
+func (n Enum[T]) Allowed() []T {
+	if len(n.allowed) > 0 {
+		return slices.Clone(n.allowed)
+	}
+	if allowed, ok := enumValues.Load(reflect.TypeFor[T]()); ok {
+		return slices.Clone(allowed.([]T))
+	}
+	return nil
+}
+
+// IsAllowed reports whether value may be held by this enum
+func (n Enum[T]) IsAllowed(value T) bool {
+	return slices.Contains(n.Allowed(), value)
+}
+
+// check returns an error when value is not allowed
+func (n Enum[T]) check(value T) error {
+	allowed := n.Allowed()
+	if len(allowed) == 0 {
+		return fmt.Errorf("nullable: no allowed values for %T, pass them to NewEnum or RegisterEnum", value)
+	}
+	if !slices.Contains(allowed, value) {
+		return fmt.Errorf("nullable: %q is not an allowed %T, expected one of %q", string(value), value, allowed)
+	}
+	return nil
+}
+
+// Set either nil or enum value, a value that is not allowed is rejected
+// and leaves the enum unchanged
+func (n *Enum[T]) Set(value *T) error {
+	if value == nil {
+		n.SetNull()
+		return nil
+	}
+	return n.SetValue(*value)
+}
+
+// Swap sets either nil or enum value like Set does and returns the previous
+// value, nil when it was NULL. A value that is not allowed is rejected with
+// nil and leaves the enum unchanged.
+func (n *Enum[T]) Swap(value *T) (*T, error) {
+	previous := n.Get()
+	if err := n.Set(value); err != nil {
+		return nil, err
+	}
+	return previous, nil
+}
+
+// SetValue sets enum value and marks it as not NULL, a value that is not
+// allowed is rejected and leaves the enum unchanged
+func (n *Enum[T]) SetValue(value T) error {
+	if err := n.check(value); err != nil {
+		return err
+	}
+	n.realValue = value
+	n.isValid = true
+	return nil
+}
+
+// Reset sets the value back to the zero NULL Enum, the one a declared
+// Enum starts with, so pooled values can be reused. Unlike SetNull it also
+// drops the allowed values, falling back to the ones given to RegisterEnum.
+func (n *Enum[T]) Reset() {
+	*n = Enum[T]{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Enum[T]) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// MustGet either enum value or panic when NULL
+func (n Enum[T]) MustGet() T {
+	return n.mustGet(reflect.TypeOf(n).String())
+}
+
+// Clone returns a copy of the value that shares no memory with n
+func (n Enum[T]) Clone() Enum[T] {
+	n.allowed = slices.Clone(n.allowed)
+	return n
+}
+
+// String returns enum value, or "<null>" when NULL
+func (n Enum[T]) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return string(n.realValue)
+}
+
+// DebugString returns Enum[T](value) or Enum[T](NULL), telling NULL apart
+// from a zero value in logs
+func (n Enum[T]) DebugString() string {
+	return debugString("Enum["+reflect.TypeFor[T]().String()+"]", n.isValid, strconv.Quote(string(n.realValue)))
+}
+
+// LogValue implements slog.LogValuer, logging NULL as nil and the value as a
+// String
+func (n Enum[T]) LogValue() slog.Value {
+	return logText(n.isValid, string(n.realValue))
+}
+
+// Equal reports whether both values are NULL or both hold the same enum
+// value, allowed values are not compared
+func (n Enum[T]) Equal(other Enum[T]) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Enum[T]) Changed(old Enum[T]) bool {
+	return !n.Equal(old)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL the text, telling NULL apart from zero in cache keys
+func (n Enum[T]) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagEnum, n.isValid, []byte(n.realValue))
+}
+
+// MarshalJSON converts current value to JSON
+func (n Enum[T]) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(string(n.realValue))
+}
+
+// UnmarshalJSON writes JSON to this type
+func (n *Enum[T]) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed T
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+	return jsonError(n.SetValue(parsed))
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Enum[T]) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(n.realValue), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Enum[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.SetNull()
+		return nil
+	}
+	return n.SetValue(T(text))
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Enum[T]) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Enum[T]) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Enum[T]) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return copyText(string(n.realValue)), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Enum[T]) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return string(n.realValue), nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Enum[T]) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed string
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+	return n.SetValue(T(parsed))
+}
+
+// MarshalXML converts current value to XML
+func (n Enum[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(string(n.realValue), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Enum[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+	return n.SetValue(T(text))
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Enum[T]) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(string(n.realValue))
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Enum[T]) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	parsed, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+	return n.SetValue(T(parsed))
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Enum[T]) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(string(n.realValue))
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Enum[T]) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed string
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+	return n.SetValue(T(parsed))
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero
+// value. Allowed values are not encoded, the decoding side keeps its own.
+func (n Enum[T]) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, string(n.realValue))
+}
+
+// GobDecode writes gob to this type
+func (n *Enum[T]) GobDecode(data []byte) error {
+	var parsed string
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.SetNull()
+		return nil
+	}
+	return n.SetValue(T(parsed))
+}
+
+// Scan implements scanner interface
+func (n *Enum[T]) Scan(value interface{}) error {
+	value = scanValue(value)
+	if value == nil {
+		n.SetNull()
+		return nil
+	}
+
+	var scanned string
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
+	}
+	return scanError(n.SetValue(T(scanned)))
+}
+
+// Value implements the driver Valuer interface.
+func (n Enum[T]) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return string(n.realValue), nil
+}
+
+// GormDataType gorm common data type
+func (Enum[T]) GormDataType() string {
+	return "enum_null"
+}
+
+// GormDBDataType gorm db data type
+func (n Enum[T]) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Enum[T]) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Enum[T]) columnType(dialect string, field *schema.Field) string {
+	size := fieldSize(field)
+	if size == 0 {
+		size = 255
+	}
+	switch dialect {
+	case "sqlite", "mysql":
+		return fmt.Sprintf("VARCHAR(%d)", size)
+	case "postgres", "cockroachdb":
+		return "text"
+	case "sqlserver":
+		return fmt.Sprintf("NVARCHAR(%d)", size)
+	case "clickhouse":
+		return "Nullable(String)"
+	}
+	return ""
+}
@@ -0,0 +1,347 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm/schema"
+	"gorm.io/gorm/utils/tests"
+)
+
+type orderStatus string
+
+const (
+	orderPending orderStatus = "pending"
+	orderPaid    orderStatus = "paid"
+	orderShipped orderStatus = "shipped"
+)
+
+var orderStatuses = []orderStatus{orderPending, orderPaid, orderShipped}
+
+// registeredStatus gets its allowed values from RegisterEnum only
+type registeredStatus string
+
+func init() {
+	nullable.RegisterEnum[registeredStatus]("active", "archived")
+}
+
+func TestScanEnum(t *testing.T) {
+	nullableEnum := nullable.NullEnum(orderStatuses)
+
+	tests.AssertEqual(t, nullableEnum.Scan("paid"), nil)
+	tests.AssertEqual(t, nullableEnum.Get(), orderPaid)
+
+	tests.AssertEqual(t, nullableEnum.Scan([]byte("shipped")), nil)
+	tests.AssertEqual(t, nullableEnum.Get(), orderShipped)
+
+	if err := nullableEnum.Scan("refunded"); err == nil {
+		t.Error("scanning value outside of allowed set must fail")
+	}
+	tests.AssertEqual(t, nullableEnum.Get(), orderShipped)
+
+	tests.AssertEqual(t, nullableEnum.Scan(nil), nil)
+	tests.AssertEqual(t, nullableEnum.Get(), nil)
+}
+
+func TestNewEnum(t *testing.T) {
+	basic := orderPending
+	nullableEnum1 := nullable.NewEnum(orderStatuses, &basic)
+	tests.AssertEqual(t, nullableEnum1.Get(), basic)
+	tests.AssertEqual(t, nullableEnum1.Allowed(), orderStatuses)
+
+	nullableEnum2 := nullable.NewEnum(orderStatuses, nil)
+	tests.AssertEqual(t, nullableEnum2.Get(), nil)
+	tests.AssertEqual(t, nullableEnum2, nullable.NullEnum(orderStatuses))
+
+	defer func() {
+		if recover() == nil {
+			t.Error("NewEnum with value outside of allowed set must panic")
+		}
+	}()
+	refunded := orderStatus("refunded")
+	nullable.NewEnum(orderStatuses, &refunded)
+}
+
+func TestSetEnum(t *testing.T) {
+	nullableEnum := nullable.NullEnum(orderStatuses)
+
+	basic := orderPaid
+	tests.AssertEqual(t, nullableEnum.Set(&basic), nil)
+	tests.AssertEqual(t, nullableEnum.Get(), basic)
+
+	refunded := orderStatus("refunded")
+	if err := nullableEnum.Set(&refunded); err == nil {
+		t.Error("setting value outside of allowed set must fail")
+	}
+	tests.AssertEqual(t, nullableEnum.Get(), basic)
+
+	tests.AssertEqual(t, nullableEnum.SetValue(orderShipped), nil)
+	tests.AssertEqual(t, nullableEnum.Get(), orderShipped)
+
+	tests.AssertEqual(t, nullableEnum.Set(nil), nil)
+	tests.AssertEqual(t, nullableEnum.Get(), nil)
+
+	tests.AssertEqual(t, nullableEnum.SetValue(orderPaid), nil)
+	nullableEnum.SetNull()
+	tests.AssertEqual(t, nullableEnum, nullable.NullEnum(orderStatuses))
+}
+
+func TestRegisterEnum(t *testing.T) {
+	var nullableEnum nullable.Enum[registeredStatus]
+	tests.AssertEqual(t, nullableEnum.Allowed(), []registeredStatus{"active", "archived"})
+	tests.AssertEqual(t, nullableEnum.IsAllowed("active"), true)
+	tests.AssertEqual(t, nullableEnum.IsAllowed("deleted"), false)
+
+	tests.AssertEqual(t, nullableEnum.Scan("archived"), nil)
+	tests.AssertEqual(t, nullableEnum.Get(), registeredStatus("archived"))
+	if err := nullableEnum.Scan("deleted"); err == nil {
+		t.Error("scanning value outside of registered set must fail")
+	}
+
+	// Values given to the constructor win over registered ones
+	nullableEnum = nullable.NullEnum([]registeredStatus{"deleted"})
+	tests.AssertEqual(t, nullableEnum.Scan("deleted"), nil)
+	if err := nullableEnum.Scan("active"); err == nil {
+		t.Error("scanning value outside of constructor set must fail")
+	}
+
+	// Without allowed values there is nothing to accept but NULL
+	var unregistered nullable.Enum[orderStatus]
+	if err := unregistered.Scan("paid"); err == nil {
+		t.Error("scanning into enum without allowed values must fail")
+	}
+	tests.AssertEqual(t, unregistered.Scan(nil), nil)
+}
+
+func TestGetOrEnum(t *testing.T) {
+	basic := orderPaid
+	tests.AssertEqual(t, nullable.NewEnum(orderStatuses, &basic).GetOr(orderPending), orderPaid)
+	tests.AssertEqual(t, nullable.NullEnum(orderStatuses).GetOr(orderPending), orderPending)
+	tests.AssertEqual(t, nullable.NullEnum(orderStatuses).GetOrZero(), orderStatus(""))
+	tests.AssertEqual(t, nullable.NewEnum(orderStatuses, &basic).MustGet(), orderPaid)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustGet on NULL enum must panic")
+		}
+	}()
+	nullable.NullEnum(orderStatuses).MustGet()
+}
+
+func TestIsZeroEnum(t *testing.T) {
+	paid := orderPaid
+	tests.AssertEqual(t, nullable.NullEnum(orderStatuses).IsZero(), true)
+	tests.AssertEqual(t, nullable.NewEnum(orderStatuses, &paid).IsZero(), false)
+}
+
+func TestUnwrapEnum(t *testing.T) {
+	paid := orderPaid
+	value, ok := nullable.NewEnum(orderStatuses, &paid).Unwrap()
+	tests.AssertEqual(t, value, orderPaid)
+	tests.AssertEqual(t, ok, true)
+
+	var zero nullable.Enum[orderStatus]
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, orderStatus(""))
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestPtrEnum(t *testing.T) {
+	basic := orderPaid
+	tests.AssertEqual(t, nullable.EnumPtr(nullable.NewEnum(orderStatuses, &basic)), orderPaid)
+	tests.AssertEqual(t, nullable.EnumPtr(nullable.NullEnum(orderStatuses)), nil)
+	tests.AssertEqual(t, nullable.EnumValue(nullable.NewEnum(orderStatuses, &basic)), orderPaid)
+	tests.AssertEqual(t, nullable.EnumValue(nullable.NullEnum(orderStatuses)), orderStatus(""))
+}
+
+func TestTextEnum(t *testing.T) {
+	basic := orderPaid
+	nullableEnum := nullable.NewEnum(orderStatuses, &basic)
+	serialized, err := nullableEnum.MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "paid")
+
+	unserialized := nullable.NullEnum(orderStatuses)
+	tests.AssertEqual(t, unserialized.UnmarshalText(serialized), nil)
+	tests.AssertEqual(t, unserialized, nullableEnum)
+
+	tests.AssertEqual(t, unserialized.UnmarshalText([]byte{}), nil)
+	tests.AssertEqual(t, unserialized.IsNull(), true)
+
+	if err := unserialized.UnmarshalText([]byte("refunded")); err == nil {
+		t.Error("unmarshalling text outside of allowed set must fail")
+	}
+}
+
+func TestStringerEnum(t *testing.T) {
+	basic := orderPaid
+	nullableEnum := nullable.NewEnum(orderStatuses, &basic)
+	tests.AssertEqual(t, nullableEnum.String(), "paid")
+	tests.AssertEqual(t, fmt.Sprint(nullableEnum), "paid")
+
+	nullableEnum.SetNull()
+	tests.AssertEqual(t, nullableEnum.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableEnum), "<null>")
+}
+
+func TestEqualEnum(t *testing.T) {
+	paid, shipped := orderPaid, orderShipped
+	tests.AssertEqual(t, nullable.NullEnum(orderStatuses).Equal(nullable.NullEnum(orderStatuses)), true)
+	tests.AssertEqual(t, nullable.NullEnum(orderStatuses).Equal(nullable.NewEnum(orderStatuses, &paid)), false)
+	tests.AssertEqual(t, nullable.NewEnum(orderStatuses, &paid).Equal(nullable.NewEnum(orderStatuses, &paid)), true)
+	tests.AssertEqual(t, nullable.NewEnum(orderStatuses, &paid).Equal(nullable.NewEnum(orderStatuses, &shipped)), false)
+	tests.AssertEqual(t, nullable.NewEnum(orderStatuses, &paid).Equal(nullable.NewEnum([]orderStatus{orderPaid}, &paid)), true)
+}
+
+func TestJSONEnum(t *testing.T) {
+	type order struct {
+		Status nullable.Enum[orderStatus] `json:"status"`
+	}
+
+	basic := orderPaid
+	serialized, err := json.Marshal(order{Status: nullable.NewEnum(orderStatuses, &basic)})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `{"status":"paid"}`)
+
+	serialized, err = json.Marshal(order{Status: nullable.NullEnum(orderStatuses)})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `{"status":null}`)
+
+	unserialized := order{Status: nullable.NullEnum(orderStatuses)}
+	tests.AssertEqual(t, json.Unmarshal([]byte(`{"status":"shipped"}`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.Status.Get(), orderShipped)
+
+	tests.AssertEqual(t, json.Unmarshal([]byte(`{"status":null}`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.Status.IsNull(), true)
+
+	if err := json.Unmarshal([]byte(`{"status":"refunded"}`), &unserialized); err == nil {
+		t.Error("unmarshalling JSON outside of allowed set must fail")
+	}
+	if err := json.Unmarshal([]byte(`{"status":42}`), &unserialized); err == nil {
+		t.Error("unmarshalling JSON number into enum must fail")
+	}
+}
+
+func TestYAMLEnum(t *testing.T) {
+	archived := registeredStatus("archived")
+	marshalUnmarshalYAML(t, nullable.NewEnum(nil, &archived))
+	marshalUnmarshalYAML(t, nullable.NullEnum[registeredStatus](nil))
+
+	unserialized := yamlEnvelope[nullable.Enum[orderStatus]]{Value: nullable.NullEnum(orderStatuses)}
+	tests.AssertEqual(t, yaml.Unmarshal([]byte("value: shipped"), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.Value.Get(), orderShipped)
+	if err := yaml.Unmarshal([]byte("value: refunded"), &unserialized); err == nil {
+		t.Error("unmarshalling YAML outside of allowed set must fail")
+	}
+}
+
+func TestXMLEnum(t *testing.T) {
+	archived := registeredStatus("archived")
+	marshalUnmarshalXML(t, nullable.NewEnum(nil, &archived))
+	marshalUnmarshalXML(t, nullable.NullEnum[registeredStatus](nil))
+
+	unserialized := nullable.NullEnum(orderStatuses)
+	if err := xml.Unmarshal([]byte("<Status>refunded</Status>"), &unserialized); err == nil {
+		t.Error("unmarshalling XML outside of allowed set must fail")
+	}
+}
+
+func TestMsgpackEnum(t *testing.T) {
+	archived := registeredStatus("archived")
+	marshalUnmarshalMsgpack(t, nullable.NewEnum(nil, &archived))
+	marshalUnmarshalMsgpack(t, nullable.NullEnum[registeredStatus](nil))
+
+	serialized, err := msgpack.Marshal("refunded")
+	tests.AssertEqual(t, err, nil)
+	unserialized := nullable.NullEnum(orderStatuses)
+	if err := msgpack.Unmarshal(serialized, &unserialized); err == nil {
+		t.Error("unmarshalling MessagePack outside of allowed set must fail")
+	}
+}
+
+func TestBSONEnum(t *testing.T) {
+	archived := registeredStatus("archived")
+	marshalUnmarshalBSON(t, nullable.NewEnum(nil, &archived))
+	marshalUnmarshalBSON(t, nullable.NullEnum[registeredStatus](nil))
+
+	serialized, err := bson.Marshal(bson.M{"value": "refunded"})
+	tests.AssertEqual(t, err, nil)
+	unserialized := struct {
+		Value nullable.Enum[orderStatus] `bson:"value"`
+	}{Value: nullable.NullEnum(orderStatuses)}
+	if err := bson.Unmarshal(serialized, &unserialized); err == nil {
+		t.Error("unmarshalling BSON outside of allowed set must fail")
+	}
+}
+
+func TestGobEnum(t *testing.T) {
+	archived := registeredStatus("archived")
+	marshalUnmarshalGob(t, nullable.NewEnum(nil, &archived))
+	marshalUnmarshalGob(t, nullable.NullEnum[registeredStatus](nil))
+
+	refunded := registeredStatus("refunded")
+	serialized, err := nullable.NewEnum([]registeredStatus{"archived", "refunded"}, &refunded).GobEncode()
+	tests.AssertEqual(t, err, nil)
+	unserialized := nullable.NullEnum[registeredStatus]([]registeredStatus{"active"})
+	if err := unserialized.GobDecode(serialized); err == nil {
+		t.Error("decoding gob outside of allowed set must fail")
+	}
+	tests.AssertEqual(t, unserialized.IsNull(), true)
+}
+
+func TestEnumDBDataType(t *testing.T) {
+	tests.AssertEqual(t, nullable.Enum[orderStatus]{}.GormDBDataType(DialectDB("mysql"), &schema.Field{}), "VARCHAR(255)")
+	tests.AssertEqual(t, nullable.Enum[orderStatus]{}.GormDBDataType(DialectDB("mysql"), &schema.Field{Size: 16}), "VARCHAR(16)")
+	tests.AssertEqual(t, nullable.Enum[orderStatus]{}.GormDBDataType(DialectDB("sqlserver"), &schema.Field{Size: 16}), "NVARCHAR(16)")
+}
+
+func TestEnum(t *testing.T) {
+	type TestNullableEnum struct {
+		ID     uint
+		Name   string
+		Status nullable.Enum[registeredStatus]
+	}
+
+	DB.Migrator().DropTable(&TestNullableEnum{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableEnum{}); err != nil {
+		t.Errorf("failed to migrate nullable enum, got error: %v", err)
+	}
+
+	active := registeredStatus("active")
+	present := TestNullableEnum{
+		Name:   "present",
+		Status: nullable.NewEnum(nil, &active),
+	}
+	DB.Create(&present)
+
+	missing := TestNullableEnum{
+		Name:   "missing",
+		Status: nullable.NewEnum[registeredStatus](nil, nil),
+	}
+	DB.Create(&missing)
+
+	var result1 TestNullableEnum
+	if err := DB.First(&result1, "name = ?", "present").Error; err != nil {
+		t.Fatal("Cannot read enum test record of \"present\"")
+	}
+	tests.AssertEqual(t, result1, present)
+
+	var result2 TestNullableEnum
+	if err := DB.First(&result2, "name = ?", "missing").Error; err != nil {
+		t.Fatal("Cannot read enum test record of \"missing\"")
+	}
+	tests.AssertEqual(t, result2, missing)
+
+	// A value that sneaked into the column is reported instead of scanned
+	DB.Exec("UPDATE test_nullable_enums SET status = ? WHERE name = ?", "deleted", "present")
+	var result3 TestNullableEnum
+	if err := DB.First(&result3, "name = ?", "present").Error; err == nil {
+		t.Error("reading enum value outside of registered set must fail")
+	}
+}
@@ -0,0 +1,95 @@
+package nullable
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Errors returned by Scan, UnmarshalJSON, and the conversions can be told
+// apart with errors.Is against these. The message of the underlying error is
+// kept, and the strconv and encoding/json errors stay reachable with
+// errors.As.
+var (
+	// ErrOverflow is matched when a value doesn't fit the type, such as
+	// 300 scanned or unmarshalled into Int8, or Int.ToInt8 of 300
+	ErrOverflow = errors.New("nullable: value out of range")
+	// ErrInvalidType is matched when Scan or SetFromInterface gets a value
+	// that cannot be converted, such as a bool for Uint64, text that is not
+	// a number, or 1.5 for Int
+	ErrInvalidType = errors.New("nullable: value cannot be converted")
+	// ErrMalformedJSON is matched when UnmarshalJSON gets input that is not
+	// valid JSON, or JSON of the wrong kind such as a string for Int
+	ErrMalformedJSON = errors.New("nullable: invalid JSON")
+)
+
+// sentinelError is err that errors.Is also matches against sentinel
+type sentinelError struct {
+	sentinel error
+	err      error
+}
+
+func (e sentinelError) Error() string {
+	return e.err.Error()
+}
+
+func (e sentinelError) Unwrap() []error {
+	return []error{e.sentinel, e.err}
+}
+
+// withSentinel makes err match sentinel, leaving nil and errors that already
+// match one of the sentinels as they are
+func withSentinel(sentinel, err error) error {
+	if err == nil || errors.Is(err, ErrOverflow) || errors.Is(err, ErrInvalidType) || errors.Is(err, ErrMalformedJSON) {
+		return err
+	}
+	return sentinelError{sentinel: sentinel, err: err}
+}
+
+// scanError makes an error of Scan match ErrOverflow or ErrInvalidType
+func scanError(err error) error {
+	if isOverflow(err) {
+		return withSentinel(ErrOverflow, err)
+	}
+	return withSentinel(ErrInvalidType, err)
+}
+
+// jsonError makes an error of UnmarshalJSON match ErrOverflow or
+// ErrMalformedJSON
+func jsonError(err error) error {
+	if isOverflow(err) {
+		return withSentinel(ErrOverflow, err)
+	}
+	return withSentinel(ErrMalformedJSON, err)
+}
+
+// isOverflow reports whether err comes from an integer that doesn't fit
+func isOverflow(err error) bool {
+	if errors.Is(err, strconv.ErrRange) {
+		return true
+	}
+	// strconv.ParseUint calls a negative number a syntax error
+	var numErr *strconv.NumError
+	if errors.As(err, &numErr) && numErr.Func == "ParseUint" && isIntegerText(numErr.Num) {
+		return true
+	}
+	// encoding/json reports an integer too large for the field as the wrong type
+	var typeErr *json.UnmarshalTypeError
+	return errors.As(err, &typeErr) && isIntegerText(strings.TrimPrefix(typeErr.Value, "number "))
+}
+
+// isIntegerText reports whether text is an integer literal, with no fraction
+// or exponent
+func isIntegerText(text string) bool {
+	text = strings.TrimPrefix(text, "-")
+	if len(text) == 0 {
+		return false
+	}
+	for _, digit := range []byte(text) {
+		if digit < '0' || digit > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,103 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanErrors(t *testing.T) {
+	cases := []struct {
+		target   interface{ Scan(interface{}) error }
+		value    interface{}
+		sentinel error
+	}{
+		{&nullable.Int8{}, int64(300), nullable.ErrOverflow},
+		{&nullable.Int8{}, "-129", nullable.ErrOverflow},
+		{&nullable.Int32{}, float64(1 << 40), nullable.ErrOverflow},
+		{&nullable.Uint64{}, "18446744073709551616", nullable.ErrOverflow},
+		{&nullable.Uint64{}, int64(-1), nullable.ErrOverflow},
+		{&nullable.Uint16{}, int64(-1), nullable.ErrOverflow},
+		{&nullable.Uint8{}, float64(-1), nullable.ErrOverflow},
+		{&nullable.Int{}, 1.5, nullable.ErrInvalidType},
+		{&nullable.Int64{}, "abc", nullable.ErrInvalidType},
+		{&nullable.Uint64{}, true, nullable.ErrInvalidType},
+		{&nullable.Bool{}, "maybe", nullable.ErrInvalidType},
+		{&nullable.UUID{}, "not-a-uuid", nullable.ErrInvalidType},
+		{&nullable.Decimal{}, "1.2.3", nullable.ErrInvalidType},
+		{&nullable.Time{}, "yesterday", nullable.ErrInvalidType},
+		{&nullable.Rune{}, int64(-1), nullable.ErrInvalidType},
+	}
+	for _, c := range cases {
+		err := c.target.Scan(c.value)
+		if !errors.Is(err, c.sentinel) {
+			t.Errorf("scanning %#v into %T: expected %v, got %v", c.value, c.target, c.sentinel, err)
+		}
+	}
+
+	// The underlying error and its message are kept
+	var nullableInt8 nullable.Int8
+	err := nullableInt8.Scan("300")
+	tests.AssertEqual(t, errors.Is(err, strconv.ErrRange), true)
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrInvalidType), false)
+	tests.AssertEqual(t, err.Error(), `converting driver.Value type string ("300") to a int8: value out of range`)
+
+	// SetFromInterface goes through Scan
+	var nullableUint64 nullable.Uint64
+	tests.AssertEqual(t, errors.Is(nullableUint64.SetFromInterface(-1), nullable.ErrOverflow), true)
+	tests.AssertEqual(t, nullableUint64.Scan(int64(1)), nil)
+}
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	cases := []struct {
+		target   json.Unmarshaler
+		data     string
+		sentinel error
+	}{
+		{&nullable.Int8{}, `300`, nullable.ErrOverflow},
+		{&nullable.Uint8{}, `-1`, nullable.ErrOverflow},
+		{&nullable.Uint64{}, `"18446744073709551616"`, nullable.ErrOverflow},
+		{&nullable.Int64{}, `"-9223372036854775809"`, nullable.ErrOverflow},
+		{&nullable.Int{}, `1.5`, nullable.ErrMalformedJSON},
+		{&nullable.Int{}, `"one"`, nullable.ErrMalformedJSON},
+		{&nullable.Bool{}, `"maybe"`, nullable.ErrMalformedJSON},
+		{&nullable.String{}, `{`, nullable.ErrMalformedJSON},
+		{&nullable.String{}, ``, nullable.ErrMalformedJSON},
+		{&nullable.JSON{}, `{"a":`, nullable.ErrMalformedJSON},
+		{&nullable.Time{}, `"yesterday"`, nullable.ErrMalformedJSON},
+		{&nullable.Rune{}, `"AB"`, nullable.ErrMalformedJSON},
+		{&nullable.Money{}, `{"currency":"USD"}`, nullable.ErrMalformedJSON},
+		{&nullable.Point{}, `{"type":"Point","coordinates":[0,91]}`, nullable.ErrMalformedJSON},
+	}
+	for _, c := range cases {
+		err := c.target.UnmarshalJSON([]byte(c.data))
+		if !errors.Is(err, c.sentinel) {
+			t.Errorf("unmarshalling %s into %T: expected %v, got %v", c.data, c.target, c.sentinel, err)
+		}
+	}
+
+	// Through json.Unmarshal too, with the encoding/json error still reachable
+	var record struct {
+		Count nullable.Int8
+	}
+	err := json.Unmarshal([]byte(`{"Count":"x"}`), &record)
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrMalformedJSON), true)
+	var typeErr *json.UnmarshalTypeError
+	tests.AssertEqual(t, errors.As(err, &typeErr), true)
+}
+
+func TestConversionErrors(t *testing.T) {
+	_, err := nullable.IntFrom(300).ToInt8()
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrOverflow), true)
+	tests.AssertEqual(t, err.Error(), "nullable: 300 overflows int8")
+
+	_, err = nullable.Int64From(-1).ToUint64()
+	tests.AssertEqual(t, errors.Is(err, nullable.ErrOverflow), true)
+
+	_, err = nullable.IntFrom(100).ToInt8()
+	tests.AssertEqual(t, err, nil)
+}
@@ -1,49 +1,201 @@
 package nullable
 
 import (
+	"cmp"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
 
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 )
 
 // Float32 SQL type that can retrieve NULL value
 type Float32 struct {
-	realValue float32
-	isValid   bool
+	core[float32]
 }
 
 // NewFloat32 creates a new nullable float
 func NewFloat32(value *float32) Float32 {
-	if value == nil {
-		return Float32{
-			realValue: 0,
-			isValid:   false,
+	return Float32{core: newCore(value)}
+}
+
+// Float32From creates a new valid nullable float from value
+func Float32From(value float32) Float32 {
+	return NewFloat32(&value)
+}
+
+// NullFloat32 creates a new NULL float
+func NullFloat32() Float32 {
+	return NewFloat32(nil)
+}
+
+// Float32ZeroAsNull creates a new nullable float that is NULL when value is the zero value
+func Float32ZeroAsNull(value float32) Float32 {
+	if value == 0 {
+		return NullFloat32()
+	}
+	return Float32From(value)
+}
+
+// CoalesceFloat32 returns the first valid value, or NULL when all of them are NULL
+func CoalesceFloat32(values ...Float32) Float32 {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return Float32{}
+}
+
+// MinFloat32 returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinFloat32(values ...Float32) Float32 {
+	var result Float32
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
 		}
 	}
-	return Float32{
-		realValue: *value,
-		isValid:   true,
+	return result
+}
+
+// MaxFloat32 returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxFloat32(values ...Float32) Float32 {
+	var result Float32
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// Float32Ptr returns n.Get(), handy where a function value is needed
+func Float32Ptr(n Float32) *float32 {
+	return n.Get()
+}
+
+// Float32Value returns n.GetOrZero(), zero value when NULL
+func Float32Value(n Float32) float32 {
+	return n.GetOrZero()
+}
+
+// ParseFloat32 parses text like UnmarshalText does, empty text is NULL
+func ParseFloat32(text string) (Float32, error) {
+	var n Float32
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Float32{}, err
+	}
+	return n, nil
+}
+
+// MustParseFloat32 is ParseFloat32 that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseFloat32(text string) Float32 {
+	n, err := ParseFloat32(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseFloat32(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL Float32, the one a declared
+// Float32 starts with, so pooled values can be reused
+func (n *Float32) Reset() {
+	*n = Float32{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Float32) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Float32) NullIfZero() {
+	if n.isValid && n.realValue == 0 {
+		n.SetNull()
 	}
 }
 
-// Get either nil or float
-func (n Float32) Get() *float32 {
+// MustGet either float or panic when NULL
+func (n Float32) MustGet() float32 {
+	return n.mustGet("Float32")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Float32) Clone() Float32 {
+	return n
+}
+
+// String returns float in its natural text form, or "<null>" when NULL
+func (n Float32) String() string {
 	if !n.isValid {
-		return nil
+		return nullString
 	}
-	return &n.realValue
+	return strconv.FormatFloat(float64(n.realValue), 'g', -1, 32)
 }
 
-// Set either nil or float
-func (n *Float32) Set(value *float32) {
-	n.isValid = (value != nil)
-	if n.isValid {
-		n.realValue = *value
-	} else {
-		n.realValue = 0
+// DebugString returns Float32(value) or Float32(NULL), telling NULL apart from a
+// zero value in logs
+func (n Float32) DebugString() string {
+	return debugString("Float32", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same float
+func (n Float32) Equal(other Float32) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
 	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Float32) Changed(old Float32) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any float.
+func (n Float32) Compare(other Float32) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL the bits, negative zero as zero, telling NULL apart from zero in
+// cache keys
+func (n Float32) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagFloat32, n.isValid, hashFloat(float64(n.realValue)))
 }
 
 // MarshalJSON converts current value to JSON
@@ -53,8 +205,11 @@ func (n Float32) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON writes JSON to this type
 func (n *Float32) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
 		n.isValid = false
 		n.realValue = 0
 		return nil
@@ -62,6 +217,138 @@ func (n *Float32) UnmarshalJSON(data []byte) error {
 
 	var parsed float32
 	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Float32) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendFloat(nil, float64(n.realValue), 'g', -1, 32), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Float32) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseFloat(string(text), 32)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = float32(parsed)
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Float32) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Float32) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Float32) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return copyFloat(float64(n.realValue), 32), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Float32) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Float32) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed float32
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n Float32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue, start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Float32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseFloat(strings.TrimSpace(text), 32)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = float32(parsed)
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Float32) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Float32) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed float32
+	if err := dec.Decode(&parsed); err != nil {
 		return err
 	}
 
@@ -70,18 +357,69 @@ func (n *Float32) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Float32) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Float32) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed float32
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Float32) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Float32) GobDecode(data []byte) error {
+	var parsed float32
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
 // Scan implements scanner interface
 func (n *Float32) Scan(value interface{}) error {
+	value = scanValue(value)
 	if value == nil {
 		n.realValue, n.isValid = 0, false
 		return nil
 	}
 
-	var f64 float64
-	if err := convertAssign(&f64, value); err != nil {
-		return err
+	// Converting straight to float32 rejects values that would become +/-Inf
+	var scanned float32
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
 	}
-	n.realValue = float32(f64)
+	n.realValue = scanned
 
 	n.isValid = true
 	return nil
@@ -101,12 +439,27 @@ func (Float32) GormDataType() string {
 }
 
 // GormDBDataType gorm db data type
-func (Float32) GormDBDataType(db *gorm.DB, field *schema.Field) string {
-	switch db.Dialector.Name() {
+func (n Float32) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Float32) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Float32) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
 	case "sqlite", "mysql":
 		return "FLOAT"
-	case "postgres":
+	case "postgres", "cockroachdb":
 		return "real"
+	case "sqlserver":
+		return "REAL"
+	case "clickhouse":
+		return "Nullable(Float32)"
 	}
 	return ""
 }
@@ -1,6 +1,10 @@
 package nullable_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
 	"testing"
 
 	"github.com/tee8z/nullable"
@@ -26,6 +30,23 @@ func TestScanFloat32(t *testing.T) {
 	tests.AssertEqual(t, nullableFloat.Get(), nil)
 }
 
+func TestScanFloat32Overflow(t *testing.T) {
+	nullableFloat := nullable.NewFloat32(nil)
+
+	tests.AssertEqual(t, nullableFloat.Scan(float64(math.MaxFloat32)), nil)
+	tests.AssertEqual(t, nullableFloat.Get(), float32(math.MaxFloat32))
+
+	if err := nullableFloat.Scan(1e39); err == nil {
+		t.Errorf("scanning 1e39 into Float32 must fail, got %v", nullableFloat.Get())
+	}
+	if err := nullableFloat.Scan(-1e39); err == nil {
+		t.Errorf("scanning -1e39 into Float32 must fail, got %v", nullableFloat.Get())
+	}
+
+	// previous valid value must be preserved
+	tests.AssertEqual(t, nullableFloat.Get(), float32(math.MaxFloat32))
+}
+
 func TestNewFloat32(t *testing.T) {
 	// Check if follow IEEE754 rules
 	var basicFloat1 float32 = 24.78
@@ -73,6 +94,259 @@ func TestSetFloat32(t *testing.T) {
 	tests.AssertEqual(t, nullableFloat.Get(), nil)
 }
 
+func TestFloat32From(t *testing.T) {
+	var basic float32 = 3.14
+	tests.AssertEqual(t, nullable.Float32From(basic), nullable.NewFloat32(&basic))
+	tests.AssertEqual(t, nullable.Float32From(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullFloat32(), nullable.NewFloat32(nil))
+	tests.AssertEqual(t, nullable.NullFloat32().IsNull(), true)
+}
+
+func TestCoalesceFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	var zero float32 = 0
+	tests.AssertEqual(t, nullable.CoalesceFloat32(), nullable.Float32{})
+	tests.AssertEqual(t, nullable.CoalesceFloat32(nullable.NullFloat32(), nullable.NullFloat32()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceFloat32(nullable.NullFloat32(), nullable.Float32From(basic), nullable.Float32From(zero)), nullable.Float32From(basic))
+	tests.AssertEqual(t, nullable.CoalesceFloat32(nullable.Float32From(zero), nullable.Float32From(basic)), nullable.Float32From(zero))
+}
+
+func TestIsZeroFloat32(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullFloat32().IsZero(), true)
+	tests.AssertEqual(t, nullable.Float32From(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.Float32From(3.14).IsZero(), false)
+}
+
+func TestCloneFloat32(t *testing.T) {
+	tests.AssertEqual(t, nullable.Float32From(3.14).Clone(), nullable.Float32From(3.14))
+	tests.AssertEqual(t, nullable.NullFloat32().Clone(), nullable.NullFloat32())
+}
+
+func TestUnwrapFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	value, ok := nullable.Float32From(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullFloat32().Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Float32
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseFloat32(t *testing.T) {
+	parsed, err := nullable.ParseFloat32("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullFloat32())
+
+	text, err := nullable.Float32From(3.14).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseFloat32(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.Float32From(3.14)), true)
+
+	for _, text := range []string{"abc", "1,5"} {
+		if _, err := nullable.ParseFloat32(text); err == nil {
+			t.Errorf("parsing %q as float must fail", text)
+		}
+	}
+}
+
+func TestMustParseFloat32(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseFloat32(""), nullable.NullFloat32())
+
+	text, err := nullable.Float32From(3.14).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseFloat32(string(text)).Equal(nullable.Float32From(3.14)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseFloat32 of malformed text must panic")
+		}
+	}()
+	nullable.MustParseFloat32("abc")
+}
+
+func TestPtrFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	tests.AssertEqual(t, nullable.Float32Ptr(nullable.NewFloat32(&basic)), basic)
+	tests.AssertEqual(t, nullable.Float32Ptr(nullable.NewFloat32(nil)), nil)
+	tests.AssertEqual(t, nullable.Float32Value(nullable.NewFloat32(&basic)), basic)
+	tests.AssertEqual(t, nullable.Float32Value(nullable.NewFloat32(nil)), 0)
+}
+
+func TestSetValueFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	var nullableFloat32 nullable.Float32
+	nullableFloat32.SetValue(basic)
+	tests.AssertEqual(t, nullableFloat32, nullable.Float32From(basic))
+
+	nullableFloat32.SetNull()
+	tests.AssertEqual(t, nullableFloat32, nullable.NullFloat32())
+}
+
+func TestZeroAsNullFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	var zero float32 = 0
+	tests.AssertEqual(t, nullable.Float32ZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.Float32ZeroAsNull(basic), nullable.Float32From(basic))
+
+	nullableFloat32 := nullable.Float32From(zero)
+	nullableFloat32.NullIfZero()
+	tests.AssertEqual(t, nullableFloat32, nullable.NullFloat32())
+
+	nullableFloat32 = nullable.Float32From(basic)
+	nullableFloat32.NullIfZero()
+	tests.AssertEqual(t, nullableFloat32, nullable.Float32From(basic))
+}
+
+func TestIsNullFloat32(t *testing.T) {
+	var zero nullable.Float32
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic float32 = 3.14
+	nullableFloat32 := nullable.NewFloat32(&basic)
+	tests.AssertEqual(t, nullableFloat32.IsNull(), false)
+	tests.AssertEqual(t, nullableFloat32.IsValid(), true)
+
+	nullableFloat32.Set(nil)
+	tests.AssertEqual(t, nullableFloat32.IsNull(), true)
+	tests.AssertEqual(t, nullableFloat32.IsValid(), false)
+}
+
+func TestGetOrFloat32(t *testing.T) {
+	var zero nullable.Float32
+	var fallback float32 = 3.14
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), 0)
+
+	var basic float32 = 3.14
+	nullableFloat32 := nullable.NewFloat32(&basic)
+	tests.AssertEqual(t, nullableFloat32.GetOr(0), basic)
+	tests.AssertEqual(t, nullableFloat32.GetOrZero(), basic)
+}
+
+func TestMustGetFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	nullableFloat32 := nullable.NewFloat32(&basic)
+	tests.AssertEqual(t, nullableFloat32.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Float32")
+	}()
+	nullableFloat32.Set(nil)
+	nullableFloat32.MustGet()
+	t.Error("MustGet on NULL Float32 must panic")
+}
+
+func TestTextFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	marshalUnmarshalText(t, nullable.NewFloat32(&basic))
+
+	marshalUnmarshalText(t, nullable.NewFloat32(nil))
+}
+
+func TestCSVFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	marshalUnmarshalCSV(t, nullable.NewFloat32(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewFloat32(nil))
+}
+
+func TestYAMLFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	marshalUnmarshalYAML(t, nullable.NewFloat32(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewFloat32(nil))
+}
+
+func TestMsgpackFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	marshalUnmarshalMsgpack(t, nullable.NewFloat32(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewFloat32(nil))
+}
+
+func TestBSONFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	marshalUnmarshalBSON(t, nullable.NewFloat32(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewFloat32(nil))
+}
+
+func TestGobFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	marshalUnmarshalGob(t, nullable.NewFloat32(&basic))
+
+	var zero float32 = 0
+	marshalUnmarshalGob(t, nullable.NewFloat32(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewFloat32(nil))
+}
+
+func TestStringerFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	nullableFloat32 := nullable.NewFloat32(&basic)
+	tests.AssertEqual(t, nullableFloat32.String(), "3.14")
+	tests.AssertEqual(t, fmt.Sprint(nullableFloat32), "3.14")
+
+	nullableFloat32.Set(nil)
+	tests.AssertEqual(t, nullableFloat32.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableFloat32), "<null>")
+}
+
+func TestChangedFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	value, null := nullable.NewFloat32(&basic), nullable.NewFloat32(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.Float32From(0)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewFloat32(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewFloat32(nil)), false)
+}
+
+func TestEqualFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	var zero float32 = 0
+	tests.AssertEqual(t, nullable.NewFloat32(nil).Equal(nullable.NewFloat32(nil)), true)
+	tests.AssertEqual(t, nullable.NewFloat32(nil).Equal(nullable.NewFloat32(&basic)), false)
+	tests.AssertEqual(t, nullable.NewFloat32(&basic).Equal(nullable.NewFloat32(nil)), false)
+	tests.AssertEqual(t, nullable.NewFloat32(&basic).Equal(nullable.NewFloat32(&basic)), true)
+	tests.AssertEqual(t, nullable.NewFloat32(&basic).Equal(nullable.NewFloat32(&zero)), false)
+	tests.AssertEqual(t, nullable.NewFloat32(&zero).Equal(nullable.NewFloat32(nil)), false)
+}
+
+func TestCompareFloat32(t *testing.T) {
+	var lesser float32 = -3.14
+	var greater float32 = 3.14
+	null := nullable.NewFloat32(nil)
+	small := nullable.NewFloat32(&lesser)
+	big := nullable.NewFloat32(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewFloat32(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewFloat32(&greater)), 0)
+
+	values := []nullable.Float32{big, null, small}
+	slices.SortFunc(values, nullable.Float32.Compare)
+	tests.AssertEqual(t, values, []nullable.Float32{null, small, big})
+}
+
+func TestXMLFloat32(t *testing.T) {
+	var basic float32 = 3.14
+	marshalUnmarshalXML(t, nullable.NewFloat32(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewFloat32(nil))
+}
+
 func TestJSONFloat32(t *testing.T) {
 	var basicFloat1 float32 = 24.78
 	marshalUnmarshalJSON(t, nullable.NewFloat32(&basicFloat1))
@@ -89,6 +363,13 @@ func TestJSONFloat32(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewFloat32(nil))
 }
 
+func TestMarshalJSONFloat32Precision(t *testing.T) {
+	var basicFloat float32 = 0.1
+	serialized, err := json.Marshal(nullable.NewFloat32(&basicFloat))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "0.1")
+}
+
 func TestFloat32(t *testing.T) {
 	type TestNullableFloat32 struct {
 		ID        uint
@@ -1,60 +1,255 @@
 package nullable
 
 import (
+	"cmp"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"math"
+	"strconv"
+	"strings"
 
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 )
 
 // Float64 SQL type that can retrieve NULL value
 type Float64 struct {
-	realValue float64
-	isValid   bool
+	core[float64]
 }
 
 // NewFloat64 creates a new nullable double precision float
 func NewFloat64(value *float64) Float64 {
-	if value == nil {
-		return Float64{
-			realValue: 0,
-			isValid:   false,
+	return Float64{core: newCore(value)}
+}
+
+// Float64From creates a new valid nullable double precision float from value
+func Float64From(value float64) Float64 {
+	return NewFloat64(&value)
+}
+
+// NullFloat64 creates a new NULL double precision float
+func NullFloat64() Float64 {
+	return NewFloat64(nil)
+}
+
+// Float64ZeroAsNull creates a new nullable double precision float that is NULL when value is the zero value
+func Float64ZeroAsNull(value float64) Float64 {
+	if value == 0 {
+		return NullFloat64()
+	}
+	return Float64From(value)
+}
+
+// Float64FromSQL creates a new nullable double precision float from sql.NullFloat64
+func Float64FromSQL(value sql.NullFloat64) Float64 {
+	if !value.Valid {
+		return NullFloat64()
+	}
+	return Float64From(value.Float64)
+}
+
+// CoalesceFloat64 returns the first valid value, or NULL when all of them are NULL
+func CoalesceFloat64(values ...Float64) Float64 {
+	for _, value := range values {
+		if value.isValid {
+			return value
 		}
 	}
-	return Float64{
-		realValue: *value,
-		isValid:   true,
+	return Float64{}
+}
+
+// MinFloat64 returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinFloat64(values ...Float64) Float64 {
+	var result Float64
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// MaxFloat64 returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxFloat64(values ...Float64) Float64 {
+	var result Float64
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
+		}
 	}
+	return result
 }
 
-// Get either nil or double precision float
-func (n Float64) Get() *float64 {
+// Float64Ptr returns n.Get(), handy where a function value is needed
+func Float64Ptr(n Float64) *float64 {
+	return n.Get()
+}
+
+// Float64Value returns n.GetOrZero(), zero value when NULL
+func Float64Value(n Float64) float64 {
+	return n.GetOrZero()
+}
+
+// ParseFloat64 parses text like UnmarshalText does, empty text is NULL
+func ParseFloat64(text string) (Float64, error) {
+	var n Float64
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Float64{}, err
+	}
+	return n, nil
+}
+
+// MustParseFloat64 is ParseFloat64 that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseFloat64(text string) Float64 {
+	n, err := ParseFloat64(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseFloat64(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL Float64, the one a declared
+// Float64 starts with, so pooled values can be reused
+func (n *Float64) Reset() {
+	*n = Float64{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Float64) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Float64) NullIfZero() {
+	if n.isValid && n.realValue == 0 {
+		n.SetNull()
+	}
+}
+
+// MustGet either double precision float or panic when NULL
+func (n Float64) MustGet() float64 {
+	return n.mustGet("Float64")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Float64) Clone() Float64 {
+	return n
+}
+
+// String returns double precision float in its natural text form, or "<null>" when NULL
+func (n Float64) String() string {
 	if !n.isValid {
-		return nil
+		return nullString
 	}
-	return &n.realValue
+	return strconv.FormatFloat(n.realValue, 'g', -1, 64)
 }
 
-// Set either nil or double precision float
-func (n *Float64) Set(value *float64) {
-	n.isValid = (value != nil)
-	if n.isValid {
-		n.realValue = *value
-	} else {
-		n.realValue = 0
+// DebugString returns Float64(value) or Float64(NULL), telling NULL apart from a
+// zero value in logs
+func (n Float64) DebugString() string {
+	return debugString("Float64", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same double precision float
+func (n Float64) Equal(other Float64) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
 	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Float64) Changed(old Float64) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any double precision float.
+func (n Float64) Compare(other Float64) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// ToSQL converts current value to sql.NullFloat64
+func (n Float64) ToSQL() sql.NullFloat64 {
+	return sql.NullFloat64{Float64: n.realValue, Valid: n.isValid}
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL the bits, negative zero as zero, telling NULL apart from zero in
+// cache keys
+func (n Float64) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagFloat64, n.isValid, hashFloat(n.realValue))
 }
 
 // MarshalJSON converts current value to JSON
 func (n Float64) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return appendJSONFloat(make([]byte, 0, 24), n.realValue)
+}
+
+// appendJSONFloat appends value formatted exactly like encoding/json does
+func appendJSONFloat(buffer []byte, value float64) ([]byte, error) {
+	if err := checkFinite(value); err != nil {
+		return nil, err
+	}
+
+	format := byte('f')
+	if abs := math.Abs(value); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	buffer = strconv.AppendFloat(buffer, value, format, -1, 64)
+	if format == 'e' {
+		// encoding/json writes e-7 instead of e-07
+		size := len(buffer)
+		if size >= 4 && buffer[size-4] == 'e' && buffer[size-3] == '-' && buffer[size-2] == '0' {
+			buffer[size-2] = buffer[size-1]
+			buffer = buffer[:size-1]
+		}
+	}
+	return buffer, nil
 }
 
 // UnmarshalJSON writes JSON to this type
 func (n *Float64) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
 		n.isValid = false
 		n.realValue = 0
 		return nil
@@ -62,6 +257,84 @@ func (n *Float64) UnmarshalJSON(data []byte) error {
 
 	var parsed float64
 	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Float64) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	if err := checkFinite(n.realValue); err != nil {
+		return nil, err
+	}
+	return strconv.AppendFloat(nil, n.realValue, 'g', -1, 64), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Float64) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseFloat(string(text), 64)
+	if err != nil {
+		return err
+	}
+	if err := checkFinite(parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Float64) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Float64) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Float64) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return copyFloat(n.realValue, 64), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Float64) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Float64) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed float64
+	if err := value.Decode(&parsed); err != nil {
 		return err
 	}
 
@@ -70,14 +343,133 @@ func (n *Float64) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Scan implements scanner interface
+// MarshalXML converts current value to XML
+func (n Float64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue, start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Float64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Float64) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Float64) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed float64
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Float64) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Float64) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed float64
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Float64) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Float64) GobDecode(data []byte) error {
+	var parsed float64
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// Scan implements scanner interface, rejecting NaN and infinities the way
+// MarshalJSON does
 func (n *Float64) Scan(value interface{}) error {
+	value = scanValue(value)
 	if value == nil {
 		n.realValue, n.isValid = 0, false
 		return nil
 	}
-	n.isValid = true
-	return convertAssign(&n.realValue, value)
+
+	var scanned float64
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
+	}
+	if err := checkFinite(scanned); err != nil {
+		return scanError(err)
+	}
+	n.realValue, n.isValid = scanned, true
+	return nil
 }
 
 // Value implements the driver Valuer interface.
@@ -85,21 +477,48 @@ func (n Float64) Value() (driver.Value, error) {
 	if !n.isValid {
 		return nil, nil
 	}
+	if err := checkFinite(n.realValue); err != nil {
+		return nil, err
+	}
 	return n.realValue, nil
 }
 
+// checkFinite fails on NaN, +Inf, and -Inf. JSON has no literal for them, and
+// null would be mistaken for NULL, so Float64 refuses them instead.
+func checkFinite(value float64) error {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return fmt.Errorf("nullable: Float64 cannot hold %v, only finite numbers are supported", value)
+	}
+	return nil
+}
+
 // GormDataType gorm common data type
 func (Float64) GormDataType() string {
 	return "float64_null"
 }
 
 // GormDBDataType gorm db data type
-func (Float64) GormDBDataType(db *gorm.DB, field *schema.Field) string {
-	switch db.Dialector.Name() {
+func (n Float64) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Float64) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Float64) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
 	case "sqlite", "mysql":
 		return "DOUBLE"
-	case "postgres":
+	case "postgres", "cockroachdb":
 		return "double precision"
+	case "sqlserver":
+		return "FLOAT"
+	case "clickhouse":
+		return "Nullable(Float64)"
 	}
 	return ""
 }
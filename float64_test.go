@@ -1,6 +1,11 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
 	"testing"
 
 	"github.com/tee8z/nullable"
@@ -26,6 +31,16 @@ func TestScanFloat64(t *testing.T) {
 	tests.AssertEqual(t, nullableFloat.Get(), nil)
 }
 
+func TestScanJSONNumberFloat64(t *testing.T) {
+	nullableFloat64 := nullable.NewFloat64(nil)
+
+	tests.AssertEqual(t, nullableFloat64.Scan(json.Number("1.5e3")), nil)
+	tests.AssertEqual(t, nullableFloat64.Get(), 1500.0)
+
+	tests.AssertEqual(t, nullableFloat64.Scan(json.Number("0.1")), nil)
+	tests.AssertEqual(t, nullableFloat64.Get(), 0.1)
+}
+
 func TestNewFloat64(t *testing.T) {
 	// Check if follow IEEE754 rules
 	var basicFloat1 float64 = 24.78
@@ -73,6 +88,268 @@ func TestSetFloat64(t *testing.T) {
 	tests.AssertEqual(t, nullableFloat.Get(), nil)
 }
 
+func TestFloat64From(t *testing.T) {
+	var basic float64 = 3.14159265359
+	tests.AssertEqual(t, nullable.Float64From(basic), nullable.NewFloat64(&basic))
+	tests.AssertEqual(t, nullable.Float64From(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullFloat64(), nullable.NewFloat64(nil))
+	tests.AssertEqual(t, nullable.NullFloat64().IsNull(), true)
+}
+
+func TestCoalesceFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	var zero float64 = 0
+	tests.AssertEqual(t, nullable.CoalesceFloat64(), nullable.Float64{})
+	tests.AssertEqual(t, nullable.CoalesceFloat64(nullable.NullFloat64(), nullable.NullFloat64()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceFloat64(nullable.NullFloat64(), nullable.Float64From(basic), nullable.Float64From(zero)), nullable.Float64From(basic))
+	tests.AssertEqual(t, nullable.CoalesceFloat64(nullable.Float64From(zero), nullable.Float64From(basic)), nullable.Float64From(zero))
+}
+
+func TestIsZeroFloat64(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullFloat64().IsZero(), true)
+	tests.AssertEqual(t, nullable.Float64From(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.Float64From(3.14159265359).IsZero(), false)
+}
+
+func TestCloneFloat64(t *testing.T) {
+	tests.AssertEqual(t, nullable.Float64From(3.14159265359).Clone(), nullable.Float64From(3.14159265359))
+	tests.AssertEqual(t, nullable.NullFloat64().Clone(), nullable.NullFloat64())
+}
+
+func TestUnwrapFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	value, ok := nullable.Float64From(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullFloat64().Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Float64
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseFloat64(t *testing.T) {
+	parsed, err := nullable.ParseFloat64("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullFloat64())
+
+	text, err := nullable.Float64From(3.14159265359).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseFloat64(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.Float64From(3.14159265359)), true)
+
+	for _, text := range []string{"abc", "1,5"} {
+		if _, err := nullable.ParseFloat64(text); err == nil {
+			t.Errorf("parsing %q as double precision float must fail", text)
+		}
+	}
+}
+
+func TestMustParseFloat64(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseFloat64(""), nullable.NullFloat64())
+
+	text, err := nullable.Float64From(3.14159265359).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseFloat64(string(text)).Equal(nullable.Float64From(3.14159265359)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseFloat64 of malformed text must panic")
+		}
+	}()
+	nullable.MustParseFloat64("abc")
+}
+
+func TestPtrFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	tests.AssertEqual(t, nullable.Float64Ptr(nullable.NewFloat64(&basic)), basic)
+	tests.AssertEqual(t, nullable.Float64Ptr(nullable.NewFloat64(nil)), nil)
+	tests.AssertEqual(t, nullable.Float64Value(nullable.NewFloat64(&basic)), basic)
+	tests.AssertEqual(t, nullable.Float64Value(nullable.NewFloat64(nil)), 0)
+}
+
+func TestSetValueFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	var nullableFloat64 nullable.Float64
+	nullableFloat64.SetValue(basic)
+	tests.AssertEqual(t, nullableFloat64, nullable.Float64From(basic))
+
+	nullableFloat64.SetNull()
+	tests.AssertEqual(t, nullableFloat64, nullable.NullFloat64())
+}
+
+func TestZeroAsNullFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	var zero float64 = 0
+	tests.AssertEqual(t, nullable.Float64ZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.Float64ZeroAsNull(basic), nullable.Float64From(basic))
+
+	nullableFloat64 := nullable.Float64From(zero)
+	nullableFloat64.NullIfZero()
+	tests.AssertEqual(t, nullableFloat64, nullable.NullFloat64())
+
+	nullableFloat64 = nullable.Float64From(basic)
+	nullableFloat64.NullIfZero()
+	tests.AssertEqual(t, nullableFloat64, nullable.Float64From(basic))
+}
+
+func TestFloat64FromSQL(t *testing.T) {
+	var basic float64 = 3.14159265359
+	tests.AssertEqual(t, nullable.Float64FromSQL(sql.NullFloat64{Float64: basic, Valid: true}), nullable.Float64From(basic))
+	tests.AssertEqual(t, nullable.Float64FromSQL(sql.NullFloat64{Float64: basic}), nullable.NullFloat64())
+
+	tests.AssertEqual(t, nullable.Float64From(basic).ToSQL(), sql.NullFloat64{Float64: basic, Valid: true})
+	tests.AssertEqual(t, nullable.NullFloat64().ToSQL(), sql.NullFloat64{})
+}
+
+func TestIsNullFloat64(t *testing.T) {
+	var zero nullable.Float64
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic float64 = 3.14159265359
+	nullableFloat64 := nullable.NewFloat64(&basic)
+	tests.AssertEqual(t, nullableFloat64.IsNull(), false)
+	tests.AssertEqual(t, nullableFloat64.IsValid(), true)
+
+	nullableFloat64.Set(nil)
+	tests.AssertEqual(t, nullableFloat64.IsNull(), true)
+	tests.AssertEqual(t, nullableFloat64.IsValid(), false)
+}
+
+func TestGetOrFloat64(t *testing.T) {
+	var zero nullable.Float64
+	var fallback float64 = 3.14159265359
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), 0)
+
+	var basic float64 = 3.14159265359
+	nullableFloat64 := nullable.NewFloat64(&basic)
+	tests.AssertEqual(t, nullableFloat64.GetOr(0), basic)
+	tests.AssertEqual(t, nullableFloat64.GetOrZero(), basic)
+}
+
+func TestMustGetFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	nullableFloat64 := nullable.NewFloat64(&basic)
+	tests.AssertEqual(t, nullableFloat64.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Float64")
+	}()
+	nullableFloat64.Set(nil)
+	nullableFloat64.MustGet()
+	t.Error("MustGet on NULL Float64 must panic")
+}
+
+func TestTextFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	marshalUnmarshalText(t, nullable.NewFloat64(&basic))
+
+	marshalUnmarshalText(t, nullable.NewFloat64(nil))
+}
+
+func TestCSVFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	marshalUnmarshalCSV(t, nullable.NewFloat64(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewFloat64(nil))
+}
+
+func TestYAMLFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	marshalUnmarshalYAML(t, nullable.NewFloat64(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewFloat64(nil))
+}
+
+func TestMsgpackFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	marshalUnmarshalMsgpack(t, nullable.NewFloat64(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewFloat64(nil))
+}
+
+func TestBSONFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	marshalUnmarshalBSON(t, nullable.NewFloat64(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewFloat64(nil))
+}
+
+func TestGobFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	marshalUnmarshalGob(t, nullable.NewFloat64(&basic))
+
+	var zero float64 = 0
+	marshalUnmarshalGob(t, nullable.NewFloat64(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewFloat64(nil))
+}
+
+func TestStringerFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	nullableFloat64 := nullable.NewFloat64(&basic)
+	tests.AssertEqual(t, nullableFloat64.String(), "3.14159265359")
+	tests.AssertEqual(t, fmt.Sprint(nullableFloat64), "3.14159265359")
+
+	nullableFloat64.Set(nil)
+	tests.AssertEqual(t, nullableFloat64.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableFloat64), "<null>")
+}
+
+func TestChangedFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	value, null := nullable.NewFloat64(&basic), nullable.NewFloat64(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.Float64From(0)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewFloat64(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewFloat64(nil)), false)
+}
+
+func TestEqualFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	var zero float64 = 0
+	tests.AssertEqual(t, nullable.NewFloat64(nil).Equal(nullable.NewFloat64(nil)), true)
+	tests.AssertEqual(t, nullable.NewFloat64(nil).Equal(nullable.NewFloat64(&basic)), false)
+	tests.AssertEqual(t, nullable.NewFloat64(&basic).Equal(nullable.NewFloat64(nil)), false)
+	tests.AssertEqual(t, nullable.NewFloat64(&basic).Equal(nullable.NewFloat64(&basic)), true)
+	tests.AssertEqual(t, nullable.NewFloat64(&basic).Equal(nullable.NewFloat64(&zero)), false)
+	tests.AssertEqual(t, nullable.NewFloat64(&zero).Equal(nullable.NewFloat64(nil)), false)
+}
+
+func TestCompareFloat64(t *testing.T) {
+	var lesser float64 = -3.14159265359
+	var greater float64 = 3.14159265359
+	null := nullable.NewFloat64(nil)
+	small := nullable.NewFloat64(&lesser)
+	big := nullable.NewFloat64(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewFloat64(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewFloat64(&greater)), 0)
+
+	values := []nullable.Float64{big, null, small}
+	slices.SortFunc(values, nullable.Float64.Compare)
+	tests.AssertEqual(t, values, []nullable.Float64{null, small, big})
+}
+
+func TestXMLFloat64(t *testing.T) {
+	var basic float64 = 3.14159265359
+	marshalUnmarshalXML(t, nullable.NewFloat64(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewFloat64(nil))
+}
+
 func TestJSONFloat64(t *testing.T) {
 	var basicFloat1 float64 = 24.78
 	marshalUnmarshalJSON(t, nullable.NewFloat64(&basicFloat1))
@@ -89,6 +366,68 @@ func TestJSONFloat64(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewFloat64(nil))
 }
 
+func TestMarshalJSONCompatibleFloat64(t *testing.T) {
+	for _, basic := range []float64{0, 3.14159265359, -0.000001, 0.0000001, 1e20, 1e21, -1.5e-300, math.MaxFloat64, math.SmallestNonzeroFloat64, 123456789.125} {
+		expected, err := json.Marshal(&basic)
+		tests.AssertEqual(t, err, nil)
+		serialized, err := json.Marshal(nullable.NewFloat64(&basic))
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(serialized), string(expected))
+	}
+
+	serialized, err := json.Marshal(nullable.NewFloat64(nil))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "null")
+}
+
+func TestNonFiniteFloat64(t *testing.T) {
+	for _, special := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+		message := fmt.Sprintf("nullable: Float64 cannot hold %v, only finite numbers are supported", special)
+		nullableFloat := nullable.Float64From(special)
+
+		_, err := nullableFloat.MarshalJSON()
+		tests.AssertEqual(t, err.Error(), message)
+		if _, err := json.Marshal(struct{ Score nullable.Float64 }{nullableFloat}); err == nil {
+			t.Errorf("json.Marshal of %v must fail", special)
+		}
+		_, err = nullableFloat.MarshalText()
+		tests.AssertEqual(t, err.Error(), message)
+		_, err = nullableFloat.Value()
+		tests.AssertEqual(t, err.Error(), message)
+
+		scanned := nullable.Float64From(1)
+		tests.AssertEqual(t, scanned.Scan(special).Error(), message)
+		tests.AssertEqual(t, scanned, nullable.Float64From(1))
+		tests.AssertEqual(t, scanned.UnmarshalText([]byte(fmt.Sprint(special))).Error(), message)
+		tests.AssertEqual(t, scanned, nullable.Float64From(1))
+	}
+
+	var unserialized nullable.Float64
+	for _, data := range []string{`NaN`, `"NaN"`, `Infinity`, `-Infinity`, `"+Inf"`, `1e400`} {
+		if err := unserialized.UnmarshalJSON([]byte(data)); err == nil {
+			t.Errorf("unmarshalling %s must fail", data)
+		}
+	}
+	tests.AssertEqual(t, unserialized.IsNull(), true)
+}
+
+func BenchmarkMarshalJSONFloat64(b *testing.B) {
+	var basic float64 = 3.14159265359
+	nullableFloat64 := nullable.NewFloat64(&basic)
+	b.Run("nullable", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			jsonSink, _ = nullableFloat64.MarshalJSON()
+		}
+	})
+	b.Run("json.Marshal of Get", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			jsonSink, _ = json.Marshal(nullableFloat64.Get())
+		}
+	})
+}
+
 func TestFloat64(t *testing.T) {
 	type TestNullableFloat64 struct {
 		ID        uint
@@ -0,0 +1,36 @@
+package nullable
+
+import (
+	"bytes"
+	"encoding/gob"
+	"errors"
+)
+
+// gobEncode writes validity flag followed by gob encoded value when valid
+func gobEncode(isValid bool, value interface{}) ([]byte, error) {
+	if !isValid {
+		return []byte{0}, nil
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteByte(1)
+	if err := gob.NewEncoder(&buffer).Encode(value); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// gobDecode reads what gobEncode wrote, target is left untouched when NULL
+func gobDecode(data []byte, target interface{}) (isValid bool, err error) {
+	if len(data) == 0 {
+		return false, errors.New("nullable: gob data is empty")
+	}
+
+	switch data[0] {
+	case 0:
+		return false, nil
+	case 1:
+		return true, gob.NewDecoder(bytes.NewReader(data[1:])).Decode(target)
+	}
+	return false, errors.New("nullable: gob data has unknown validity flag")
+}
@@ -0,0 +1,61 @@
+package nullable_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"reflect"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+type gobEnvelope[T any] struct {
+	Value T
+}
+
+func marshalUnmarshalGob[T any](t *testing.T, target T) {
+	var buffer bytes.Buffer
+	if err := gob.NewEncoder(&buffer).Encode(gobEnvelope[T]{Value: target}); err != nil {
+		t.Fatalf("Failed to encode %T because: %s", target, err)
+		return
+	}
+
+	var unserialized gobEnvelope[T]
+	if err := gob.NewDecoder(&buffer).Decode(&unserialized); err != nil {
+		t.Fatalf("Failed to decode %T because: %s", target, err)
+		return
+	}
+	// AssertEqual compares printed values, which cannot tell nil from empty
+	if !reflect.DeepEqual(unserialized.Value, target) {
+		t.Errorf("%T did not round trip through gob: expect %#v, got %#v", target, target, unserialized.Value)
+	}
+}
+
+func TestGobNull(t *testing.T) {
+	// Zero value but valid must not come back as NULL and vice versa
+	zero := nullable.Int64From(0)
+	var decoded nullable.Int64
+	serialized, err := zero.GobEncode()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, decoded.GobDecode(serialized), nil)
+	tests.AssertEqual(t, decoded.IsValid(), true)
+
+	serialized, err = nullable.NullInt64().GobEncode()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, decoded.GobDecode(serialized), nil)
+	tests.AssertEqual(t, decoded.IsNull(), true)
+
+	if err := decoded.GobDecode(nil); err == nil {
+		t.Error("decoding empty gob data must fail")
+	}
+	if err := decoded.GobDecode([]byte{2}); err == nil {
+		t.Error("decoding unknown validity flag must fail")
+	}
+}
+
+func TestGobNullable(t *testing.T) {
+	marshalUnmarshalGob(t, nullable.NullableFrom(uint64(18446744073709551615)))
+	marshalUnmarshalGob(t, nullable.NullableFrom(""))
+	marshalUnmarshalGob(t, nullable.Null[string]())
+}
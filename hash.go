@@ -0,0 +1,96 @@
+package nullable
+
+import (
+	"encoding/binary"
+	"hash"
+	"math"
+	"time"
+)
+
+// Type tags WriteHash starts with, so that equal bytes of different types
+// such as Int64 and Uint64 hash apart. They are part of the hash, so new
+// types only ever get appended here.
+const (
+	hashTagBigInt byte = iota + 1
+	hashTagBool
+	hashTagByte
+	hashTagBytes
+	hashTagDate
+	hashTagDecimal
+	hashTagDuration
+	hashTagEnum
+	hashTagFloat32
+	hashTagFloat64
+	hashTagInt
+	hashTagInt8
+	hashTagInt16
+	hashTagInt32
+	hashTagInt64
+	hashTagInt64Array
+	hashTagIP
+	hashTagJSON
+	hashTagMoney
+	hashTagRune
+	hashTagString
+	hashTagTime
+	hashTagUint
+	hashTagUint8
+	hashTagUint16
+	hashTagUint32
+	hashTagUint64
+	hashTagUint64Array
+	hashTagURL
+	hashTagUUID
+	hashTagPoint
+)
+
+// writeHash writes the type tag, the validity byte, and unless NULL the
+// length of value followed by value, so that fields written one after
+// another cannot run into each other
+func writeHash(h hash.Hash, tag byte, isValid bool, value []byte) {
+	if !isValid {
+		h.Write([]byte{tag, 0})
+		return
+	}
+	header := make([]byte, 2, 2+binary.MaxVarintLen64)
+	header[0], header[1] = tag, 1
+	h.Write(binary.AppendUvarint(header, uint64(len(value))))
+	h.Write(value)
+}
+
+// hashUint64 returns value as 8 big-endian bytes
+func hashUint64(value uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, value)
+}
+
+// hashFloat returns the bits of value, with negative zero written as zero
+// since Equal tells them apart from nothing
+func hashFloat(value float64) []byte {
+	if value == 0 {
+		value = 0
+	}
+	return hashUint64(math.Float64bits(value))
+}
+
+// hashTime returns the instant of value, leaving out its location the way
+// time.Time.Equal does
+func hashTime(value time.Time) []byte {
+	return binary.BigEndian.AppendUint32(hashUint64(uint64(value.Unix())), uint32(value.Nanosecond()))
+}
+
+// hashUint64s returns each of values as 8 big-endian bytes
+func hashUint64s[T int64 | uint64](values []T) []byte {
+	result := make([]byte, 0, 8*len(values))
+	for _, value := range values {
+		result = binary.BigEndian.AppendUint64(result, uint64(value))
+	}
+	return result
+}
+
+// boolByte returns 1 for true and 0 for false
+func boolByte(value bool) byte {
+	if value {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,128 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"hash"
+	"hash/fnv"
+	"math"
+	"math/big"
+	"net"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+type hashWriter interface {
+	WriteHash(h hash.Hash)
+}
+
+func hashOf(values ...hashWriter) uint64 {
+	h := fnv.New64a()
+	for _, value := range values {
+		value.WriteHash(h)
+	}
+	return h.Sum64()
+}
+
+func TestWriteHashNullIsNotZero(t *testing.T) {
+	cases := []struct {
+		null hashWriter
+		zero hashWriter
+	}{
+		{nullable.NullBigInt(), nullable.BigIntFromInt64(0)},
+		{nullable.NullBool(), nullable.BoolFrom(false)},
+		{nullable.NullByte(), nullable.ByteFrom(0)},
+		{nullable.NullBytes(), nullable.BytesFrom([]byte{})},
+		{nullable.NullDate(), nullable.DateFrom(time.Time{})},
+		{nullable.NullDecimal(), nullable.DecimalFrom(decimal.Zero)},
+		{nullable.NullDuration(), nullable.DurationFrom(0)},
+		{nullable.NullDurationISO(), nullable.DurationISOFrom(0)},
+		{nullable.NullEnum([]string{""}), nullable.NewEnum([]string{""}, new(string))},
+		{nullable.NullFloat32(), nullable.Float32From(0)},
+		{nullable.NullFloat64(), nullable.Float64From(0)},
+		{nullable.NullInt(), nullable.IntFrom(0)},
+		{nullable.NullInt8(), nullable.Int8From(0)},
+		{nullable.NullInt16(), nullable.Int16From(0)},
+		{nullable.NullInt32(), nullable.Int32From(0)},
+		{nullable.NullInt64(), nullable.Int64From(0)},
+		{nullable.NullInt64Array(), nullable.Int64ArrayFrom([]int64{})},
+		{nullable.NullIP(), nullable.IPFrom(net.IPv4zero)},
+		{nullable.NullJSON(), nullable.JSONFrom(json.RawMessage{})},
+		{nullable.NullMoney(), nullable.MoneyFrom(decimal.Zero, "USD")},
+		{nullable.NullPoint(), nullable.PointFrom(0, 0)},
+		{nullable.NullRune(), nullable.RuneFrom(0)},
+		{nullable.NullString(), nullable.StringFrom("")},
+		{nullable.NullStringTrimmed(), nullable.StringTrimmedFrom("")},
+		{nullable.NullTime(), nullable.TimeFrom(time.Time{})},
+		{nullable.NullUint(), nullable.UintFrom(0)},
+		{nullable.NullUint8(), nullable.Uint8From(0)},
+		{nullable.NullUint16(), nullable.Uint16From(0)},
+		{nullable.NullUint32(), nullable.Uint32From(0)},
+		{nullable.NullUint64(), nullable.Uint64From(0)},
+		{nullable.NullUint64Array(), nullable.Uint64ArrayFrom([]uint64{})},
+		{nullable.NullURL(), nullable.URLFrom(url.URL{})},
+		{nullable.NullUUID(), nullable.UUIDFrom(uuid.Nil)},
+	}
+	for _, c := range cases {
+		if hashOf(c.null) == hashOf(c.zero) {
+			t.Errorf("NULL and zero %T must hash apart", c.zero)
+		}
+		// The same value always gives the same hash
+		tests.AssertEqual(t, hashOf(c.null), hashOf(c.null))
+		tests.AssertEqual(t, hashOf(c.zero), hashOf(c.zero))
+	}
+}
+
+func TestWriteHashFollowsEqual(t *testing.T) {
+	instant := time.Date(2021, time.March, 4, 5, 6, 7, 8, time.UTC)
+	cases := []struct {
+		a, b hashWriter
+	}{
+		{nullable.DecimalFrom(decimal.RequireFromString("1.0")), nullable.DecimalFrom(decimal.RequireFromString("1.00"))},
+		{nullable.MustParseMoney("13.00 USD"), nullable.MustParseMoney("13 USD")},
+		{nullable.TimeFrom(instant), nullable.TimeFrom(instant.In(time.FixedZone("WIB", 7*60*60)))},
+		{nullable.Float64From(0), nullable.Float64From(math.Copysign(0, -1))},
+		{nullable.IPFrom(net.ParseIP("192.168.1.10").To4()), nullable.IPFrom(net.ParseIP("192.168.1.10"))},
+		{nullable.NewBigInt(big.NewInt(42)), nullable.BigIntFromInt64(42)},
+	}
+	for _, c := range cases {
+		tests.AssertEqual(t, hashOf(c.a), hashOf(c.b))
+	}
+}
+
+func TestWriteHashTellsApart(t *testing.T) {
+	cases := []struct {
+		a, b []hashWriter
+	}{
+		// Same bytes of different types
+		{[]hashWriter{nullable.Int64From(5)}, []hashWriter{nullable.Uint64From(5)}},
+		{[]hashWriter{nullable.StringFrom("a")}, []hashWriter{nullable.BytesFrom([]byte("a"))}},
+		// Fields don't run into each other
+		{
+			[]hashWriter{nullable.StringFrom("ab"), nullable.StringFrom("c")},
+			[]hashWriter{nullable.StringFrom("a"), nullable.StringFrom("bc")},
+		},
+		{
+			[]hashWriter{nullable.NullString(), nullable.StringFrom("")},
+			[]hashWriter{nullable.StringFrom(""), nullable.NullString()},
+		},
+		{[]hashWriter{nullable.Int64ArrayFrom([]int64{1, 2})}, []hashWriter{nullable.Int64ArrayFrom([]int64{2, 1})}},
+		{[]hashWriter{nullable.MustParseMoney("1 USD")}, []hashWriter{nullable.MustParseMoney("1 EUR")}},
+	}
+	for _, c := range cases {
+		if hashOf(c.a...) == hashOf(c.b...) {
+			t.Errorf("%v and %v must hash apart", c.a, c.b)
+		}
+	}
+
+	// Written byte for byte, so the hash never changes between releases
+	h := fnv.New64a()
+	nullable.Uint64From(1).WriteHash(h)
+	nullable.NullString().WriteHash(h)
+	tests.AssertEqual(t, h.Sum64(), hashOf(nullable.Uint64From(1), nullable.NullString()))
+}
@@ -1,49 +1,200 @@
 package nullable
 
 import (
+	"cmp"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
 
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 )
 
 // Int SQL type that can retrieve NULL value
 type Int struct {
-	realValue int
-	isValid   bool
+	core[int]
 }
 
 // NewInt creates a new nullable integer
 func NewInt(value *int) Int {
-	if value == nil {
-		return Int{
-			realValue: 0,
-			isValid:   false,
+	return Int{core: newCore(value)}
+}
+
+// IntFrom creates a new valid nullable integer from value
+func IntFrom(value int) Int {
+	return NewInt(&value)
+}
+
+// NullInt creates a new NULL integer
+func NullInt() Int {
+	return NewInt(nil)
+}
+
+// IntZeroAsNull creates a new nullable integer that is NULL when value is the zero value
+func IntZeroAsNull(value int) Int {
+	if value == 0 {
+		return NullInt()
+	}
+	return IntFrom(value)
+}
+
+// CoalesceInt returns the first valid value, or NULL when all of them are NULL
+func CoalesceInt(values ...Int) Int {
+	for _, value := range values {
+		if value.isValid {
+			return value
 		}
 	}
-	return Int{
-		realValue: *value,
-		isValid:   true,
+	return Int{}
+}
+
+// MinInt returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinInt(values ...Int) Int {
+	var result Int
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// MaxInt returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxInt(values ...Int) Int {
+	var result Int
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
+		}
 	}
+	return result
 }
 
-// Get either nil or integer
-func (n Int) Get() *int {
+// IntPtr returns n.Get(), handy where a function value is needed
+func IntPtr(n Int) *int {
+	return n.Get()
+}
+
+// IntValue returns n.GetOrZero(), zero value when NULL
+func IntValue(n Int) int {
+	return n.GetOrZero()
+}
+
+// ParseInt parses text like UnmarshalText does, empty text is NULL
+func ParseInt(text string) (Int, error) {
+	var n Int
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Int{}, err
+	}
+	return n, nil
+}
+
+// MustParseInt is ParseInt that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseInt(text string) Int {
+	n, err := ParseInt(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseInt(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL Int, the one a declared
+// Int starts with, so pooled values can be reused
+func (n *Int) Reset() {
+	*n = Int{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Int) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Int) NullIfZero() {
+	if n.isValid && n.realValue == 0 {
+		n.SetNull()
+	}
+}
+
+// MustGet either integer or panic when NULL
+func (n Int) MustGet() int {
+	return n.mustGet("Int")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Int) Clone() Int {
+	return n
+}
+
+// String returns integer in its natural text form, or "<null>" when NULL
+func (n Int) String() string {
 	if !n.isValid {
-		return nil
+		return nullString
 	}
-	return &n.realValue
+	return strconv.FormatInt(int64(n.realValue), 10)
 }
 
-// Set either nil or integer
-func (n *Int) Set(value *int) {
-	n.isValid = (value != nil)
-	if n.isValid {
-		n.realValue = *value
-	} else {
-		n.realValue = 0
+// DebugString returns Int(value) or Int(NULL), telling NULL apart from a
+// zero value in logs
+func (n Int) DebugString() string {
+	return debugString("Int", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same integer
+func (n Int) Equal(other Int) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
 	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Int) Changed(old Int) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any integer.
+func (n Int) Compare(other Int) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL 8 big-endian bytes, telling NULL apart from zero in cache keys
+func (n Int) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagInt, n.isValid, hashUint64(uint64(n.realValue)))
 }
 
 // MarshalJSON converts current value to JSON
@@ -53,8 +204,11 @@ func (n Int) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON writes JSON to this type
 func (n *Int) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
 		n.isValid = false
 		n.realValue = 0
 		return nil
@@ -62,6 +216,78 @@ func (n *Int) UnmarshalJSON(data []byte) error {
 
 	var parsed int
 	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Int) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, int64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Int) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(string(text), 10, 0)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = int(parsed)
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Int) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Int) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Int) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return strconv.Itoa(n.realValue), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Int) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Int) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed int
+	if err := value.Decode(&parsed); err != nil {
 		return err
 	}
 
@@ -70,14 +296,134 @@ func (n *Int) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalXML converts current value to XML
+func (n Int) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue, start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Int) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(strings.TrimSpace(text), 10, 0)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = int(parsed)
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Int) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Int) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed int
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Int) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Int) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed int
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Int) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Int) GobDecode(data []byte) error {
+	var parsed int
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
 // Scan implements scanner interface
 func (n *Int) Scan(value interface{}) error {
+	value = scanValue(value)
 	if value == nil {
 		n.realValue, n.isValid = 0, false
 		return nil
 	}
+
+	if floatValue, ok := value.(float64); ok {
+		parsed, err := scanFloatInt(floatValue, strconv.IntSize, "int")
+		if err != nil {
+			return scanError(err)
+		}
+		n.realValue, n.isValid = int(parsed), true
+		return nil
+	}
+
 	n.isValid = true
-	return convertAssign(&n.realValue, value)
+	return scanError(convertAssign(&n.realValue, value))
 }
 
 // Value implements the driver Valuer interface.
@@ -94,12 +440,29 @@ func (Int) GormDataType() string {
 }
 
 // GormDBDataType gorm db data type
-func (Int) GormDBDataType(db *gorm.DB, field *schema.Field) string {
-	switch db.Dialector.Name() {
+func (n Int) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Int) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Int) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
 	case "sqlite", "mysql":
 		return "BIGINT"
 	case "postgres":
 		return "bigint"
+	case "sqlserver":
+		return "BIGINT"
+	case "clickhouse":
+		return "Nullable(Int64)"
+	case "cockroachdb":
+		return "INT8"
 	}
 	return ""
 }
@@ -1,12 +1,277 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/tee8z/nullable"
 	"gorm.io/gorm/utils/tests"
 )
 
+func TestInt16From(t *testing.T) {
+	var basic int16 = -12345
+	tests.AssertEqual(t, nullable.Int16From(basic), nullable.NewInt16(&basic))
+	tests.AssertEqual(t, nullable.Int16From(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullInt16(), nullable.NewInt16(nil))
+	tests.AssertEqual(t, nullable.NullInt16().IsNull(), true)
+}
+
+func TestCoalesceInt16(t *testing.T) {
+	var basic int16 = -12345
+	var zero int16 = 0
+	tests.AssertEqual(t, nullable.CoalesceInt16(), nullable.Int16{})
+	tests.AssertEqual(t, nullable.CoalesceInt16(nullable.NullInt16(), nullable.NullInt16()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceInt16(nullable.NullInt16(), nullable.Int16From(basic), nullable.Int16From(zero)), nullable.Int16From(basic))
+	tests.AssertEqual(t, nullable.CoalesceInt16(nullable.Int16From(zero), nullable.Int16From(basic)), nullable.Int16From(zero))
+}
+
+func TestIsZeroInt16(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullInt16().IsZero(), true)
+	tests.AssertEqual(t, nullable.Int16From(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.Int16From(-12345).IsZero(), false)
+}
+
+func TestCloneInt16(t *testing.T) {
+	tests.AssertEqual(t, nullable.Int16From(-12345).Clone(), nullable.Int16From(-12345))
+	tests.AssertEqual(t, nullable.NullInt16().Clone(), nullable.NullInt16())
+}
+
+func TestUnwrapInt16(t *testing.T) {
+	var basic int16 = -12345
+	value, ok := nullable.Int16From(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullInt16().Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Int16
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseInt16(t *testing.T) {
+	parsed, err := nullable.ParseInt16("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullInt16())
+
+	text, err := nullable.Int16From(-12345).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseInt16(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.Int16From(-12345)), true)
+
+	for _, text := range []string{"abc", "1.5", "1e3"} {
+		if _, err := nullable.ParseInt16(text); err == nil {
+			t.Errorf("parsing %q as 16-bit integer must fail", text)
+		}
+	}
+}
+
+func TestMustParseInt16(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseInt16(""), nullable.NullInt16())
+
+	text, err := nullable.Int16From(-12345).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseInt16(string(text)).Equal(nullable.Int16From(-12345)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseInt16 of malformed text must panic")
+		}
+	}()
+	nullable.MustParseInt16("abc")
+}
+
+func TestPtrInt16(t *testing.T) {
+	var basic int16 = -12345
+	tests.AssertEqual(t, nullable.Int16Ptr(nullable.NewInt16(&basic)), basic)
+	tests.AssertEqual(t, nullable.Int16Ptr(nullable.NewInt16(nil)), nil)
+	tests.AssertEqual(t, nullable.Int16Value(nullable.NewInt16(&basic)), basic)
+	tests.AssertEqual(t, nullable.Int16Value(nullable.NewInt16(nil)), 0)
+}
+
+func TestSetValueInt16(t *testing.T) {
+	var basic int16 = -12345
+	var nullableInt16 nullable.Int16
+	nullableInt16.SetValue(basic)
+	tests.AssertEqual(t, nullableInt16, nullable.Int16From(basic))
+
+	nullableInt16.SetNull()
+	tests.AssertEqual(t, nullableInt16, nullable.NullInt16())
+}
+
+func TestZeroAsNullInt16(t *testing.T) {
+	var basic int16 = -12345
+	var zero int16 = 0
+	tests.AssertEqual(t, nullable.Int16ZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.Int16ZeroAsNull(basic), nullable.Int16From(basic))
+
+	nullableInt16 := nullable.Int16From(zero)
+	nullableInt16.NullIfZero()
+	tests.AssertEqual(t, nullableInt16, nullable.NullInt16())
+
+	nullableInt16 = nullable.Int16From(basic)
+	nullableInt16.NullIfZero()
+	tests.AssertEqual(t, nullableInt16, nullable.Int16From(basic))
+}
+
+func TestInt16FromSQL(t *testing.T) {
+	var basic int16 = -12345
+	tests.AssertEqual(t, nullable.Int16FromSQL(sql.NullInt16{Int16: basic, Valid: true}), nullable.Int16From(basic))
+	tests.AssertEqual(t, nullable.Int16FromSQL(sql.NullInt16{Int16: basic}), nullable.NullInt16())
+
+	tests.AssertEqual(t, nullable.Int16From(basic).ToSQL(), sql.NullInt16{Int16: basic, Valid: true})
+	tests.AssertEqual(t, nullable.NullInt16().ToSQL(), sql.NullInt16{})
+}
+
+func TestIsNullInt16(t *testing.T) {
+	var zero nullable.Int16
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic int16 = -12345
+	nullableInt16 := nullable.NewInt16(&basic)
+	tests.AssertEqual(t, nullableInt16.IsNull(), false)
+	tests.AssertEqual(t, nullableInt16.IsValid(), true)
+
+	nullableInt16.Set(nil)
+	tests.AssertEqual(t, nullableInt16.IsNull(), true)
+	tests.AssertEqual(t, nullableInt16.IsValid(), false)
+}
+
+func TestGetOrInt16(t *testing.T) {
+	var zero nullable.Int16
+	var fallback int16 = -12345
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), 0)
+
+	var basic int16 = -12345
+	nullableInt16 := nullable.NewInt16(&basic)
+	tests.AssertEqual(t, nullableInt16.GetOr(0), basic)
+	tests.AssertEqual(t, nullableInt16.GetOrZero(), basic)
+}
+
+func TestMustGetInt16(t *testing.T) {
+	var basic int16 = -12345
+	nullableInt16 := nullable.NewInt16(&basic)
+	tests.AssertEqual(t, nullableInt16.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Int16")
+	}()
+	nullableInt16.Set(nil)
+	nullableInt16.MustGet()
+	t.Error("MustGet on NULL Int16 must panic")
+}
+
+func TestTextInt16(t *testing.T) {
+	var basic int16 = -12345
+	marshalUnmarshalText(t, nullable.NewInt16(&basic))
+
+	marshalUnmarshalText(t, nullable.NewInt16(nil))
+}
+
+func TestCSVInt16(t *testing.T) {
+	var basic int16 = -12345
+	marshalUnmarshalCSV(t, nullable.NewInt16(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewInt16(nil))
+}
+
+func TestYAMLInt16(t *testing.T) {
+	var basic int16 = -12345
+	marshalUnmarshalYAML(t, nullable.NewInt16(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewInt16(nil))
+}
+
+func TestMsgpackInt16(t *testing.T) {
+	var basic int16 = -12345
+	marshalUnmarshalMsgpack(t, nullable.NewInt16(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewInt16(nil))
+}
+
+func TestBSONInt16(t *testing.T) {
+	var basic int16 = -12345
+	marshalUnmarshalBSON(t, nullable.NewInt16(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewInt16(nil))
+}
+
+func TestGobInt16(t *testing.T) {
+	var basic int16 = -12345
+	marshalUnmarshalGob(t, nullable.NewInt16(&basic))
+
+	var zero int16 = 0
+	marshalUnmarshalGob(t, nullable.NewInt16(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewInt16(nil))
+}
+
+func TestStringerInt16(t *testing.T) {
+	var basic int16 = -12345
+	nullableInt16 := nullable.NewInt16(&basic)
+	tests.AssertEqual(t, nullableInt16.String(), "-12345")
+	tests.AssertEqual(t, fmt.Sprint(nullableInt16), "-12345")
+
+	nullableInt16.Set(nil)
+	tests.AssertEqual(t, nullableInt16.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableInt16), "<null>")
+}
+
+func TestChangedInt16(t *testing.T) {
+	var basic int16 = -12345
+	value, null := nullable.NewInt16(&basic), nullable.NewInt16(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.Int16From(0)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewInt16(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewInt16(nil)), false)
+}
+
+func TestEqualInt16(t *testing.T) {
+	var basic int16 = -12345
+	var zero int16 = 0
+	tests.AssertEqual(t, nullable.NewInt16(nil).Equal(nullable.NewInt16(nil)), true)
+	tests.AssertEqual(t, nullable.NewInt16(nil).Equal(nullable.NewInt16(&basic)), false)
+	tests.AssertEqual(t, nullable.NewInt16(&basic).Equal(nullable.NewInt16(nil)), false)
+	tests.AssertEqual(t, nullable.NewInt16(&basic).Equal(nullable.NewInt16(&basic)), true)
+	tests.AssertEqual(t, nullable.NewInt16(&basic).Equal(nullable.NewInt16(&zero)), false)
+	tests.AssertEqual(t, nullable.NewInt16(&zero).Equal(nullable.NewInt16(nil)), false)
+}
+
+func TestCompareInt16(t *testing.T) {
+	var lesser int16 = -12345
+	var greater int16 = 12345
+	null := nullable.NewInt16(nil)
+	small := nullable.NewInt16(&lesser)
+	big := nullable.NewInt16(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewInt16(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewInt16(&greater)), 0)
+
+	values := []nullable.Int16{big, null, small}
+	slices.SortFunc(values, nullable.Int16.Compare)
+	tests.AssertEqual(t, values, []nullable.Int16{null, small, big})
+}
+
+func TestXMLInt16(t *testing.T) {
+	var basic int16 = -12345
+	marshalUnmarshalXML(t, nullable.NewInt16(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewInt16(nil))
+}
+
 func TestJSONInt16(t *testing.T) {
 	var basicInt1 int16 = 37
 	marshalUnmarshalJSON(t, nullable.NewInt16(&basicInt1))
@@ -23,6 +288,39 @@ func TestJSONInt16(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewInt16(nil))
 }
 
+func TestScanInt16Overflow(t *testing.T) {
+	nullableInt := nullable.NewInt16(nil)
+
+	tests.AssertEqual(t, nullableInt.Scan(32767), nil)
+	tests.AssertEqual(t, nullableInt.Get(), 32767)
+
+	if err := nullableInt.Scan(32768); err == nil {
+		t.Errorf("scanning 32768 into Int16 must fail, got %v", nullableInt.Get())
+	}
+	if err := nullableInt.Scan(-32769); err == nil {
+		t.Errorf("scanning -32769 into Int16 must fail, got %v", nullableInt.Get())
+	}
+	if err := nullableInt.Scan("32768"); err == nil {
+		t.Errorf("scanning \"32768\" into Int16 must fail, got %v", nullableInt.Get())
+	}
+
+	// previous valid value must be preserved
+	tests.AssertEqual(t, nullableInt.Get(), 32767)
+}
+
+func TestScanFloatInt16(t *testing.T) {
+	var scanned nullable.Int16
+	tests.AssertEqual(t, scanned.Scan(float64(42)), nil)
+	tests.AssertEqual(t, scanned, nullable.Int16From(42))
+
+	if err := scanned.Scan(float64(42.5)); err == nil {
+		t.Error("scanning float64 with fractional part into Int16 must fail")
+	}
+	if err := scanned.Scan(float64(32768)); err == nil {
+		t.Error("scanning overflowing float64 into Int16 must fail")
+	}
+}
+
 func TestNewInt16(t *testing.T) {
 	// uint8
 	var basicInt1 int16 = 37
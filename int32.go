@@ -1,49 +1,216 @@
 package nullable
 
 import (
+	"cmp"
+	"context"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
 
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/schema"
 )
 
 // Int32 SQL type that can retrieve NULL value
 type Int32 struct {
-	realValue int32
-	isValid   bool
+	core[int32]
 }
 
 // NewInt32 creates a new nullable 32-bit integer
 func NewInt32(value *int32) Int32 {
-	if value == nil {
-		return Int32{
-			realValue: 0,
-			isValid:   false,
+	return Int32{core: newCore(value)}
+}
+
+// Int32From creates a new valid nullable 32-bit integer from value
+func Int32From(value int32) Int32 {
+	return NewInt32(&value)
+}
+
+// NullInt32 creates a new NULL 32-bit integer
+func NullInt32() Int32 {
+	return NewInt32(nil)
+}
+
+// Int32ZeroAsNull creates a new nullable 32-bit integer that is NULL when value is the zero value
+func Int32ZeroAsNull(value int32) Int32 {
+	if value == 0 {
+		return NullInt32()
+	}
+	return Int32From(value)
+}
+
+// Int32FromSQL creates a new nullable 32-bit integer from sql.NullInt32
+func Int32FromSQL(value sql.NullInt32) Int32 {
+	if !value.Valid {
+		return NullInt32()
+	}
+	return Int32From(value.Int32)
+}
+
+// CoalesceInt32 returns the first valid value, or NULL when all of them are NULL
+func CoalesceInt32(values ...Int32) Int32 {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return Int32{}
+}
+
+// MinInt32 returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinInt32(values ...Int32) Int32 {
+	var result Int32
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// MaxInt32 returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxInt32(values ...Int32) Int32 {
+	var result Int32
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
 		}
 	}
-	return Int32{
-		realValue: *value,
-		isValid:   true,
+	return result
+}
+
+// Int32Ptr returns n.Get(), handy where a function value is needed
+func Int32Ptr(n Int32) *int32 {
+	return n.Get()
+}
+
+// Int32Value returns n.GetOrZero(), zero value when NULL
+func Int32Value(n Int32) int32 {
+	return n.GetOrZero()
+}
+
+// ParseInt32 parses text like UnmarshalText does, empty text is NULL
+func ParseInt32(text string) (Int32, error) {
+	var n Int32
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Int32{}, err
+	}
+	return n, nil
+}
+
+// MustParseInt32 is ParseInt32 that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseInt32(text string) Int32 {
+	n, err := ParseInt32(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseInt32(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL Int32, the one a declared
+// Int32 starts with, so pooled values can be reused
+func (n *Int32) Reset() {
+	*n = Int32{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Int32) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Int32) NullIfZero() {
+	if n.isValid && n.realValue == 0 {
+		n.SetNull()
 	}
 }
 
-// Get either nil or 32-bit integer
-func (n Int32) Get() *int32 {
+// MustGet either 32-bit integer or panic when NULL
+func (n Int32) MustGet() int32 {
+	return n.mustGet("Int32")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Int32) Clone() Int32 {
+	return n
+}
+
+// String returns 32-bit integer in its natural text form, or "<null>" when NULL
+func (n Int32) String() string {
 	if !n.isValid {
-		return nil
+		return nullString
 	}
-	return &n.realValue
+	return strconv.FormatInt(int64(n.realValue), 10)
 }
 
-// Set either nil or 32-bit integer
-func (n *Int32) Set(value *int32) {
-	n.isValid = (value != nil)
-	if n.isValid {
-		n.realValue = *value
-	} else {
-		n.realValue = 0
+// DebugString returns Int32(value) or Int32(NULL), telling NULL apart from a
+// zero value in logs
+func (n Int32) DebugString() string {
+	return debugString("Int32", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same 32-bit integer
+func (n Int32) Equal(other Int32) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Int32) Changed(old Int32) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any 32-bit integer.
+func (n Int32) Compare(other Int32) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
 	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// ToSQL converts current value to sql.NullInt32
+func (n Int32) ToSQL() sql.NullInt32 {
+	return sql.NullInt32{Int32: n.realValue, Valid: n.isValid}
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL 8 big-endian bytes, telling NULL apart from zero in cache keys
+func (n Int32) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagInt32, n.isValid, hashUint64(uint64(n.realValue)))
 }
 
 // MarshalJSON converts current value to JSON
@@ -53,8 +220,11 @@ func (n Int32) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON writes JSON to this type
 func (n *Int32) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
 		n.isValid = false
 		n.realValue = 0
 		return nil
@@ -62,6 +232,164 @@ func (n *Int32) UnmarshalJSON(data []byte) error {
 
 	var parsed int32
 	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Int32) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, int64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Int32) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(string(text), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = int32(parsed)
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Int32) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Int32) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Int32) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return strconv.FormatInt(int64(n.realValue), 10), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Int32) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Int32) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed int32
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n Int32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue, start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Int32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(strings.TrimSpace(text), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = int32(parsed)
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Int32) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Int32) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed int32
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Int32) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Int32) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed int32
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
 		return err
 	}
 
@@ -70,18 +398,51 @@ func (n *Int32) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Int32) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Int32) GobDecode(data []byte) error {
+	var parsed int32
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
 // Scan implements scanner interface
 func (n *Int32) Scan(value interface{}) error {
+	value = scanValue(value)
 	if value == nil {
 		n.realValue, n.isValid = 0, false
 		return nil
 	}
 
-	var i64 int64
-	if err := convertAssign(&i64, value); err != nil {
-		return err
+	if floatValue, ok := value.(float64); ok {
+		parsed, err := scanFloatInt(floatValue, 32, "int32")
+		if err != nil {
+			return scanError(err)
+		}
+		n.realValue, n.isValid = int32(parsed), true
+		return nil
+	}
+
+	var scanned int32
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
 	}
-	n.realValue = int32(i64)
+	n.realValue = scanned
 
 	n.isValid = true
 	return nil
@@ -95,18 +456,62 @@ func (n Int32) Value() (driver.Value, error) {
 	return int64(n.realValue), nil
 }
 
+// GormValue implements the driver Valuer interface via GORM.
+func (n Int32) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
+	switch dialectName(db) {
+	case "sqlite", "mysql", "sqlserver":
+		// MySQL, SQLite and SQL Server are using Value() instead of GormValue()
+		value, err := n.Value()
+		if err != nil {
+			db.AddError(err)
+			return clause.Expr{}
+		}
+		return clause.Expr{SQL: "?", Vars: []interface{}{value}}
+	case "postgres", "clickhouse", "cockroachdb":
+		if !n.isValid {
+			return clause.Expr{SQL: "?", Vars: []interface{}{nil}}
+		}
+		return clause.Expr{SQL: "?", Vars: []interface{}{n.realValue}}
+	default:
+		// Unknown dialects get the same bound parameter as database/sql would
+		value, err := n.Value()
+		if err != nil {
+			db.AddError(fmt.Errorf("nullable: cannot bind Int32 for %q dialect: %w", db.Dialector.Name(), err))
+			return clause.Expr{}
+		}
+		return clause.Expr{SQL: "?", Vars: []interface{}{value}}
+	}
+}
+
 // GormDataType gorm common data type
 func (Int32) GormDataType() string {
 	return "int32_null"
 }
 
 // GormDBDataType gorm db data type
-func (Int32) GormDBDataType(db *gorm.DB, field *schema.Field) string {
-	switch db.Dialector.Name() {
+func (n Int32) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Int32) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Int32) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
 	case "sqlite", "mysql":
 		return "INT"
 	case "postgres":
 		return "integer"
+	case "sqlserver":
+		return "INT"
+	case "clickhouse":
+		return "Nullable(Int32)"
+	case "cockroachdb":
+		return "INT4"
 	}
 	return ""
 }
@@ -1,6 +1,9 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/tee8z/nullable"
@@ -38,6 +41,39 @@ func TestScanInt32(t *testing.T) {
 	tests.AssertEqual(t, nullableInt.Get(), nil)
 }
 
+func TestScanInt32Overflow(t *testing.T) {
+	nullableInt := nullable.NewInt32(nil)
+
+	tests.AssertEqual(t, nullableInt.Scan(2147483647), nil)
+	tests.AssertEqual(t, nullableInt.Get(), 2147483647)
+
+	if err := nullableInt.Scan(2147483648); err == nil {
+		t.Errorf("scanning 2147483648 into Int32 must fail, got %v", nullableInt.Get())
+	}
+	if err := nullableInt.Scan(-2147483649); err == nil {
+		t.Errorf("scanning -2147483649 into Int32 must fail, got %v", nullableInt.Get())
+	}
+	if err := nullableInt.Scan("2147483648"); err == nil {
+		t.Errorf("scanning \"2147483648\" into Int32 must fail, got %v", nullableInt.Get())
+	}
+
+	// previous valid value must be preserved
+	tests.AssertEqual(t, nullableInt.Get(), 2147483647)
+}
+
+func TestScanFloatInt32(t *testing.T) {
+	var scanned nullable.Int32
+	tests.AssertEqual(t, scanned.Scan(float64(42)), nil)
+	tests.AssertEqual(t, scanned, nullable.Int32From(42))
+
+	if err := scanned.Scan(float64(42.5)); err == nil {
+		t.Error("scanning float64 with fractional part into Int32 must fail")
+	}
+	if err := scanned.Scan(float64(2147483648)); err == nil {
+		t.Error("scanning overflowing float64 into Int32 must fail")
+	}
+}
+
 func TestNewInt32(t *testing.T) {
 	// uint8
 	var basicInt1 int32 = 37
@@ -118,6 +154,268 @@ func TestSetInt32(t *testing.T) {
 	tests.AssertEqual(t, nullableInt.Get(), nil)
 }
 
+func TestInt32From(t *testing.T) {
+	var basic int32 = -1234567
+	tests.AssertEqual(t, nullable.Int32From(basic), nullable.NewInt32(&basic))
+	tests.AssertEqual(t, nullable.Int32From(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullInt32(), nullable.NewInt32(nil))
+	tests.AssertEqual(t, nullable.NullInt32().IsNull(), true)
+}
+
+func TestCoalesceInt32(t *testing.T) {
+	var basic int32 = -1234567
+	var zero int32 = 0
+	tests.AssertEqual(t, nullable.CoalesceInt32(), nullable.Int32{})
+	tests.AssertEqual(t, nullable.CoalesceInt32(nullable.NullInt32(), nullable.NullInt32()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceInt32(nullable.NullInt32(), nullable.Int32From(basic), nullable.Int32From(zero)), nullable.Int32From(basic))
+	tests.AssertEqual(t, nullable.CoalesceInt32(nullable.Int32From(zero), nullable.Int32From(basic)), nullable.Int32From(zero))
+}
+
+func TestIsZeroInt32(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullInt32().IsZero(), true)
+	tests.AssertEqual(t, nullable.Int32From(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.Int32From(-1234567).IsZero(), false)
+}
+
+func TestCloneInt32(t *testing.T) {
+	tests.AssertEqual(t, nullable.Int32From(-1234567).Clone(), nullable.Int32From(-1234567))
+	tests.AssertEqual(t, nullable.NullInt32().Clone(), nullable.NullInt32())
+}
+
+func TestUnwrapInt32(t *testing.T) {
+	var basic int32 = -1234567
+	value, ok := nullable.Int32From(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullInt32().Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Int32
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseInt32(t *testing.T) {
+	parsed, err := nullable.ParseInt32("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullInt32())
+
+	text, err := nullable.Int32From(-1234567).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseInt32(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.Int32From(-1234567)), true)
+
+	for _, text := range []string{"abc", "1.5", "1e3"} {
+		if _, err := nullable.ParseInt32(text); err == nil {
+			t.Errorf("parsing %q as 32-bit integer must fail", text)
+		}
+	}
+}
+
+func TestMustParseInt32(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseInt32(""), nullable.NullInt32())
+
+	text, err := nullable.Int32From(-1234567).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseInt32(string(text)).Equal(nullable.Int32From(-1234567)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseInt32 of malformed text must panic")
+		}
+	}()
+	nullable.MustParseInt32("abc")
+}
+
+func TestPtrInt32(t *testing.T) {
+	var basic int32 = -1234567
+	tests.AssertEqual(t, nullable.Int32Ptr(nullable.NewInt32(&basic)), basic)
+	tests.AssertEqual(t, nullable.Int32Ptr(nullable.NewInt32(nil)), nil)
+	tests.AssertEqual(t, nullable.Int32Value(nullable.NewInt32(&basic)), basic)
+	tests.AssertEqual(t, nullable.Int32Value(nullable.NewInt32(nil)), 0)
+}
+
+func TestSetValueInt32(t *testing.T) {
+	var basic int32 = -1234567
+	var nullableInt32 nullable.Int32
+	nullableInt32.SetValue(basic)
+	tests.AssertEqual(t, nullableInt32, nullable.Int32From(basic))
+
+	nullableInt32.SetNull()
+	tests.AssertEqual(t, nullableInt32, nullable.NullInt32())
+}
+
+func TestZeroAsNullInt32(t *testing.T) {
+	var basic int32 = -1234567
+	var zero int32 = 0
+	tests.AssertEqual(t, nullable.Int32ZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.Int32ZeroAsNull(basic), nullable.Int32From(basic))
+
+	nullableInt32 := nullable.Int32From(zero)
+	nullableInt32.NullIfZero()
+	tests.AssertEqual(t, nullableInt32, nullable.NullInt32())
+
+	nullableInt32 = nullable.Int32From(basic)
+	nullableInt32.NullIfZero()
+	tests.AssertEqual(t, nullableInt32, nullable.Int32From(basic))
+}
+
+func TestInt32FromSQL(t *testing.T) {
+	var basic int32 = -1234567
+	tests.AssertEqual(t, nullable.Int32FromSQL(sql.NullInt32{Int32: basic, Valid: true}), nullable.Int32From(basic))
+	tests.AssertEqual(t, nullable.Int32FromSQL(sql.NullInt32{Int32: basic}), nullable.NullInt32())
+
+	tests.AssertEqual(t, nullable.Int32From(basic).ToSQL(), sql.NullInt32{Int32: basic, Valid: true})
+	tests.AssertEqual(t, nullable.NullInt32().ToSQL(), sql.NullInt32{})
+}
+
+func TestIsNullInt32(t *testing.T) {
+	var zero nullable.Int32
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic int32 = -1234567
+	nullableInt32 := nullable.NewInt32(&basic)
+	tests.AssertEqual(t, nullableInt32.IsNull(), false)
+	tests.AssertEqual(t, nullableInt32.IsValid(), true)
+
+	nullableInt32.Set(nil)
+	tests.AssertEqual(t, nullableInt32.IsNull(), true)
+	tests.AssertEqual(t, nullableInt32.IsValid(), false)
+}
+
+func TestGetOrInt32(t *testing.T) {
+	var zero nullable.Int32
+	var fallback int32 = -1234567
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), 0)
+
+	var basic int32 = -1234567
+	nullableInt32 := nullable.NewInt32(&basic)
+	tests.AssertEqual(t, nullableInt32.GetOr(0), basic)
+	tests.AssertEqual(t, nullableInt32.GetOrZero(), basic)
+}
+
+func TestMustGetInt32(t *testing.T) {
+	var basic int32 = -1234567
+	nullableInt32 := nullable.NewInt32(&basic)
+	tests.AssertEqual(t, nullableInt32.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Int32")
+	}()
+	nullableInt32.Set(nil)
+	nullableInt32.MustGet()
+	t.Error("MustGet on NULL Int32 must panic")
+}
+
+func TestTextInt32(t *testing.T) {
+	var basic int32 = -1234567
+	marshalUnmarshalText(t, nullable.NewInt32(&basic))
+
+	marshalUnmarshalText(t, nullable.NewInt32(nil))
+}
+
+func TestCSVInt32(t *testing.T) {
+	var basic int32 = -1234567
+	marshalUnmarshalCSV(t, nullable.NewInt32(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewInt32(nil))
+}
+
+func TestYAMLInt32(t *testing.T) {
+	var basic int32 = -1234567
+	marshalUnmarshalYAML(t, nullable.NewInt32(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewInt32(nil))
+}
+
+func TestMsgpackInt32(t *testing.T) {
+	var basic int32 = -1234567
+	marshalUnmarshalMsgpack(t, nullable.NewInt32(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewInt32(nil))
+}
+
+func TestBSONInt32(t *testing.T) {
+	var basic int32 = -1234567
+	marshalUnmarshalBSON(t, nullable.NewInt32(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewInt32(nil))
+}
+
+func TestGobInt32(t *testing.T) {
+	var basic int32 = -1234567
+	marshalUnmarshalGob(t, nullable.NewInt32(&basic))
+
+	var zero int32 = 0
+	marshalUnmarshalGob(t, nullable.NewInt32(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewInt32(nil))
+}
+
+func TestStringerInt32(t *testing.T) {
+	var basic int32 = -1234567
+	nullableInt32 := nullable.NewInt32(&basic)
+	tests.AssertEqual(t, nullableInt32.String(), "-1234567")
+	tests.AssertEqual(t, fmt.Sprint(nullableInt32), "-1234567")
+
+	nullableInt32.Set(nil)
+	tests.AssertEqual(t, nullableInt32.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableInt32), "<null>")
+}
+
+func TestChangedInt32(t *testing.T) {
+	var basic int32 = -1234567
+	value, null := nullable.NewInt32(&basic), nullable.NewInt32(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.Int32From(0)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewInt32(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewInt32(nil)), false)
+}
+
+func TestEqualInt32(t *testing.T) {
+	var basic int32 = -1234567
+	var zero int32 = 0
+	tests.AssertEqual(t, nullable.NewInt32(nil).Equal(nullable.NewInt32(nil)), true)
+	tests.AssertEqual(t, nullable.NewInt32(nil).Equal(nullable.NewInt32(&basic)), false)
+	tests.AssertEqual(t, nullable.NewInt32(&basic).Equal(nullable.NewInt32(nil)), false)
+	tests.AssertEqual(t, nullable.NewInt32(&basic).Equal(nullable.NewInt32(&basic)), true)
+	tests.AssertEqual(t, nullable.NewInt32(&basic).Equal(nullable.NewInt32(&zero)), false)
+	tests.AssertEqual(t, nullable.NewInt32(&zero).Equal(nullable.NewInt32(nil)), false)
+}
+
+func TestCompareInt32(t *testing.T) {
+	var lesser int32 = -1234567
+	var greater int32 = 1234567
+	null := nullable.NewInt32(nil)
+	small := nullable.NewInt32(&lesser)
+	big := nullable.NewInt32(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewInt32(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewInt32(&greater)), 0)
+
+	values := []nullable.Int32{big, null, small}
+	slices.SortFunc(values, nullable.Int32.Compare)
+	tests.AssertEqual(t, values, []nullable.Int32{null, small, big})
+}
+
+func TestXMLInt32(t *testing.T) {
+	var basic int32 = -1234567
+	marshalUnmarshalXML(t, nullable.NewInt32(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewInt32(nil))
+}
+
 func TestJSONInt32(t *testing.T) {
 	var basicInt1 int32 = 37
 	marshalUnmarshalJSON(t, nullable.NewInt32(&basicInt1))
@@ -1,67 +1,352 @@
 package nullable
 
 import (
+	"bytes"
+	"cmp"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
 
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 )
 
 // Int64 SQL type that can retrieve NULL value
 type Int64 struct {
-	realValue int64
-	isValid   bool
+	core[int64]
 }
 
 // NewInt64 creates a new nullable 64-bit integer
 func NewInt64(value *int64) Int64 {
-	if value == nil {
-		return Int64{
-			realValue: 0,
-			isValid:   false,
+	return Int64{core: newCore(value)}
+}
+
+// Int64From creates a new valid nullable 64-bit integer from value
+func Int64From(value int64) Int64 {
+	return NewInt64(&value)
+}
+
+// NullInt64 creates a new NULL 64-bit integer
+func NullInt64() Int64 {
+	return NewInt64(nil)
+}
+
+// Int64ZeroAsNull creates a new nullable 64-bit integer that is NULL when value is the zero value
+func Int64ZeroAsNull(value int64) Int64 {
+	if value == 0 {
+		return NullInt64()
+	}
+	return Int64From(value)
+}
+
+// Int64FromSQL creates a new nullable 64-bit integer from sql.NullInt64
+func Int64FromSQL(value sql.NullInt64) Int64 {
+	if !value.Valid {
+		return NullInt64()
+	}
+	return Int64From(value.Int64)
+}
+
+// CoalesceInt64 returns the first valid value, or NULL when all of them are NULL
+func CoalesceInt64(values ...Int64) Int64 {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return Int64{}
+}
+
+// MinInt64 returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinInt64(values ...Int64) Int64 {
+	var result Int64
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// MaxInt64 returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxInt64(values ...Int64) Int64 {
+	var result Int64
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
 		}
 	}
-	return Int64{
-		realValue: *value,
-		isValid:   true,
+	return result
+}
+
+// Int64Ptr returns n.Get(), handy where a function value is needed
+func Int64Ptr(n Int64) *int64 {
+	return n.Get()
+}
+
+// Int64Value returns n.GetOrZero(), zero value when NULL
+func Int64Value(n Int64) int64 {
+	return n.GetOrZero()
+}
+
+// ParseInt64 parses text like UnmarshalText does, empty text is NULL
+func ParseInt64(text string) (Int64, error) {
+	var n Int64
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Int64{}, err
+	}
+	return n, nil
+}
+
+// MustParseInt64 is ParseInt64 that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseInt64(text string) Int64 {
+	n, err := ParseInt64(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseInt64(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL Int64, the one a declared
+// Int64 starts with, so pooled values can be reused
+func (n *Int64) Reset() {
+	*n = Int64{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Int64) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Int64) NullIfZero() {
+	if n.isValid && n.realValue == 0 {
+		n.SetNull()
 	}
 }
 
-// Get either nil or 64-bit integer
-func (n Int64) Get() *int64 {
+// MustGet either 64-bit integer or panic when NULL
+func (n Int64) MustGet() int64 {
+	return n.mustGet("Int64")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Int64) Clone() Int64 {
+	return n
+}
+
+// String returns 64-bit integer in its natural text form, or "<null>" when NULL
+func (n Int64) String() string {
 	if !n.isValid {
-		return nil
+		return nullString
 	}
-	return &n.realValue
+	return strconv.FormatInt(n.realValue, 10)
 }
 
-// Set either nil or 64-bit integer
-func (n *Int64) Set(value *int64) {
-	n.isValid = (value != nil)
-	if n.isValid {
-		n.realValue = *value
-	} else {
-		n.realValue = 0
+// DebugString returns Int64(value) or Int64(NULL), telling NULL apart from a
+// zero value in logs
+func (n Int64) DebugString() string {
+	return debugString("Int64", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same 64-bit integer
+func (n Int64) Equal(other Int64) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Int64) Changed(old Int64) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any 64-bit integer.
+func (n Int64) Compare(other Int64) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
 	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// ToSQL converts current value to sql.NullInt64
+func (n Int64) ToSQL() sql.NullInt64 {
+	return sql.NullInt64{Int64: n.realValue, Valid: n.isValid}
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL 8 big-endian bytes, telling NULL apart from zero in cache keys
+func (n Int64) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagInt64, n.isValid, hashUint64(uint64(n.realValue)))
 }
 
 // MarshalJSON converts current value to JSON
 func (n Int64) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return strconv.AppendInt(make([]byte, 0, 20), n.realValue, 10), nil
 }
 
 // UnmarshalJSON writes JSON to this type
 func (n *Int64) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed int64
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		// Large integers are often quoted to survive JavaScript numbers
+		var quoted string
+		if err := json.Unmarshal(data, &quoted); err != nil {
+			return jsonError(err)
+		}
+		value, err := strconv.ParseInt(quoted, 10, 64)
+		if err != nil {
+			return jsonError(err)
+		}
+		parsed = value
+	} else if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Int64) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, n.realValue, 10), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Int64) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Int64) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Int64) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Int64) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return strconv.FormatInt(n.realValue, 10), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Int64) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Int64) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
 	var parsed int64
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n Int64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue, start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Int64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+	if err != nil {
 		return err
 	}
 
@@ -70,14 +355,104 @@ func (n *Int64) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// EncodeMsgpack converts current value to MessagePack
+func (n Int64) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Int64) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed int64
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Int64) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Int64) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed int64
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Int64) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Int64) GobDecode(data []byte) error {
+	var parsed int64
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
 // Scan implements scanner interface
 func (n *Int64) Scan(value interface{}) error {
+	value = scanValue(value)
 	if value == nil {
 		n.realValue, n.isValid = 0, false
 		return nil
 	}
+
+	if floatValue, ok := value.(float64); ok {
+		parsed, err := scanFloatInt(floatValue, 64, "int64")
+		if err != nil {
+			return scanError(err)
+		}
+		n.realValue, n.isValid = parsed, true
+		return nil
+	}
+
 	n.isValid = true
-	return convertAssign(&n.realValue, value)
+	return scanError(convertAssign(&n.realValue, value))
 }
 
 // Value implements the driver Valuer interface.
@@ -94,12 +469,29 @@ func (Int64) GormDataType() string {
 }
 
 // GormDBDataType gorm db data type
-func (Int64) GormDBDataType(db *gorm.DB, field *schema.Field) string {
-	switch db.Dialector.Name() {
+func (n Int64) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Int64) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Int64) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
 	case "sqlite", "mysql":
 		return "BIGINT"
 	case "postgres":
 		return "bigint"
+	case "sqlserver":
+		return "BIGINT"
+	case "clickhouse":
+		return "Nullable(Int64)"
+	case "cockroachdb":
+		return "INT8"
 	}
 	return ""
 }
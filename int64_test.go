@@ -1,6 +1,11 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
 	"testing"
 
 	"github.com/tee8z/nullable"
@@ -46,6 +51,30 @@ func TestScanInt64(t *testing.T) {
 	tests.AssertEqual(t, nullableInt.Get(), nil)
 }
 
+func TestScanFloatInt64(t *testing.T) {
+	var scanned nullable.Int64
+	tests.AssertEqual(t, scanned.Scan(float64(42)), nil)
+	tests.AssertEqual(t, scanned, nullable.Int64From(42))
+
+	if err := scanned.Scan(float64(42.5)); err == nil {
+		t.Error("scanning float64 with fractional part into Int64 must fail")
+	}
+	if err := scanned.Scan(float64(1 << 63)); err == nil {
+		t.Error("scanning overflowing float64 into Int64 must fail")
+	}
+}
+
+func TestScanJSONNumberInt64(t *testing.T) {
+	nullableInt64 := nullable.NewInt64(nil)
+
+	tests.AssertEqual(t, nullableInt64.Scan(json.Number("-9223372036854775808")), nil)
+	tests.AssertEqual(t, nullableInt64.Get(), int64(-9223372036854775808))
+
+	if err := nullableInt64.Scan(json.Number("9223372036854775808")); err == nil {
+		t.Error("scanning json.Number beyond int64 into Int64 must fail")
+	}
+}
+
 func TestNewInt64(t *testing.T) {
 	// uint8
 	var basicInt1 int64 = 37
@@ -136,6 +165,268 @@ func TestSetInt64(t *testing.T) {
 	tests.AssertEqual(t, nullableInt.Get(), nil)
 }
 
+func TestInt64From(t *testing.T) {
+	var basic int64 = -50000000000
+	tests.AssertEqual(t, nullable.Int64From(basic), nullable.NewInt64(&basic))
+	tests.AssertEqual(t, nullable.Int64From(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullInt64(), nullable.NewInt64(nil))
+	tests.AssertEqual(t, nullable.NullInt64().IsNull(), true)
+}
+
+func TestCoalesceInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	var zero int64 = 0
+	tests.AssertEqual(t, nullable.CoalesceInt64(), nullable.Int64{})
+	tests.AssertEqual(t, nullable.CoalesceInt64(nullable.NullInt64(), nullable.NullInt64()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceInt64(nullable.NullInt64(), nullable.Int64From(basic), nullable.Int64From(zero)), nullable.Int64From(basic))
+	tests.AssertEqual(t, nullable.CoalesceInt64(nullable.Int64From(zero), nullable.Int64From(basic)), nullable.Int64From(zero))
+}
+
+func TestIsZeroInt64(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullInt64().IsZero(), true)
+	tests.AssertEqual(t, nullable.Int64From(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.Int64From(-50000000000).IsZero(), false)
+}
+
+func TestCloneInt64(t *testing.T) {
+	tests.AssertEqual(t, nullable.Int64From(-50000000000).Clone(), nullable.Int64From(-50000000000))
+	tests.AssertEqual(t, nullable.NullInt64().Clone(), nullable.NullInt64())
+}
+
+func TestUnwrapInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	value, ok := nullable.Int64From(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullInt64().Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Int64
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseInt64(t *testing.T) {
+	parsed, err := nullable.ParseInt64("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullInt64())
+
+	text, err := nullable.Int64From(-50000000000).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseInt64(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.Int64From(-50000000000)), true)
+
+	for _, text := range []string{"abc", "1.5", "1e3"} {
+		if _, err := nullable.ParseInt64(text); err == nil {
+			t.Errorf("parsing %q as 64-bit integer must fail", text)
+		}
+	}
+}
+
+func TestMustParseInt64(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseInt64(""), nullable.NullInt64())
+
+	text, err := nullable.Int64From(-50000000000).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseInt64(string(text)).Equal(nullable.Int64From(-50000000000)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseInt64 of malformed text must panic")
+		}
+	}()
+	nullable.MustParseInt64("abc")
+}
+
+func TestPtrInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	tests.AssertEqual(t, nullable.Int64Ptr(nullable.NewInt64(&basic)), basic)
+	tests.AssertEqual(t, nullable.Int64Ptr(nullable.NewInt64(nil)), nil)
+	tests.AssertEqual(t, nullable.Int64Value(nullable.NewInt64(&basic)), basic)
+	tests.AssertEqual(t, nullable.Int64Value(nullable.NewInt64(nil)), 0)
+}
+
+func TestSetValueInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	var nullableInt64 nullable.Int64
+	nullableInt64.SetValue(basic)
+	tests.AssertEqual(t, nullableInt64, nullable.Int64From(basic))
+
+	nullableInt64.SetNull()
+	tests.AssertEqual(t, nullableInt64, nullable.NullInt64())
+}
+
+func TestZeroAsNullInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	var zero int64 = 0
+	tests.AssertEqual(t, nullable.Int64ZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.Int64ZeroAsNull(basic), nullable.Int64From(basic))
+
+	nullableInt64 := nullable.Int64From(zero)
+	nullableInt64.NullIfZero()
+	tests.AssertEqual(t, nullableInt64, nullable.NullInt64())
+
+	nullableInt64 = nullable.Int64From(basic)
+	nullableInt64.NullIfZero()
+	tests.AssertEqual(t, nullableInt64, nullable.Int64From(basic))
+}
+
+func TestInt64FromSQL(t *testing.T) {
+	var basic int64 = -50000000000
+	tests.AssertEqual(t, nullable.Int64FromSQL(sql.NullInt64{Int64: basic, Valid: true}), nullable.Int64From(basic))
+	tests.AssertEqual(t, nullable.Int64FromSQL(sql.NullInt64{Int64: basic}), nullable.NullInt64())
+
+	tests.AssertEqual(t, nullable.Int64From(basic).ToSQL(), sql.NullInt64{Int64: basic, Valid: true})
+	tests.AssertEqual(t, nullable.NullInt64().ToSQL(), sql.NullInt64{})
+}
+
+func TestIsNullInt64(t *testing.T) {
+	var zero nullable.Int64
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic int64 = -50000000000
+	nullableInt64 := nullable.NewInt64(&basic)
+	tests.AssertEqual(t, nullableInt64.IsNull(), false)
+	tests.AssertEqual(t, nullableInt64.IsValid(), true)
+
+	nullableInt64.Set(nil)
+	tests.AssertEqual(t, nullableInt64.IsNull(), true)
+	tests.AssertEqual(t, nullableInt64.IsValid(), false)
+}
+
+func TestGetOrInt64(t *testing.T) {
+	var zero nullable.Int64
+	var fallback int64 = -50000000000
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), 0)
+
+	var basic int64 = -50000000000
+	nullableInt64 := nullable.NewInt64(&basic)
+	tests.AssertEqual(t, nullableInt64.GetOr(0), basic)
+	tests.AssertEqual(t, nullableInt64.GetOrZero(), basic)
+}
+
+func TestMustGetInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	nullableInt64 := nullable.NewInt64(&basic)
+	tests.AssertEqual(t, nullableInt64.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Int64")
+	}()
+	nullableInt64.Set(nil)
+	nullableInt64.MustGet()
+	t.Error("MustGet on NULL Int64 must panic")
+}
+
+func TestTextInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	marshalUnmarshalText(t, nullable.NewInt64(&basic))
+
+	marshalUnmarshalText(t, nullable.NewInt64(nil))
+}
+
+func TestCSVInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	marshalUnmarshalCSV(t, nullable.NewInt64(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewInt64(nil))
+}
+
+func TestYAMLInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	marshalUnmarshalYAML(t, nullable.NewInt64(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewInt64(nil))
+}
+
+func TestMsgpackInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	marshalUnmarshalMsgpack(t, nullable.NewInt64(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewInt64(nil))
+}
+
+func TestBSONInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	marshalUnmarshalBSON(t, nullable.NewInt64(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewInt64(nil))
+}
+
+func TestGobInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	marshalUnmarshalGob(t, nullable.NewInt64(&basic))
+
+	var zero int64 = 0
+	marshalUnmarshalGob(t, nullable.NewInt64(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewInt64(nil))
+}
+
+func TestStringerInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	nullableInt64 := nullable.NewInt64(&basic)
+	tests.AssertEqual(t, nullableInt64.String(), "-50000000000")
+	tests.AssertEqual(t, fmt.Sprint(nullableInt64), "-50000000000")
+
+	nullableInt64.Set(nil)
+	tests.AssertEqual(t, nullableInt64.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableInt64), "<null>")
+}
+
+func TestChangedInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	value, null := nullable.NewInt64(&basic), nullable.NewInt64(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.Int64From(0)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewInt64(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewInt64(nil)), false)
+}
+
+func TestEqualInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	var zero int64 = 0
+	tests.AssertEqual(t, nullable.NewInt64(nil).Equal(nullable.NewInt64(nil)), true)
+	tests.AssertEqual(t, nullable.NewInt64(nil).Equal(nullable.NewInt64(&basic)), false)
+	tests.AssertEqual(t, nullable.NewInt64(&basic).Equal(nullable.NewInt64(nil)), false)
+	tests.AssertEqual(t, nullable.NewInt64(&basic).Equal(nullable.NewInt64(&basic)), true)
+	tests.AssertEqual(t, nullable.NewInt64(&basic).Equal(nullable.NewInt64(&zero)), false)
+	tests.AssertEqual(t, nullable.NewInt64(&zero).Equal(nullable.NewInt64(nil)), false)
+}
+
+func TestCompareInt64(t *testing.T) {
+	var lesser int64 = -50000000000
+	var greater int64 = 50000000000
+	null := nullable.NewInt64(nil)
+	small := nullable.NewInt64(&lesser)
+	big := nullable.NewInt64(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewInt64(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewInt64(&greater)), 0)
+
+	values := []nullable.Int64{big, null, small}
+	slices.SortFunc(values, nullable.Int64.Compare)
+	tests.AssertEqual(t, values, []nullable.Int64{null, small, big})
+}
+
+func TestXMLInt64(t *testing.T) {
+	var basic int64 = -50000000000
+	marshalUnmarshalXML(t, nullable.NewInt64(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewInt64(nil))
+}
+
 func TestJSONInt64(t *testing.T) {
 	var basicInt1 int64 = 37
 	marshalUnmarshalJSON(t, nullable.NewInt64(&basicInt1))
@@ -164,6 +455,64 @@ func TestJSONInt64(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewInt64(nil))
 }
 
+func TestJSONQuotedInt64(t *testing.T) {
+	var maxValue int64 = math.MaxInt64
+	var minValue int64 = math.MinInt64
+	marshalUnmarshalJSON(t, nullable.NewInt64(&maxValue))
+	marshalUnmarshalJSON(t, nullable.NewInt64(&minValue))
+
+	var unserialized nullable.Int64
+	tests.AssertEqual(t, json.Unmarshal([]byte(`"9223372036854775807"`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized, nullable.NewInt64(&maxValue))
+
+	// Called directly, UnmarshalJSON may get the token with whitespace around it
+	tests.AssertEqual(t, unserialized.UnmarshalJSON([]byte(" \n\"9223372036854775807\" ")), nil)
+	tests.AssertEqual(t, unserialized, nullable.NewInt64(&maxValue))
+
+	tests.AssertEqual(t, json.Unmarshal([]byte(`"-9223372036854775808"`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized, nullable.NewInt64(&minValue))
+
+	tests.AssertEqual(t, json.Unmarshal([]byte(`null`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.IsNull(), true)
+
+	for _, invalid := range []string{`"abc"`, `""`, `"9223372036854775808"`, `"1.5"`} {
+		if err := json.Unmarshal([]byte(invalid), &unserialized); err == nil {
+			t.Errorf("unmarshaling %s into Int64 must fail", invalid)
+		}
+	}
+}
+
+func TestMarshalJSONCompatibleInt64(t *testing.T) {
+	for _, basic := range []int64{0, -50000000000, math.MaxInt64, math.MinInt64} {
+		expected, err := json.Marshal(&basic)
+		tests.AssertEqual(t, err, nil)
+		serialized, err := json.Marshal(nullable.NewInt64(&basic))
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(serialized), string(expected))
+	}
+
+	serialized, err := json.Marshal(nullable.NewInt64(nil))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "null")
+}
+
+func BenchmarkMarshalJSONInt64(b *testing.B) {
+	var basic int64 = -50000000000
+	nullableInt64 := nullable.NewInt64(&basic)
+	b.Run("nullable", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			jsonSink, _ = nullableInt64.MarshalJSON()
+		}
+	})
+	b.Run("json.Marshal of Get", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			jsonSink, _ = json.Marshal(nullableInt64.Get())
+		}
+	})
+}
+
 func TestInt64(t *testing.T) {
 	type TestNullableInt64 struct {
 		ID    uint
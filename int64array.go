@@ -0,0 +1,417 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Int64Array SQL type that can retrieve NULL value of a PostgreSQL integer[]
+// or bigint[] column, read and written in array text form such as {1,2,3}.
+// NULL and an empty array are told apart: a valid array is never nil.
+type Int64Array struct {
+	core[[]int64]
+}
+
+// NewInt64Array creates a new nullable array of 64-bit integers
+func NewInt64Array(value *[]int64) Int64Array {
+	if value == nil {
+		return Int64Array{}
+	}
+	return Int64ArrayFrom(*value)
+}
+
+// Int64ArrayFrom creates a new valid nullable array of 64-bit integers from
+// value, nil is an empty array
+func Int64ArrayFrom(value []int64) Int64Array {
+	if value == nil {
+		value = []int64{}
+	}
+	return Int64Array{core: newCore(&value)}
+}
+
+// NullInt64Array creates a new NULL array of 64-bit integers
+func NullInt64Array() Int64Array {
+	return NewInt64Array(nil)
+}
+
+// Set either nil or array of 64-bit integers
+func (n *Int64Array) Set(value *[]int64) {
+	*n = NewInt64Array(value)
+}
+
+// Swap sets either nil or array of 64-bit integers like Set does and returns
+// the previous value, nil when it was NULL
+func (n *Int64Array) Swap(value *[]int64) *[]int64 {
+	previous := n.Get()
+	n.Set(value)
+	return previous
+}
+
+// SetValue sets array of 64-bit integers and marks it as not NULL
+func (n *Int64Array) SetValue(value []int64) {
+	*n = Int64ArrayFrom(value)
+}
+
+// Reset sets the value back to the zero NULL Int64Array, the one a declared
+// Int64Array starts with, letting go of the held slice so pooled values can be
+// reused
+func (n *Int64Array) Reset() {
+	*n = Int64Array{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Int64Array) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// MustGet either array of 64-bit integers or panic when NULL
+func (n Int64Array) MustGet() []int64 {
+	return n.mustGet("Int64Array")
+}
+
+// Clone returns a copy of the value that shares no memory with n
+func (n Int64Array) Clone() Int64Array {
+	n.realValue = slices.Clone(n.realValue)
+	return n
+}
+
+// String returns array of 64-bit integers in PostgreSQL array text form, or
+// "<null>" when NULL
+func (n Int64Array) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	elements := make([]string, len(n.realValue))
+	for i, value := range n.realValue {
+		elements[i] = strconv.FormatInt(value, 10)
+	}
+	return formatPGArray(elements)
+}
+
+// DebugString returns Int64Array(value) or Int64Array(NULL), telling NULL apart from a
+// zero value in logs
+func (n Int64Array) DebugString() string {
+	return debugString("Int64Array", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same array of 64-bit integers
+func (n Int64Array) Equal(other Int64Array) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return slices.Equal(n.realValue, other.realValue)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Int64Array) Changed(old Int64Array) bool {
+	return !n.Equal(old)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL 8 big-endian bytes per element, telling NULL apart from zero in cache
+// keys
+func (n Int64Array) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagInt64Array, n.isValid, hashUint64s(n.realValue))
+}
+
+// MarshalJSON converts current value to JSON
+func (n Int64Array) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.realValue)
+}
+
+// UnmarshalJSON writes JSON to this type
+func (n *Int64Array) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed []int64
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalText converts current value to PostgreSQL array text form, NULL is
+// empty text
+func (n Int64Array) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText writes array text form such as {1,2,3} to this type, empty
+// text is NULL
+func (n *Int64Array) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.SetNull()
+		return nil
+	}
+
+	parsed, err := parseInt64Array(string(text))
+	if err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Int64Array) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Int64Array) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Int64Array) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return n.String(), false
+}
+
+// MarshalYAML converts current value to YAML, a sequence like JSON
+func (n Int64Array) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Int64Array) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed []int64
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalXML converts current value to XML, the array text form
+func (n Int64Array) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.String(), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Int64Array) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	parsed, err := parseInt64Array(strings.TrimSpace(text))
+	if err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Int64Array) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Int64Array) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed []int64
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Int64Array) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Int64Array) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed []int64
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from empty array
+func (n Int64Array) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Int64Array) GobDecode(data []byte) error {
+	var parsed []int64
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.SetNull()
+		return nil
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// Scan implements scanner interface, reading PostgreSQL array text form
+func (n *Int64Array) Scan(value interface{}) error {
+	value = scanValue(value)
+	if value == nil {
+		n.SetNull()
+		return nil
+	}
+
+	var scanned string
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
+	}
+	parsed, err := parseInt64Array(scanned)
+	if err != nil {
+		return scanError(err)
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n Int64Array) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.String(), nil
+}
+
+// GormDataType gorm common data type
+func (Int64Array) GormDataType() string {
+	return "int64_array_null"
+}
+
+// GormDBDataType gorm db data type
+func (n Int64Array) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Int64Array) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Int64Array) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
+	case "postgres", "cockroachdb":
+		return "bigint[]"
+	case "sqlite", "mysql":
+		// No array type, the text form is stored as is
+		return "TEXT"
+	case "sqlserver":
+		return "NVARCHAR(MAX)"
+	case "clickhouse":
+		// ClickHouse arrays cannot be NULL
+		return "Nullable(String)"
+	}
+	return ""
+}
+
+// parseInt64Array parses array text form such as {1,2,3} into 64-bit integers
+func parseInt64Array(text string) ([]int64, error) {
+	elements, err := parsePGArray(text)
+	if err != nil {
+		return nil, err
+	}
+	parsed := make([]int64, len(elements))
+	for i, element := range elements {
+		if parsed[i], err = strconv.ParseInt(element, 10, 64); err != nil {
+			return nil, fmt.Errorf("nullable: invalid element %q of Int64Array: %w", element, err)
+		}
+	}
+	return parsed, nil
+}
@@ -0,0 +1,261 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanInt64Array(t *testing.T) {
+	nullableArray := nullable.NullInt64Array()
+
+	tests.AssertEqual(t, nullableArray.Scan("{1,-2,3}"), nil)
+	tests.AssertEqual(t, nullableArray.Get(), []int64{1, -2, 3})
+
+	tests.AssertEqual(t, nullableArray.Scan([]byte("{}")), nil)
+	tests.AssertEqual(t, nullableArray.IsValid(), true)
+	tests.AssertEqual(t, nullableArray.Get(), []int64{})
+
+	tests.AssertEqual(t, nullableArray.Scan([]byte("{ 9223372036854775807 , -9223372036854775808 }")), nil)
+	tests.AssertEqual(t, nullableArray.Get(), []int64{math.MaxInt64, math.MinInt64})
+
+	for _, malformed := range []string{"", "1,2", "{1,2", "{1,,2}", "{1,NULL}", "{{1,2},{3,4}}", "{9223372036854775808}"} {
+		if err := nullableArray.Scan(malformed); err == nil {
+			t.Errorf("scanning %q must fail", malformed)
+		}
+	}
+
+	tests.AssertEqual(t, nullableArray.Scan(nil), nil)
+	tests.AssertEqual(t, nullableArray.Get(), nil)
+}
+
+func TestValueInt64Array(t *testing.T) {
+	value, err := nullable.Int64ArrayFrom([]int64{1, -2, 3}).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, "{1,-2,3}")
+
+	value, err = nullable.Int64ArrayFrom(nil).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, "{}")
+
+	value, err = nullable.NullInt64Array().Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
+func TestNewInt64Array(t *testing.T) {
+	basic := []int64{1, 2, 3}
+	nullableArray1 := nullable.NewInt64Array(&basic)
+	tests.AssertEqual(t, nullableArray1.Get(), basic)
+
+	nullableArray2 := nullable.NewInt64Array(nil)
+	tests.AssertEqual(t, nullableArray2.Get(), nil)
+
+	var empty []int64
+	nullableArray3 := nullable.NewInt64Array(&empty)
+	tests.AssertEqual(t, nullableArray3.IsValid(), true)
+	tests.AssertEqual(t, nullableArray3.Get(), []int64{})
+}
+
+func TestSetInt64Array(t *testing.T) {
+	nullableArray := nullable.NullInt64Array()
+
+	basic := []int64{1, 2, 3}
+	nullableArray.Set(&basic)
+	tests.AssertEqual(t, nullableArray.Get(), basic)
+
+	nullableArray.SetValue(nil)
+	tests.AssertEqual(t, nullableArray, nullable.Int64ArrayFrom([]int64{}))
+
+	nullableArray.SetNull()
+	tests.AssertEqual(t, nullableArray, nullable.NullInt64Array())
+}
+
+func TestGetOrInt64Array(t *testing.T) {
+	tests.AssertEqual(t, nullable.Int64ArrayFrom([]int64{1}).GetOr([]int64{2}), []int64{1})
+	tests.AssertEqual(t, nullable.NullInt64Array().GetOr([]int64{2}), []int64{2})
+	tests.AssertEqual(t, nullable.NullInt64Array().GetOrZero() == nil, true)
+	tests.AssertEqual(t, nullable.Int64ArrayFrom([]int64{1}).MustGet(), []int64{1})
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustGet on NULL array must panic")
+		}
+	}()
+	nullable.NullInt64Array().MustGet()
+}
+
+func TestIsZeroInt64Array(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullInt64Array().IsZero(), true)
+	tests.AssertEqual(t, nullable.Int64ArrayFrom([]int64{}).IsZero(), false)
+}
+
+func TestCloneInt64Array(t *testing.T) {
+	original := nullable.Int64ArrayFrom([]int64{1, 2})
+	clone := original.Clone()
+	tests.AssertEqual(t, clone, original)
+
+	clone.MustGet()[0] = 3
+	tests.AssertEqual(t, original, nullable.Int64ArrayFrom([]int64{1, 2}))
+	tests.AssertEqual(t, nullable.NullInt64Array().Clone(), nullable.NullInt64Array())
+}
+
+func TestUnwrapInt64Array(t *testing.T) {
+	value, ok := nullable.Int64ArrayFrom([]int64{}).Unwrap()
+	tests.AssertEqual(t, value, []int64{})
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullInt64Array().Unwrap()
+	tests.AssertEqual(t, value == nil, true)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestStringerInt64Array(t *testing.T) {
+	nullableArray := nullable.Int64ArrayFrom([]int64{1, 2, 3})
+	tests.AssertEqual(t, nullableArray.String(), "{1,2,3}")
+	tests.AssertEqual(t, fmt.Sprint(nullableArray), "{1,2,3}")
+
+	nullableArray.SetNull()
+	tests.AssertEqual(t, nullableArray.String(), "<null>")
+}
+
+func TestEqualInt64Array(t *testing.T) {
+	basic := nullable.Int64ArrayFrom([]int64{1, 2, 3})
+	empty := nullable.Int64ArrayFrom([]int64{})
+	tests.AssertEqual(t, nullable.NullInt64Array().Equal(nullable.NullInt64Array()), true)
+	tests.AssertEqual(t, basic.Equal(nullable.Int64ArrayFrom([]int64{1, 2, 3})), true)
+	tests.AssertEqual(t, basic.Equal(empty), false)
+	tests.AssertEqual(t, empty.Equal(nullable.NullInt64Array()), false)
+	tests.AssertEqual(t, empty.Changed(nullable.NullInt64Array()), true)
+}
+
+func TestJSONInt64Array(t *testing.T) {
+	cases := []struct {
+		value      nullable.Int64Array
+		serialized string
+	}{
+		{nullable.Int64ArrayFrom([]int64{1, -2}), `[1,-2]`},
+		{nullable.Int64ArrayFrom([]int64{}), `[]`},
+		{nullable.NullInt64Array(), `null`},
+	}
+	for _, c := range cases {
+		serialized, err := json.Marshal(c.value)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(serialized), c.serialized)
+
+		var unserialized nullable.Int64Array
+		tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+		tests.AssertEqual(t, unserialized, c.value)
+	}
+
+	var unserialized nullable.Int64Array
+	if err := json.Unmarshal([]byte(`["1"]`), &unserialized); err == nil {
+		t.Error("unmarshalling JSON array of strings into Int64Array must fail")
+	}
+}
+
+func TestTextInt64Array(t *testing.T) {
+	marshalUnmarshalText(t, nullable.Int64ArrayFrom([]int64{math.MinInt64, 0, math.MaxInt64}))
+	marshalUnmarshalText(t, nullable.Int64ArrayFrom([]int64{}))
+	marshalUnmarshalText(t, nullable.NullInt64Array())
+	marshalUnmarshalCSV(t, nullable.Int64ArrayFrom([]int64{math.MinInt64, 0, math.MaxInt64}))
+	marshalUnmarshalCSV(t, nullable.NullInt64Array())
+
+	cell, err := nullable.Int64ArrayFrom([]int64{}).MarshalCSV()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, cell, "{}")
+
+	var unserialized nullable.Int64Array
+	tests.AssertEqual(t, unserialized.UnmarshalCSV("{}"), nil)
+	tests.AssertEqual(t, unserialized.IsValid(), true)
+	tests.AssertEqual(t, unserialized.UnmarshalCSV(""), nil)
+	tests.AssertEqual(t, unserialized.IsNull(), true)
+	if err := unserialized.UnmarshalText([]byte("{1,x}")); err == nil {
+		t.Error("unmarshalling a malformed array text must fail")
+	}
+}
+
+func TestYAMLInt64Array(t *testing.T) {
+	marshalUnmarshalYAML(t, nullable.Int64ArrayFrom([]int64{math.MinInt64, 0, math.MaxInt64}))
+	marshalUnmarshalYAML(t, nullable.Int64ArrayFrom([]int64{}))
+	marshalUnmarshalYAML(t, nullable.NullInt64Array())
+
+	var unserialized yamlEnvelope[nullable.Int64Array]
+	if err := yaml.Unmarshal([]byte("value: [a]"), &unserialized); err == nil {
+		t.Error("unmarshalling a sequence of strings into Int64Array must fail")
+	}
+}
+
+func TestXMLInt64Array(t *testing.T) {
+	marshalUnmarshalXML(t, nullable.Int64ArrayFrom([]int64{math.MinInt64, 0, math.MaxInt64}))
+	marshalUnmarshalXML(t, nullable.Int64ArrayFrom([]int64{}))
+	marshalUnmarshalXML(t, nullable.NullInt64Array())
+
+	var unserialized nullable.Int64Array
+	if err := xml.Unmarshal([]byte("<Int64Array>1,2</Int64Array>"), &unserialized); err == nil {
+		t.Error("unmarshalling an array without braces must fail")
+	}
+}
+
+func TestMsgpackInt64Array(t *testing.T) {
+	marshalUnmarshalMsgpack(t, nullable.Int64ArrayFrom([]int64{math.MinInt64, 0, math.MaxInt64}))
+	marshalUnmarshalMsgpack(t, nullable.Int64ArrayFrom([]int64{}))
+	marshalUnmarshalMsgpack(t, nullable.NullInt64Array())
+}
+
+func TestBSONInt64Array(t *testing.T) {
+	marshalUnmarshalBSON(t, nullable.Int64ArrayFrom([]int64{math.MinInt64, 0, math.MaxInt64}))
+	marshalUnmarshalBSON(t, nullable.Int64ArrayFrom([]int64{}))
+	marshalUnmarshalBSON(t, nullable.NullInt64Array())
+}
+
+func TestGobInt64Array(t *testing.T) {
+	marshalUnmarshalGob(t, nullable.Int64ArrayFrom([]int64{math.MinInt64, 0, math.MaxInt64}))
+	marshalUnmarshalGob(t, nullable.Int64ArrayFrom([]int64{}))
+	marshalUnmarshalGob(t, nullable.NullInt64Array())
+}
+
+func TestInt64Array(t *testing.T) {
+	type TestNullableInt64Array struct {
+		ID      uint
+		Name    string
+		ItemIDs nullable.Int64Array
+	}
+
+	DB.Migrator().DropTable(&TestNullableInt64Array{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableInt64Array{}); err != nil {
+		t.Errorf("failed to migrate nullable int64 array, got error: %v", err)
+	}
+
+	filled := TestNullableInt64Array{
+		Name:    "filled",
+		ItemIDs: nullable.Int64ArrayFrom([]int64{math.MinInt64, 0, math.MaxInt64}),
+	}
+	DB.Create(&filled)
+
+	empty := TestNullableInt64Array{
+		Name:    "empty",
+		ItemIDs: nullable.Int64ArrayFrom([]int64{}),
+	}
+	DB.Create(&empty)
+
+	unknown := TestNullableInt64Array{
+		Name:    "unknown",
+		ItemIDs: nullable.NullInt64Array(),
+	}
+	DB.Create(&unknown)
+
+	for _, expected := range []TestNullableInt64Array{filled, empty, unknown} {
+		var result TestNullableInt64Array
+		if err := DB.First(&result, "name = ?", expected.Name).Error; err != nil {
+			t.Fatalf("Cannot read int64 array test record of %q", expected.Name)
+		}
+		tests.AssertEqual(t, result, expected)
+	}
+}
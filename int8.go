@@ -1,49 +1,202 @@
 package nullable
 
 import (
+	"cmp"
+	"context"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
 
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/schema"
 )
 
 // Int8 SQL type that can retrieve NULL value
 type Int8 struct {
-	realValue int8
-	isValid   bool
+	core[int8]
 }
 
 // NewInt8 creates a new nullable 8-bit integer
 func NewInt8(value *int8) Int8 {
-	if value == nil {
-		return Int8{
-			realValue: 0,
-			isValid:   false,
+	return Int8{core: newCore(value)}
+}
+
+// Int8From creates a new valid nullable 8-bit integer from value
+func Int8From(value int8) Int8 {
+	return NewInt8(&value)
+}
+
+// NullInt8 creates a new NULL 8-bit integer
+func NullInt8() Int8 {
+	return NewInt8(nil)
+}
+
+// Int8ZeroAsNull creates a new nullable 8-bit integer that is NULL when value is the zero value
+func Int8ZeroAsNull(value int8) Int8 {
+	if value == 0 {
+		return NullInt8()
+	}
+	return Int8From(value)
+}
+
+// CoalesceInt8 returns the first valid value, or NULL when all of them are NULL
+func CoalesceInt8(values ...Int8) Int8 {
+	for _, value := range values {
+		if value.isValid {
+			return value
 		}
 	}
-	return Int8{
-		realValue: *value,
-		isValid:   true,
+	return Int8{}
+}
+
+// MinInt8 returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinInt8(values ...Int8) Int8 {
+	var result Int8
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// MaxInt8 returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxInt8(values ...Int8) Int8 {
+	var result Int8
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// Int8Ptr returns n.Get(), handy where a function value is needed
+func Int8Ptr(n Int8) *int8 {
+	return n.Get()
+}
+
+// Int8Value returns n.GetOrZero(), zero value when NULL
+func Int8Value(n Int8) int8 {
+	return n.GetOrZero()
+}
+
+// ParseInt8 parses text like UnmarshalText does, empty text is NULL
+func ParseInt8(text string) (Int8, error) {
+	var n Int8
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Int8{}, err
+	}
+	return n, nil
+}
+
+// MustParseInt8 is ParseInt8 that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseInt8(text string) Int8 {
+	n, err := ParseInt8(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseInt8(%q): %v", text, err))
 	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL Int8, the one a declared
+// Int8 starts with, so pooled values can be reused
+func (n *Int8) Reset() {
+	*n = Int8{}
 }
 
-// Get either nil or 8-bit integer
-func (n Int8) Get() *int8 {
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Int8) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Int8) NullIfZero() {
+	if n.isValid && n.realValue == 0 {
+		n.SetNull()
+	}
+}
+
+// MustGet either 8-bit integer or panic when NULL
+func (n Int8) MustGet() int8 {
+	return n.mustGet("Int8")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Int8) Clone() Int8 {
+	return n
+}
+
+// String returns 8-bit integer in its natural text form, or "<null>" when NULL
+func (n Int8) String() string {
 	if !n.isValid {
-		return nil
+		return nullString
 	}
-	return &n.realValue
+	return strconv.FormatInt(int64(n.realValue), 10)
 }
 
-// Set either nil or 8-bit integer
-func (n *Int8) Set(value *int8) {
-	n.isValid = (value != nil)
-	if n.isValid {
-		n.realValue = *value
-	} else {
-		n.realValue = 0
+// DebugString returns Int8(value) or Int8(NULL), telling NULL apart from a
+// zero value in logs
+func (n Int8) DebugString() string {
+	return debugString("Int8", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same 8-bit integer
+func (n Int8) Equal(other Int8) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Int8) Changed(old Int8) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any 8-bit integer.
+func (n Int8) Compare(other Int8) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
 	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL 8 big-endian bytes, telling NULL apart from zero in cache keys
+func (n Int8) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagInt8, n.isValid, hashUint64(uint64(n.realValue)))
 }
 
 // MarshalJSON converts current value to JSON
@@ -53,8 +206,11 @@ func (n Int8) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON writes JSON to this type
 func (n *Int8) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
 		n.isValid = false
 		n.realValue = 0
 		return nil
@@ -62,6 +218,138 @@ func (n *Int8) UnmarshalJSON(data []byte) error {
 
 	var parsed int8
 	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Int8) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendInt(nil, int64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Int8) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(string(text), 10, 8)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = int8(parsed)
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Int8) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Int8) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Int8) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return strconv.FormatInt(int64(n.realValue), 10), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Int8) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Int8) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed int8
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n Int8) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue, start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Int8) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseInt(strings.TrimSpace(text), 10, 8)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = int8(parsed)
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Int8) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Int8) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed int8
+	if err := dec.Decode(&parsed); err != nil {
 		return err
 	}
 
@@ -70,18 +358,77 @@ func (n *Int8) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Int8) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Int8) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed int8
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Int8) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Int8) GobDecode(data []byte) error {
+	var parsed int8
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
 // Scan implements scanner interface
 func (n *Int8) Scan(value interface{}) error {
+	value = scanValue(value)
 	if value == nil {
 		n.realValue, n.isValid = 0, false
 		return nil
 	}
 
-	var i64 int64
-	if err := convertAssign(&i64, value); err != nil {
-		return err
+	if floatValue, ok := value.(float64); ok {
+		parsed, err := scanFloatInt(floatValue, 8, "int8")
+		if err != nil {
+			return scanError(err)
+		}
+		n.realValue, n.isValid = int8(parsed), true
+		return nil
+	}
+
+	var scanned int8
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
 	}
-	n.realValue = int8(i64)
+	n.realValue = scanned
 
 	n.isValid = true
 	return nil
@@ -95,18 +442,63 @@ func (n Int8) Value() (driver.Value, error) {
 	return int64(n.realValue), nil
 }
 
+// GormValue implements the driver Valuer interface via GORM.
+func (n Int8) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
+	switch dialectName(db) {
+	case "sqlite", "mysql", "sqlserver":
+		// MySQL, SQLite and SQL Server are using Value() instead of GormValue()
+		value, err := n.Value()
+		if err != nil {
+			db.AddError(err)
+			return clause.Expr{}
+		}
+		return clause.Expr{SQL: "?", Vars: []interface{}{value}}
+	case "postgres", "clickhouse", "cockroachdb":
+		if !n.isValid {
+			return clause.Expr{SQL: "?", Vars: []interface{}{nil}}
+		}
+		return clause.Expr{SQL: "?", Vars: []interface{}{n.realValue}}
+	default:
+		// Unknown dialects get the same bound parameter as database/sql would
+		value, err := n.Value()
+		if err != nil {
+			db.AddError(fmt.Errorf("nullable: cannot bind Int8 for %q dialect: %w", db.Dialector.Name(), err))
+			return clause.Expr{}
+		}
+		return clause.Expr{SQL: "?", Vars: []interface{}{value}}
+	}
+}
+
 // GormDataType gorm common data type
 func (Int8) GormDataType() string {
 	return "int8_null"
 }
 
 // GormDBDataType gorm db data type
-func (Int8) GormDBDataType(db *gorm.DB, field *schema.Field) string {
-	switch db.Dialector.Name() {
+func (n Int8) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Int8) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Int8) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
 	case "sqlite", "mysql":
 		return "TINYINT"
 	case "postgres":
 		return "smallint"
+	case "sqlserver":
+		// SQL Server TINYINT is unsigned, use the next wider signed one
+		return "SMALLINT"
+	case "clickhouse":
+		return "Nullable(Int8)"
+	case "cockroachdb":
+		return "INT2"
 	}
 	return ""
 }
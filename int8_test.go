@@ -1,6 +1,8 @@
 package nullable_test
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/tee8z/nullable"
@@ -22,6 +24,39 @@ func TestScanInt8(t *testing.T) {
 	tests.AssertEqual(t, nullableInt.Get(), nil)
 }
 
+func TestScanInt8Overflow(t *testing.T) {
+	nullableInt := nullable.NewInt8(nil)
+
+	tests.AssertEqual(t, nullableInt.Scan(127), nil)
+	tests.AssertEqual(t, nullableInt.Get(), 127)
+
+	if err := nullableInt.Scan(128); err == nil {
+		t.Errorf("scanning 128 into Int8 must fail, got %v", nullableInt.Get())
+	}
+	if err := nullableInt.Scan(-129); err == nil {
+		t.Errorf("scanning -129 into Int8 must fail, got %v", nullableInt.Get())
+	}
+	if err := nullableInt.Scan("128"); err == nil {
+		t.Errorf("scanning \"128\" into Int8 must fail, got %v", nullableInt.Get())
+	}
+
+	// previous valid value must be preserved
+	tests.AssertEqual(t, nullableInt.Get(), 127)
+}
+
+func TestScanFloatInt8(t *testing.T) {
+	var scanned nullable.Int8
+	tests.AssertEqual(t, scanned.Scan(float64(42)), nil)
+	tests.AssertEqual(t, scanned, nullable.Int8From(42))
+
+	if err := scanned.Scan(float64(42.5)); err == nil {
+		t.Error("scanning float64 with fractional part into Int8 must fail")
+	}
+	if err := scanned.Scan(float64(128)); err == nil {
+		t.Error("scanning overflowing float64 into Int8 must fail")
+	}
+}
+
 func TestNewInt8(t *testing.T) {
 	// uint8
 	var basicInt1 int8 = 37
@@ -52,6 +87,259 @@ func TestSetInt8(t *testing.T) {
 	tests.AssertEqual(t, nullableInt.Get(), nil)
 }
 
+func TestInt8From(t *testing.T) {
+	var basic int8 = -100
+	tests.AssertEqual(t, nullable.Int8From(basic), nullable.NewInt8(&basic))
+	tests.AssertEqual(t, nullable.Int8From(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullInt8(), nullable.NewInt8(nil))
+	tests.AssertEqual(t, nullable.NullInt8().IsNull(), true)
+}
+
+func TestCoalesceInt8(t *testing.T) {
+	var basic int8 = -100
+	var zero int8 = 0
+	tests.AssertEqual(t, nullable.CoalesceInt8(), nullable.Int8{})
+	tests.AssertEqual(t, nullable.CoalesceInt8(nullable.NullInt8(), nullable.NullInt8()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceInt8(nullable.NullInt8(), nullable.Int8From(basic), nullable.Int8From(zero)), nullable.Int8From(basic))
+	tests.AssertEqual(t, nullable.CoalesceInt8(nullable.Int8From(zero), nullable.Int8From(basic)), nullable.Int8From(zero))
+}
+
+func TestIsZeroInt8(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullInt8().IsZero(), true)
+	tests.AssertEqual(t, nullable.Int8From(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.Int8From(-100).IsZero(), false)
+}
+
+func TestCloneInt8(t *testing.T) {
+	tests.AssertEqual(t, nullable.Int8From(-100).Clone(), nullable.Int8From(-100))
+	tests.AssertEqual(t, nullable.NullInt8().Clone(), nullable.NullInt8())
+}
+
+func TestUnwrapInt8(t *testing.T) {
+	var basic int8 = -100
+	value, ok := nullable.Int8From(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullInt8().Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Int8
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseInt8(t *testing.T) {
+	parsed, err := nullable.ParseInt8("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullInt8())
+
+	text, err := nullable.Int8From(-100).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseInt8(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.Int8From(-100)), true)
+
+	for _, text := range []string{"abc", "1.5", "1e3"} {
+		if _, err := nullable.ParseInt8(text); err == nil {
+			t.Errorf("parsing %q as 8-bit integer must fail", text)
+		}
+	}
+}
+
+func TestMustParseInt8(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseInt8(""), nullable.NullInt8())
+
+	text, err := nullable.Int8From(-100).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseInt8(string(text)).Equal(nullable.Int8From(-100)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseInt8 of malformed text must panic")
+		}
+	}()
+	nullable.MustParseInt8("abc")
+}
+
+func TestPtrInt8(t *testing.T) {
+	var basic int8 = -100
+	tests.AssertEqual(t, nullable.Int8Ptr(nullable.NewInt8(&basic)), basic)
+	tests.AssertEqual(t, nullable.Int8Ptr(nullable.NewInt8(nil)), nil)
+	tests.AssertEqual(t, nullable.Int8Value(nullable.NewInt8(&basic)), basic)
+	tests.AssertEqual(t, nullable.Int8Value(nullable.NewInt8(nil)), 0)
+}
+
+func TestSetValueInt8(t *testing.T) {
+	var basic int8 = -100
+	var nullableInt8 nullable.Int8
+	nullableInt8.SetValue(basic)
+	tests.AssertEqual(t, nullableInt8, nullable.Int8From(basic))
+
+	nullableInt8.SetNull()
+	tests.AssertEqual(t, nullableInt8, nullable.NullInt8())
+}
+
+func TestZeroAsNullInt8(t *testing.T) {
+	var basic int8 = -100
+	var zero int8 = 0
+	tests.AssertEqual(t, nullable.Int8ZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.Int8ZeroAsNull(basic), nullable.Int8From(basic))
+
+	nullableInt8 := nullable.Int8From(zero)
+	nullableInt8.NullIfZero()
+	tests.AssertEqual(t, nullableInt8, nullable.NullInt8())
+
+	nullableInt8 = nullable.Int8From(basic)
+	nullableInt8.NullIfZero()
+	tests.AssertEqual(t, nullableInt8, nullable.Int8From(basic))
+}
+
+func TestIsNullInt8(t *testing.T) {
+	var zero nullable.Int8
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic int8 = -100
+	nullableInt8 := nullable.NewInt8(&basic)
+	tests.AssertEqual(t, nullableInt8.IsNull(), false)
+	tests.AssertEqual(t, nullableInt8.IsValid(), true)
+
+	nullableInt8.Set(nil)
+	tests.AssertEqual(t, nullableInt8.IsNull(), true)
+	tests.AssertEqual(t, nullableInt8.IsValid(), false)
+}
+
+func TestGetOrInt8(t *testing.T) {
+	var zero nullable.Int8
+	var fallback int8 = -100
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), 0)
+
+	var basic int8 = -100
+	nullableInt8 := nullable.NewInt8(&basic)
+	tests.AssertEqual(t, nullableInt8.GetOr(0), basic)
+	tests.AssertEqual(t, nullableInt8.GetOrZero(), basic)
+}
+
+func TestMustGetInt8(t *testing.T) {
+	var basic int8 = -100
+	nullableInt8 := nullable.NewInt8(&basic)
+	tests.AssertEqual(t, nullableInt8.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Int8")
+	}()
+	nullableInt8.Set(nil)
+	nullableInt8.MustGet()
+	t.Error("MustGet on NULL Int8 must panic")
+}
+
+func TestTextInt8(t *testing.T) {
+	var basic int8 = -100
+	marshalUnmarshalText(t, nullable.NewInt8(&basic))
+
+	marshalUnmarshalText(t, nullable.NewInt8(nil))
+}
+
+func TestCSVInt8(t *testing.T) {
+	var basic int8 = -100
+	marshalUnmarshalCSV(t, nullable.NewInt8(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewInt8(nil))
+}
+
+func TestYAMLInt8(t *testing.T) {
+	var basic int8 = -100
+	marshalUnmarshalYAML(t, nullable.NewInt8(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewInt8(nil))
+}
+
+func TestMsgpackInt8(t *testing.T) {
+	var basic int8 = -100
+	marshalUnmarshalMsgpack(t, nullable.NewInt8(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewInt8(nil))
+}
+
+func TestBSONInt8(t *testing.T) {
+	var basic int8 = -100
+	marshalUnmarshalBSON(t, nullable.NewInt8(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewInt8(nil))
+}
+
+func TestGobInt8(t *testing.T) {
+	var basic int8 = -100
+	marshalUnmarshalGob(t, nullable.NewInt8(&basic))
+
+	var zero int8 = 0
+	marshalUnmarshalGob(t, nullable.NewInt8(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewInt8(nil))
+}
+
+func TestStringerInt8(t *testing.T) {
+	var basic int8 = -100
+	nullableInt8 := nullable.NewInt8(&basic)
+	tests.AssertEqual(t, nullableInt8.String(), "-100")
+	tests.AssertEqual(t, fmt.Sprint(nullableInt8), "-100")
+
+	nullableInt8.Set(nil)
+	tests.AssertEqual(t, nullableInt8.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableInt8), "<null>")
+}
+
+func TestChangedInt8(t *testing.T) {
+	var basic int8 = -100
+	value, null := nullable.NewInt8(&basic), nullable.NewInt8(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.Int8From(0)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewInt8(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewInt8(nil)), false)
+}
+
+func TestEqualInt8(t *testing.T) {
+	var basic int8 = -100
+	var zero int8 = 0
+	tests.AssertEqual(t, nullable.NewInt8(nil).Equal(nullable.NewInt8(nil)), true)
+	tests.AssertEqual(t, nullable.NewInt8(nil).Equal(nullable.NewInt8(&basic)), false)
+	tests.AssertEqual(t, nullable.NewInt8(&basic).Equal(nullable.NewInt8(nil)), false)
+	tests.AssertEqual(t, nullable.NewInt8(&basic).Equal(nullable.NewInt8(&basic)), true)
+	tests.AssertEqual(t, nullable.NewInt8(&basic).Equal(nullable.NewInt8(&zero)), false)
+	tests.AssertEqual(t, nullable.NewInt8(&zero).Equal(nullable.NewInt8(nil)), false)
+}
+
+func TestCompareInt8(t *testing.T) {
+	var lesser int8 = -100
+	var greater int8 = 100
+	null := nullable.NewInt8(nil)
+	small := nullable.NewInt8(&lesser)
+	big := nullable.NewInt8(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewInt8(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewInt8(&greater)), 0)
+
+	values := []nullable.Int8{big, null, small}
+	slices.SortFunc(values, nullable.Int8.Compare)
+	tests.AssertEqual(t, values, []nullable.Int8{null, small, big})
+}
+
+func TestXMLInt8(t *testing.T) {
+	var basic int8 = -100
+	marshalUnmarshalXML(t, nullable.NewInt8(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewInt8(nil))
+}
+
 func TestJSONInt8(t *testing.T) {
 	var basicInt1 int8 = 37
 	marshalUnmarshalJSON(t, nullable.NewInt8(&basicInt1))
@@ -1,6 +1,8 @@
 package nullable_test
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/tee8z/nullable"
@@ -46,6 +48,19 @@ func TestScanInt(t *testing.T) {
 	tests.AssertEqual(t, nullableInt.Get(), nil)
 }
 
+func TestScanFloatInt(t *testing.T) {
+	var scanned nullable.Int
+	tests.AssertEqual(t, scanned.Scan(float64(42)), nil)
+	tests.AssertEqual(t, scanned, nullable.IntFrom(42))
+
+	if err := scanned.Scan(float64(42.5)); err == nil {
+		t.Error("scanning float64 with fractional part into Int must fail")
+	}
+	if err := scanned.Scan(float64(1 << 63)); err == nil {
+		t.Error("scanning overflowing float64 into Int must fail")
+	}
+}
+
 func TestNewInt(t *testing.T) {
 	// uint8
 	var basicInt1 int = 37
@@ -136,6 +151,259 @@ func TestSetInt(t *testing.T) {
 	tests.AssertEqual(t, nullableInt.Get(), nil)
 }
 
+func TestIntFrom(t *testing.T) {
+	var basic int = -12345
+	tests.AssertEqual(t, nullable.IntFrom(basic), nullable.NewInt(&basic))
+	tests.AssertEqual(t, nullable.IntFrom(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullInt(), nullable.NewInt(nil))
+	tests.AssertEqual(t, nullable.NullInt().IsNull(), true)
+}
+
+func TestCoalesceInt(t *testing.T) {
+	var basic int = -12345
+	var zero int = 0
+	tests.AssertEqual(t, nullable.CoalesceInt(), nullable.Int{})
+	tests.AssertEqual(t, nullable.CoalesceInt(nullable.NullInt(), nullable.NullInt()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceInt(nullable.NullInt(), nullable.IntFrom(basic), nullable.IntFrom(zero)), nullable.IntFrom(basic))
+	tests.AssertEqual(t, nullable.CoalesceInt(nullable.IntFrom(zero), nullable.IntFrom(basic)), nullable.IntFrom(zero))
+}
+
+func TestIsZeroInt(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullInt().IsZero(), true)
+	tests.AssertEqual(t, nullable.IntFrom(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.IntFrom(-12345).IsZero(), false)
+}
+
+func TestCloneInt(t *testing.T) {
+	tests.AssertEqual(t, nullable.IntFrom(-12345).Clone(), nullable.IntFrom(-12345))
+	tests.AssertEqual(t, nullable.NullInt().Clone(), nullable.NullInt())
+}
+
+func TestUnwrapInt(t *testing.T) {
+	var basic int = -12345
+	value, ok := nullable.IntFrom(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullInt().Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Int
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseInt(t *testing.T) {
+	parsed, err := nullable.ParseInt("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullInt())
+
+	text, err := nullable.IntFrom(-12345).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseInt(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.IntFrom(-12345)), true)
+
+	for _, text := range []string{"abc", "1.5", "1e3"} {
+		if _, err := nullable.ParseInt(text); err == nil {
+			t.Errorf("parsing %q as integer must fail", text)
+		}
+	}
+}
+
+func TestMustParseInt(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseInt(""), nullable.NullInt())
+
+	text, err := nullable.IntFrom(-12345).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseInt(string(text)).Equal(nullable.IntFrom(-12345)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseInt of malformed text must panic")
+		}
+	}()
+	nullable.MustParseInt("abc")
+}
+
+func TestPtrInt(t *testing.T) {
+	var basic int = -12345
+	tests.AssertEqual(t, nullable.IntPtr(nullable.NewInt(&basic)), basic)
+	tests.AssertEqual(t, nullable.IntPtr(nullable.NewInt(nil)), nil)
+	tests.AssertEqual(t, nullable.IntValue(nullable.NewInt(&basic)), basic)
+	tests.AssertEqual(t, nullable.IntValue(nullable.NewInt(nil)), 0)
+}
+
+func TestSetValueInt(t *testing.T) {
+	var basic int = -12345
+	var nullableInt nullable.Int
+	nullableInt.SetValue(basic)
+	tests.AssertEqual(t, nullableInt, nullable.IntFrom(basic))
+
+	nullableInt.SetNull()
+	tests.AssertEqual(t, nullableInt, nullable.NullInt())
+}
+
+func TestZeroAsNullInt(t *testing.T) {
+	var basic int = -12345
+	var zero int = 0
+	tests.AssertEqual(t, nullable.IntZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.IntZeroAsNull(basic), nullable.IntFrom(basic))
+
+	nullableInt := nullable.IntFrom(zero)
+	nullableInt.NullIfZero()
+	tests.AssertEqual(t, nullableInt, nullable.NullInt())
+
+	nullableInt = nullable.IntFrom(basic)
+	nullableInt.NullIfZero()
+	tests.AssertEqual(t, nullableInt, nullable.IntFrom(basic))
+}
+
+func TestIsNullInt(t *testing.T) {
+	var zero nullable.Int
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic int = -12345
+	nullableInt := nullable.NewInt(&basic)
+	tests.AssertEqual(t, nullableInt.IsNull(), false)
+	tests.AssertEqual(t, nullableInt.IsValid(), true)
+
+	nullableInt.Set(nil)
+	tests.AssertEqual(t, nullableInt.IsNull(), true)
+	tests.AssertEqual(t, nullableInt.IsValid(), false)
+}
+
+func TestGetOrInt(t *testing.T) {
+	var zero nullable.Int
+	var fallback int = -12345
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), 0)
+
+	var basic int = -12345
+	nullableInt := nullable.NewInt(&basic)
+	tests.AssertEqual(t, nullableInt.GetOr(0), basic)
+	tests.AssertEqual(t, nullableInt.GetOrZero(), basic)
+}
+
+func TestMustGetInt(t *testing.T) {
+	var basic int = -12345
+	nullableInt := nullable.NewInt(&basic)
+	tests.AssertEqual(t, nullableInt.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Int")
+	}()
+	nullableInt.Set(nil)
+	nullableInt.MustGet()
+	t.Error("MustGet on NULL Int must panic")
+}
+
+func TestTextInt(t *testing.T) {
+	var basic int = -12345
+	marshalUnmarshalText(t, nullable.NewInt(&basic))
+
+	marshalUnmarshalText(t, nullable.NewInt(nil))
+}
+
+func TestCSVInt(t *testing.T) {
+	var basic int = -12345
+	marshalUnmarshalCSV(t, nullable.NewInt(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewInt(nil))
+}
+
+func TestYAMLInt(t *testing.T) {
+	var basic int = -12345
+	marshalUnmarshalYAML(t, nullable.NewInt(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewInt(nil))
+}
+
+func TestMsgpackInt(t *testing.T) {
+	var basic int = -12345
+	marshalUnmarshalMsgpack(t, nullable.NewInt(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewInt(nil))
+}
+
+func TestBSONInt(t *testing.T) {
+	var basic int = -12345
+	marshalUnmarshalBSON(t, nullable.NewInt(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewInt(nil))
+}
+
+func TestGobInt(t *testing.T) {
+	var basic int = -12345
+	marshalUnmarshalGob(t, nullable.NewInt(&basic))
+
+	var zero int = 0
+	marshalUnmarshalGob(t, nullable.NewInt(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewInt(nil))
+}
+
+func TestStringerInt(t *testing.T) {
+	var basic int = -12345
+	nullableInt := nullable.NewInt(&basic)
+	tests.AssertEqual(t, nullableInt.String(), "-12345")
+	tests.AssertEqual(t, fmt.Sprint(nullableInt), "-12345")
+
+	nullableInt.Set(nil)
+	tests.AssertEqual(t, nullableInt.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableInt), "<null>")
+}
+
+func TestChangedInt(t *testing.T) {
+	var basic int = -12345
+	value, null := nullable.NewInt(&basic), nullable.NewInt(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.IntFrom(0)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewInt(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewInt(nil)), false)
+}
+
+func TestEqualInt(t *testing.T) {
+	var basic int = -12345
+	var zero int = 0
+	tests.AssertEqual(t, nullable.NewInt(nil).Equal(nullable.NewInt(nil)), true)
+	tests.AssertEqual(t, nullable.NewInt(nil).Equal(nullable.NewInt(&basic)), false)
+	tests.AssertEqual(t, nullable.NewInt(&basic).Equal(nullable.NewInt(nil)), false)
+	tests.AssertEqual(t, nullable.NewInt(&basic).Equal(nullable.NewInt(&basic)), true)
+	tests.AssertEqual(t, nullable.NewInt(&basic).Equal(nullable.NewInt(&zero)), false)
+	tests.AssertEqual(t, nullable.NewInt(&zero).Equal(nullable.NewInt(nil)), false)
+}
+
+func TestCompareInt(t *testing.T) {
+	var lesser int = -12345
+	var greater int = 12345
+	null := nullable.NewInt(nil)
+	small := nullable.NewInt(&lesser)
+	big := nullable.NewInt(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewInt(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewInt(&greater)), 0)
+
+	values := []nullable.Int{big, null, small}
+	slices.SortFunc(values, nullable.Int.Compare)
+	tests.AssertEqual(t, values, []nullable.Int{null, small, big})
+}
+
+func TestXMLInt(t *testing.T) {
+	var basic int = -12345
+	marshalUnmarshalXML(t, nullable.NewInt(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewInt(nil))
+}
+
 func TestJSONInt(t *testing.T) {
 	var basicInt1 int = 37
 	marshalUnmarshalJSON(t, nullable.NewInt(&basicInt1))
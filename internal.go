@@ -5,6 +5,7 @@ package nullable
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -72,8 +73,25 @@ func asBytes(buf []byte, rv reflect.Value) (b []byte, ok bool) {
 	return
 }
 
+// plainValue returns sql.RawBytes as plain []byte and json.Number as plain
+// string, leaving anything else as is. RawBytes is only valid until the next
+// Next or Scan, and as []byte it takes the paths that parse or copy the
+// driver buffer. A json.Number is parsed from its text, so no precision is
+// lost on the way.
+func plainValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case sql.RawBytes:
+		return []byte(value)
+	case json.Number:
+		return string(value)
+	}
+	return value
+}
+
 // convertAssign copies to dest the value in src, converting it if possible.
 func convertAssign(dest, src interface{}) error {
+	src = plainValue(src)
+
 	// Common cases, without reflect.
 	switch s := src.(type) {
 	case string:
@@ -250,7 +268,7 @@ func convertAssign(dest, src interface{}) error {
 		i64, err := strconv.ParseInt(s, 10, dv.Type().Bits())
 		if err != nil {
 			err = strconvErr(err)
-			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %v", src, s, dv.Kind(), err)
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %w", src, s, dv.Kind(), err)
 		}
 		dv.SetInt(i64)
 		return nil
@@ -262,7 +280,11 @@ func convertAssign(dest, src interface{}) error {
 		u64, err := strconv.ParseUint(s, 10, dv.Type().Bits())
 		if err != nil {
 			err = strconvErr(err)
-			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %v", src, s, dv.Kind(), err)
+			// A negative integer is out of range rather than malformed
+			if err == strconv.ErrSyntax && isIntegerText(s) {
+				err = strconv.ErrRange
+			}
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %w", src, s, dv.Kind(), err)
 		}
 		dv.SetUint(u64)
 		return nil
@@ -274,7 +296,7 @@ func convertAssign(dest, src interface{}) error {
 		f64, err := strconv.ParseFloat(s, dv.Type().Bits())
 		if err != nil {
 			err = strconvErr(err)
-			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %v", src, s, dv.Kind(), err)
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %w", src, s, dv.Kind(), err)
 		}
 		dv.SetFloat(f64)
 		return nil
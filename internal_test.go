@@ -100,6 +100,11 @@ func conversionTests() []conversionTest {
 		{s: uint64(123), d: &scanbytes, wantbytes: []byte("123")},
 		{s: 1.5, d: &scanbytes, wantbytes: []byte("1.5")},
 
+		// From sql.RawBytes
+		{s: sql.RawBytes("raw"), d: &scanstr, wantstr: "raw"},
+		{s: sql.RawBytes("raw"), d: &scanbytes, wantbytes: []byte("raw")},
+		{s: sql.RawBytes("123"), d: &scanint, wantint: 123},
+
 		// To sql.RawBytes
 		{s: nil, d: &scanraw, wantraw: nil},
 		{s: []byte("byteslice"), d: &scanraw, wantraw: sql.RawBytes("byteslice")},
@@ -0,0 +1,482 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"log/slog"
+	"net"
+	"slices"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// IP SQL type that can retrieve NULL value
+type IP struct {
+	core[net.IP]
+}
+
+// NewIP creates a new nullable IP address
+func NewIP(value *net.IP) IP {
+	return IP{core: newCore(value)}
+}
+
+// IPFrom creates a new valid nullable IP address from value
+func IPFrom(value net.IP) IP {
+	return NewIP(&value)
+}
+
+// NullIP creates a new NULL IP address
+func NullIP() IP {
+	return NewIP(nil)
+}
+
+// IPZeroAsNull creates a new nullable IP address that is NULL when value is the zero value
+func IPZeroAsNull(value net.IP) IP {
+	if len(value) == 0 {
+		return NullIP()
+	}
+	return IPFrom(value)
+}
+
+// CoalesceIP returns the first valid value, or NULL when all of them are NULL
+func CoalesceIP(values ...IP) IP {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return IP{}
+}
+
+// IPPtr returns n.Get(), handy where a function value is needed
+func IPPtr(n IP) *net.IP {
+	return n.Get()
+}
+
+// IPValue returns n.GetOrZero(), zero value when NULL
+func IPValue(n IP) net.IP {
+	return n.GetOrZero()
+}
+
+// ParseIP parses text like UnmarshalText does, empty text is NULL
+func ParseIP(text string) (IP, error) {
+	var n IP
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return IP{}, err
+	}
+	return n, nil
+}
+
+// MustParseIP is ParseIP that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseIP(text string) IP {
+	n, err := ParseIP(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseIP(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL IP, the one a declared
+// IP starts with, letting go of the held address so pooled values can be
+// reused
+func (n *IP) Reset() {
+	*n = IP{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *IP) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *IP) NullIfZero() {
+	if n.isValid && len(n.realValue) == 0 {
+		n.SetNull()
+	}
+}
+
+// MustGet either IP address or panic when NULL
+func (n IP) MustGet() net.IP {
+	return n.mustGet("IP")
+}
+
+// Clone returns a copy of the value that shares no memory with n
+func (n IP) Clone() IP {
+	n.realValue = slices.Clone(n.realValue)
+	return n
+}
+
+// String returns IP address in its natural text form, or "<null>" when NULL
+func (n IP) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return n.realValue.String()
+}
+
+// DebugString returns IP(value) or IP(NULL), telling NULL apart from a
+// zero value in logs
+func (n IP) DebugString() string {
+	return debugString("IP", n.isValid, n.String())
+}
+
+// LogValue implements slog.LogValuer, logging NULL as nil and the value as
+// its text like String
+func (n IP) LogValue() slog.Value {
+	return logText(n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same IP address
+func (n IP) Equal(other IP) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue.Equal(other.realValue)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n IP) Changed(old IP) bool {
+	return !n.Equal(old)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL the 16-byte form, so IPv4 hashes like its IPv6 mapping, telling NULL
+// apart from zero in cache keys
+func (n IP) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagIP, n.isValid, n.realValue.To16())
+}
+
+// MarshalJSON converts current value to JSON
+func (n IP) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.realValue.String())
+}
+
+// UnmarshalJSON writes JSON to this type
+func (n *IP) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return jsonError(err)
+	}
+	parsed, err := parseIP(text)
+	if err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n IP) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(n.realValue.String()), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *IP) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	parsed, err := parseIP(string(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n IP) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *IP) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n IP) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return n.realValue.String(), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n IP) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue.String(), nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *IP) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	var text string
+	if err := value.Decode(&text); err != nil {
+		return err
+	}
+	parsed, err := parseIP(text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n IP) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue.String(), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *IP) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	parsed, err := parseIP(strings.TrimSpace(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n IP) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(n.realValue.String())
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *IP) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	text, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+	parsed, err := parseIP(text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n IP) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue.String())
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *IP) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	if t != bson.TypeString {
+		return bsonTypeError(t, "IP")
+	}
+	text, _, ok := bsoncore.ReadString(data)
+	if !ok {
+		return bsonTypeError(t, "IP")
+	}
+	parsed, err := parseIP(text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n IP) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *IP) GobDecode(data []byte) error {
+	var parsed net.IP
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// Scan implements scanner interface, accepting text form as well as packed
+// 4 or 16 bytes. Text is tried first, so bytes that read as valid text win.
+func (n *IP) Scan(value interface{}) error {
+	value = scanValue(value)
+	if value == nil {
+		n.realValue, n.isValid = nil, false
+		return nil
+	}
+
+	var buffer []byte
+	if err := convertAssign(&buffer, value); err != nil {
+		return scanError(err)
+	}
+
+	text := string(buffer)
+	if strings.Contains(text, "/") {
+		// PostgreSQL inet with netmask
+		if ip, _, err := net.ParseCIDR(text); err == nil {
+			text = ip.String()
+		}
+	}
+	parsed, err := parseIP(text)
+	if err != nil {
+		if len(buffer) != net.IPv4len && len(buffer) != net.IPv6len {
+			return scanError(err)
+		}
+		parsed = net.IP(buffer).To16()
+	}
+	n.realValue = parsed
+
+	n.isValid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n IP) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue.String(), nil
+}
+
+// GormDataType gorm common data type
+func (IP) GormDataType() string {
+	return "ip_null"
+}
+
+// GormDBDataType gorm db data type
+func (n IP) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n IP) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (IP) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
+	case "sqlite", "mysql", "sqlserver":
+		// Long enough for any IPv6 address in text form
+		return "VARCHAR(45)"
+	case "postgres", "cockroachdb":
+		return "inet"
+	case "clickhouse":
+		return "Nullable(String)"
+	}
+	return ""
+}
+
+// parseIP parses IPv4 or IPv6 address in text form
+func parseIP(text string) (net.IP, error) {
+	parsed := net.ParseIP(text)
+	if parsed == nil {
+		return nil, fmt.Errorf("nullable: invalid IP address %q", text)
+	}
+	return parsed, nil
+}
@@ -0,0 +1,361 @@
+package nullable_test
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanIP(t *testing.T) {
+	nullableIP := nullable.NewIP(nil)
+
+	tests.AssertEqual(t, nullableIP.Scan("192.168.1.10"), nil)
+	tests.AssertEqual(t, nullableIP.Get(), net.ParseIP("192.168.1.10"))
+
+	tests.AssertEqual(t, nullableIP.Scan([]byte("2001:db8::68")), nil)
+	tests.AssertEqual(t, nullableIP.Get(), net.ParseIP("2001:db8::68"))
+
+	tests.AssertEqual(t, nullableIP.Scan([]byte{10, 0, 0, 1}), nil)
+	tests.AssertEqual(t, nullableIP.Get(), net.ParseIP("10.0.0.1"))
+
+	tests.AssertEqual(t, nullableIP.Scan([]byte(net.ParseIP("2001:db8::68"))), nil)
+	tests.AssertEqual(t, nullableIP.Get(), net.ParseIP("2001:db8::68"))
+
+	tests.AssertEqual(t, nullableIP.Scan("10.1.2.3/8"), nil)
+	tests.AssertEqual(t, nullableIP.Get(), net.ParseIP("10.1.2.3"))
+
+	if err := nullableIP.Scan("localhost"); err == nil {
+		t.Error("scanning malformed IP address must fail")
+	}
+
+	tests.AssertEqual(t, nullableIP.Scan(nil), nil)
+	tests.AssertEqual(t, nullableIP.Get(), nil)
+}
+
+func TestNewIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	nullableIP1 := nullable.NewIP(&basic)
+	tests.AssertEqual(t, nullableIP1.Get(), basic)
+
+	nullableIP2 := nullable.NewIP(nil)
+	tests.AssertEqual(t, nullableIP2.Get(), nil)
+}
+
+func TestSetIP(t *testing.T) {
+	nullableIP := nullable.NewIP(nil)
+	tests.AssertEqual(t, nullableIP.Get(), nil)
+
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	nullableIP.Set(&basic)
+	tests.AssertEqual(t, nullableIP.Get(), basic)
+
+	nullableIP.Set(nil)
+	tests.AssertEqual(t, nullableIP.Get(), nil)
+}
+
+func TestIPFrom(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	tests.AssertEqual(t, nullable.IPFrom(basic), nullable.NewIP(&basic))
+	tests.AssertEqual(t, nullable.IPFrom(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullIP(), nullable.NewIP(nil))
+	tests.AssertEqual(t, nullable.NullIP().IsNull(), true)
+}
+
+func TestCoalesceIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	var zero net.IP = nil
+	tests.AssertEqual(t, nullable.CoalesceIP(), nullable.IP{})
+	tests.AssertEqual(t, nullable.CoalesceIP(nullable.NullIP(), nullable.NullIP()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceIP(nullable.NullIP(), nullable.IPFrom(basic), nullable.IPFrom(zero)), nullable.IPFrom(basic))
+	tests.AssertEqual(t, nullable.CoalesceIP(nullable.IPFrom(zero), nullable.IPFrom(basic)), nullable.IPFrom(zero))
+}
+
+func TestIsZeroIP(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullIP().IsZero(), true)
+	tests.AssertEqual(t, nullable.IPFrom(nil).IsZero(), false)
+	tests.AssertEqual(t, nullable.IPFrom(net.ParseIP("192.168.1.10")).IsZero(), false)
+}
+
+func TestCloneIP(t *testing.T) {
+	original := nullable.IPFrom(net.ParseIP("192.168.1.10"))
+	clone := original.Clone()
+	tests.AssertEqual(t, clone, original)
+
+	clone.MustGet()[0]++
+	tests.AssertEqual(t, original, nullable.IPFrom(net.ParseIP("192.168.1.10")))
+	tests.AssertEqual(t, clone.Equal(original), false)
+
+	tests.AssertEqual(t, nullable.NullIP().Clone(), nullable.NullIP())
+}
+
+func TestUnwrapIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	value, ok := nullable.IPFrom(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullIP().Unwrap()
+	tests.AssertEqual(t, value == nil, true)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.IP
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value == nil, true)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseIP(t *testing.T) {
+	parsed, err := nullable.ParseIP("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullIP())
+
+	text, err := nullable.IPFrom(net.ParseIP("192.168.1.10")).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseIP(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.IPFrom(net.ParseIP("192.168.1.10"))), true)
+
+	for _, text := range []string{"abc"} {
+		if _, err := nullable.ParseIP(text); err == nil {
+			t.Errorf("parsing %q as IP address must fail", text)
+		}
+	}
+}
+
+func TestMustParseIP(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseIP(""), nullable.NullIP())
+
+	text, err := nullable.IPFrom(net.ParseIP("192.168.1.10")).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseIP(string(text)).Equal(nullable.IPFrom(net.ParseIP("192.168.1.10"))), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseIP of malformed text must panic")
+		}
+	}()
+	nullable.MustParseIP("abc")
+}
+
+func TestPtrIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	tests.AssertEqual(t, nullable.IPPtr(nullable.NewIP(&basic)), basic)
+	tests.AssertEqual(t, nullable.IPPtr(nullable.NewIP(nil)), nil)
+	tests.AssertEqual(t, nullable.IPValue(nullable.NewIP(&basic)), basic)
+	tests.AssertEqual(t, nullable.IPValue(nullable.NewIP(nil)) == nil, true)
+}
+
+func TestSetValueIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	var nullableIP nullable.IP
+	nullableIP.SetValue(basic)
+	tests.AssertEqual(t, nullableIP, nullable.IPFrom(basic))
+
+	nullableIP.SetNull()
+	tests.AssertEqual(t, nullableIP, nullable.NullIP())
+}
+
+func TestZeroAsNullIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	var zero net.IP = nil
+	tests.AssertEqual(t, nullable.IPZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.IPZeroAsNull(basic), nullable.IPFrom(basic))
+
+	nullableIP := nullable.IPFrom(zero)
+	nullableIP.NullIfZero()
+	tests.AssertEqual(t, nullableIP, nullable.NullIP())
+
+	nullableIP = nullable.IPFrom(basic)
+	nullableIP.NullIfZero()
+	tests.AssertEqual(t, nullableIP, nullable.IPFrom(basic))
+}
+
+func TestIsNullIP(t *testing.T) {
+	var zero nullable.IP
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	nullableIP := nullable.NewIP(&basic)
+	tests.AssertEqual(t, nullableIP.IsNull(), false)
+	tests.AssertEqual(t, nullableIP.IsValid(), true)
+
+	nullableIP.Set(nil)
+	tests.AssertEqual(t, nullableIP.IsNull(), true)
+	tests.AssertEqual(t, nullableIP.IsValid(), false)
+}
+
+func TestGetOrIP(t *testing.T) {
+	var zero nullable.IP
+	var fallback net.IP = net.ParseIP("192.168.1.10")
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero() == nil, true)
+
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	nullableIP := nullable.NewIP(&basic)
+	tests.AssertEqual(t, nullableIP.GetOr(nil), basic)
+	tests.AssertEqual(t, nullableIP.GetOrZero(), basic)
+}
+
+func TestMustGetIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	nullableIP := nullable.NewIP(&basic)
+	tests.AssertEqual(t, nullableIP.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL IP")
+	}()
+	nullableIP.Set(nil)
+	nullableIP.MustGet()
+	t.Error("MustGet on NULL IP must panic")
+}
+
+func TestTextIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	marshalUnmarshalText(t, nullable.NewIP(&basic))
+
+	marshalUnmarshalText(t, nullable.NewIP(nil))
+}
+
+func TestCSVIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	marshalUnmarshalCSV(t, nullable.NewIP(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewIP(nil))
+}
+
+func TestYAMLIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	marshalUnmarshalYAML(t, nullable.NewIP(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewIP(nil))
+}
+
+func TestMsgpackIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	marshalUnmarshalMsgpack(t, nullable.NewIP(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewIP(nil))
+}
+
+func TestBSONIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	marshalUnmarshalBSON(t, nullable.NewIP(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewIP(nil))
+}
+
+func TestGobIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	marshalUnmarshalGob(t, nullable.NewIP(&basic))
+
+	var zero net.IP = nil
+	marshalUnmarshalGob(t, nullable.NewIP(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewIP(nil))
+}
+
+func TestStringerIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	nullableIP := nullable.NewIP(&basic)
+	tests.AssertEqual(t, nullableIP.String(), "192.168.1.10")
+	tests.AssertEqual(t, fmt.Sprint(nullableIP), "192.168.1.10")
+
+	nullableIP.Set(nil)
+	tests.AssertEqual(t, nullableIP.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableIP), "<null>")
+}
+
+func TestChangedIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	value, null := nullable.NewIP(&basic), nullable.NewIP(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.IPFrom(nil)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewIP(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewIP(nil)), false)
+}
+
+func TestEqualIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	var zero net.IP = nil
+	tests.AssertEqual(t, nullable.NewIP(nil).Equal(nullable.NewIP(nil)), true)
+	tests.AssertEqual(t, nullable.NewIP(nil).Equal(nullable.NewIP(&basic)), false)
+	tests.AssertEqual(t, nullable.NewIP(&basic).Equal(nullable.NewIP(nil)), false)
+	tests.AssertEqual(t, nullable.NewIP(&basic).Equal(nullable.NewIP(&basic)), true)
+	tests.AssertEqual(t, nullable.NewIP(&basic).Equal(nullable.NewIP(&zero)), false)
+	tests.AssertEqual(t, nullable.NewIP(&zero).Equal(nullable.NewIP(nil)), false)
+
+	// IPv4 in 4 bytes form is the same address as in 16 bytes form
+	packed := net.IPv4(192, 168, 1, 10).To4()
+	tests.AssertEqual(t, nullable.NewIP(&packed).Equal(nullable.NewIP(&basic)), true)
+}
+
+func TestXMLIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	marshalUnmarshalXML(t, nullable.NewIP(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewIP(nil))
+}
+
+func TestJSONIP(t *testing.T) {
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	marshalUnmarshalJSON(t, nullable.NewIP(&basic))
+
+	marshalUnmarshalJSON(t, nullable.NewIP(nil))
+
+	serialized, err := nullable.NewIP(&basic).MarshalJSON()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `"192.168.1.10"`)
+
+	var unserialized nullable.IP
+	tests.AssertEqual(t, unserialized.UnmarshalJSON([]byte(`"2001:db8::68"`)), nil)
+	tests.AssertEqual(t, unserialized.Get(), net.ParseIP("2001:db8::68"))
+
+	for _, invalid := range []string{`"256.0.0.1"`, `"localhost"`, `""`, `42`} {
+		if err := unserialized.UnmarshalJSON([]byte(invalid)); err == nil {
+			t.Errorf("unmarshalling %s into IP must fail", invalid)
+		}
+	}
+}
+
+func TestIP(t *testing.T) {
+	type TestNullableIP struct {
+		ID      uint
+		Name    string
+		Address nullable.IP
+	}
+
+	DB.Migrator().DropTable(&TestNullableIP{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableIP{}); err != nil {
+		t.Errorf("failed to migrate nullable IP address, got error: %v", err)
+	}
+
+	var basic net.IP = net.ParseIP("192.168.1.10")
+	present := TestNullableIP{
+		Name:    "present",
+		Address: nullable.NewIP(&basic),
+	}
+	DB.Create(&present)
+
+	missing := TestNullableIP{
+		Name:    "missing",
+		Address: nullable.NewIP(nil),
+	}
+	DB.Create(&missing)
+
+	var result1 TestNullableIP
+	if err := DB.First(&result1, "name = ?", "present").Error; err != nil {
+		t.Fatal("Cannot read IP address test record of \"present\"")
+	}
+	tests.AssertEqual(t, result1, present)
+
+	var result2 TestNullableIP
+	if err := DB.First(&result2, "name = ?", "missing").Error; err != nil {
+		t.Fatal("Cannot read IP address test record of \"missing\"")
+	}
+	tests.AssertEqual(t, result2, missing)
+}
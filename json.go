@@ -0,0 +1,468 @@
+package nullable
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"log/slog"
+	"slices"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// JSON SQL type that can retrieve NULL value
+type JSON struct {
+	core[json.RawMessage]
+}
+
+// NewJSON creates a new nullable raw JSON
+func NewJSON(value *json.RawMessage) JSON {
+	return JSON{core: newCore(value)}
+}
+
+// JSONFrom creates a new valid nullable raw JSON from value
+func JSONFrom(value json.RawMessage) JSON {
+	return NewJSON(&value)
+}
+
+// NullJSON creates a new NULL raw JSON
+func NullJSON() JSON {
+	return NewJSON(nil)
+}
+
+// JSONZeroAsNull creates a new nullable raw JSON that is NULL when value is the zero value
+func JSONZeroAsNull(value json.RawMessage) JSON {
+	if len(value) == 0 {
+		return NullJSON()
+	}
+	return JSONFrom(value)
+}
+
+// CoalesceJSON returns the first valid value, or NULL when all of them are NULL
+func CoalesceJSON(values ...JSON) JSON {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return JSON{}
+}
+
+// JSONPtr returns n.Get(), handy where a function value is needed
+func JSONPtr(n JSON) *json.RawMessage {
+	return n.Get()
+}
+
+// JSONValue returns n.GetOrZero(), zero value when NULL
+func JSONValue(n JSON) json.RawMessage {
+	return n.GetOrZero()
+}
+
+// ParseJSON parses text like UnmarshalText does, empty text is NULL
+func ParseJSON(text string) (JSON, error) {
+	var n JSON
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return JSON{}, err
+	}
+	return n, nil
+}
+
+// MustParseJSON is ParseJSON that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseJSON(text string) JSON {
+	n, err := ParseJSON(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseJSON(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL JSON, the one a declared
+// JSON starts with, letting go of the held raw JSON so pooled values can be
+// reused
+func (n *JSON) Reset() {
+	*n = JSON{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *JSON) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *JSON) NullIfZero() {
+	if n.isValid && len(n.realValue) == 0 {
+		n.SetNull()
+	}
+}
+
+// MustGet either raw JSON or panic when NULL
+func (n JSON) MustGet() json.RawMessage {
+	return n.mustGet("JSON")
+}
+
+// Clone returns a copy of the value that shares no memory with n
+func (n JSON) Clone() JSON {
+	n.realValue = slices.Clone(n.realValue)
+	return n
+}
+
+// String returns raw JSON in its natural text form, or "<null>" when NULL
+func (n JSON) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return string(n.realValue)
+}
+
+// DebugString returns JSON(value) or JSON(NULL), telling NULL apart from a
+// zero value in logs
+func (n JSON) DebugString() string {
+	return debugString("JSON", n.isValid, n.String())
+}
+
+// LogValue implements slog.LogValuer, logging NULL as nil and the value as
+// the raw JSON text
+func (n JSON) LogValue() slog.Value {
+	return logText(n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same raw JSON
+func (n JSON) Equal(other JSON) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return bytes.Equal(n.realValue, other.realValue)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n JSON) Changed(old JSON) bool {
+	return !n.Equal(old)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL the raw bytes as held, telling NULL apart from zero in cache keys
+func (n JSON) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagJSON, n.isValid, n.realValue)
+}
+
+// MarshalJSON converts current value to JSON
+func (n JSON) MarshalJSON() ([]byte, error) {
+	if !n.isValid || n.realValue == nil {
+		return []byte("null"), nil
+	}
+	// Stored value is already JSON, no need to encode it again
+	return n.realValue, nil
+}
+
+// UnmarshalJSON writes JSON to this type
+func (n *JSON) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	if !json.Valid(data) {
+		return ErrMalformedJSON
+	}
+
+	// data may be reused by the decoder, keep our own copy
+	n.isValid = true
+	n.realValue = cloneBytes(data)
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n JSON) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *JSON) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	if !json.Valid(text) {
+		return ErrMalformedJSON
+	}
+	parsed := json.RawMessage(cloneBytes(text))
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n JSON) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *JSON) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n JSON) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return copyText(string(n.realValue)), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n JSON) MarshalYAML() (interface{}, error) {
+	if !n.isValid || n.realValue == nil {
+		return nil, nil
+	}
+	// Decode first so the JSON document is written as YAML structure
+	var decoded interface{}
+	if err := json.Unmarshal(n.realValue, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *JSON) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	var decoded interface{}
+	if err := value.Decode(&decoded); err != nil {
+		return err
+	}
+	parsed, err := json.Marshal(decoded)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n JSON) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(string(n.realValue), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *JSON) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	if !json.Valid([]byte(text)) {
+		return ErrMalformedJSON
+	}
+	parsed := json.RawMessage(text)
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n JSON) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(string(n.realValue))
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *JSON) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	text, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+	parsed := json.RawMessage(text)
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n JSON) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(string(n.realValue))
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *JSON) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	if t != bson.TypeString {
+		return bsonTypeError(t, "JSON")
+	}
+	text, _, ok := bsoncore.ReadString(data)
+	if !ok {
+		return bsonTypeError(t, "JSON")
+	}
+	parsed := json.RawMessage(text)
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n JSON) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *JSON) GobDecode(data []byte) error {
+	var parsed json.RawMessage
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = nil
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// Scan implements scanner interface
+func (n *JSON) Scan(value interface{}) error {
+	return scanError(n.ScanContext(context.Background(), value))
+}
+
+// ScanContext is Scan that gives up with ctx.Err(), leaving the value
+// unchanged, when ctx is done before parsing starts
+func (n *JSON) ScanContext(ctx context.Context, value interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	value = scanValue(value)
+	if value == nil {
+		n.realValue, n.isValid = nil, false
+		return nil
+	}
+
+	var scanned []byte
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
+	}
+	if !json.Valid(scanned) {
+		return scanError(fmt.Errorf("nullable: scanned value %q is not valid JSON", scanned))
+	}
+	n.realValue = scanned
+
+	n.isValid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface. The raw JSON is sent as a
+// string rather than []byte on purpose: MySQL refuses a binary string in a
+// JSON column ("Cannot create a JSON value from a string with CHARACTER SET
+// 'binary'"), SQLite stores []byte as a BLOB, and drivers may bind it as
+// bytea. Slice and MapOf send their JSON as string for the same reason.
+func (n JSON) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return string(n.realValue), nil
+}
+
+// GormDataType gorm common data type
+func (JSON) GormDataType() string {
+	return "json_null"
+}
+
+// GormDBDataType gorm db data type
+func (n JSON) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n JSON) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (JSON) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
+	case "sqlite", "mysql":
+		return "JSON"
+	case "postgres", "cockroachdb":
+		return "jsonb"
+	case "sqlserver":
+		return "NVARCHAR(MAX)"
+	case "clickhouse":
+		return "Nullable(String)"
+	}
+	return ""
+}
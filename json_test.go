@@ -1,13 +1,19 @@
 package nullable_test
 
 import (
+	"context"
+	"database/sql"
 	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/tee8z/nullable"
 	"gorm.io/gorm/utils/tests"
 )
 
+// jsonSink keeps benchmarks from optimizing the marshaling away
+var jsonSink []byte
+
 func marshalUnmarshalJSON(t *testing.T, target interface{}) {
 	serialized, err := json.Marshal(target)
 	if err != nil {
@@ -135,7 +141,497 @@ func marshalUnmarshalJSON(t *testing.T, target interface{}) {
 			return
 		}
 		tests.AssertEqual(t, unserialized, target)
+	case nullable.UUID:
+		var unserialized nullable.UUID
+		if err := json.Unmarshal(serialized, &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+			return
+		}
+		tests.AssertEqual(t, unserialized, target)
+	case nullable.Decimal:
+		var unserialized nullable.Decimal
+		if err := json.Unmarshal(serialized, &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+			return
+		}
+		tests.AssertEqual(t, unserialized, target)
+	case nullable.Duration:
+		var unserialized nullable.Duration
+		if err := json.Unmarshal(serialized, &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+			return
+		}
+		tests.AssertEqual(t, unserialized, target)
+	case nullable.JSON:
+		var unserialized nullable.JSON
+		if err := json.Unmarshal(serialized, &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+			return
+		}
+		tests.AssertEqual(t, unserialized, target)
+	case nullable.Date:
+		var unserialized nullable.Date
+		if err := json.Unmarshal(serialized, &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+			return
+		}
+		tests.AssertEqual(t, unserialized, target)
+	case nullable.IP:
+		var unserialized nullable.IP
+		if err := json.Unmarshal(serialized, &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+			return
+		}
+		tests.AssertEqual(t, unserialized, target)
+	case nullable.URL:
+		var unserialized nullable.URL
+		if err := json.Unmarshal(serialized, &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+			return
+		}
+		tests.AssertEqual(t, unserialized, target)
+	case nullable.BigInt:
+		var unserialized nullable.BigInt
+		if err := json.Unmarshal(serialized, &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+			return
+		}
+		tests.AssertEqual(t, unserialized, target)
+	case nullable.DurationISO:
+		var unserialized nullable.DurationISO
+		if err := json.Unmarshal(serialized, &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+			return
+		}
+		tests.AssertEqual(t, unserialized, target)
 	default:
 		t.Fatalf("%T is not registered at json_test.go", target)
 	}
 }
+
+type jsonNullable interface {
+	json.Unmarshaler
+	IsNull() bool
+}
+
+func TestUnmarshalJSONNullToken(t *testing.T) {
+	targets := []jsonNullable{
+		&nullable.BigInt{}, &nullable.Bool{}, &nullable.Byte{}, &nullable.Bytes{}, &nullable.Date{},
+		&nullable.Decimal{}, &nullable.Duration{}, &nullable.Float32{}, &nullable.Float64{},
+		&nullable.Int{}, &nullable.Int8{}, &nullable.Int16{}, &nullable.Int32{}, &nullable.Int64{},
+		&nullable.IP{}, &nullable.JSON{}, &nullable.String{}, &nullable.Time{}, &nullable.Uint{},
+		&nullable.Uint8{}, &nullable.Uint16{}, &nullable.Uint32{}, &nullable.Uint64{}, &nullable.URL{},
+		&nullable.UUID{}, &nullable.Nullable[int]{}, &nullable.Slice[int]{}, &nullable.MapOf[string, int]{}, &nullable.Int64Array{},
+		&nullable.Uint64Array{}, &nullable.Money{}, &nullable.Rune{}, &nullable.DurationISO{}, &nullable.StringEmptyForNull{},
+		&nullable.Point{},
+	}
+	for _, target := range targets {
+		for _, data := range []string{"null", " null ", "\nnull\t"} {
+			if err := target.UnmarshalJSON([]byte(data)); err != nil {
+				t.Errorf("unmarshalling %q into %T failed: %v", data, target, err)
+			}
+			tests.AssertEqual(t, target.IsNull(), true)
+		}
+		for _, data := range []string{"", " ", "nul"} {
+			if err := target.UnmarshalJSON([]byte(data)); err == nil {
+				t.Errorf("unmarshalling %q into %T must fail", data, target)
+			}
+		}
+	}
+}
+
+func TestScanJSON(t *testing.T) {
+	nullableJSON := nullable.NewJSON(nil)
+
+	tests.AssertEqual(t, nullableJSON.Scan(`{"name":"cat","lives":9}`), nil)
+	tests.AssertEqual(t, string(*nullableJSON.Get()), `{"name":"cat","lives":9}`)
+
+	tests.AssertEqual(t, nullableJSON.Scan([]byte(`[1,2,3]`)), nil)
+	tests.AssertEqual(t, string(*nullableJSON.Get()), `[1,2,3]`)
+
+	if err := nullableJSON.Scan("{not json"); err == nil {
+		t.Error("scanning malformed JSON must fail")
+	}
+
+	tests.AssertEqual(t, nullableJSON.Scan(nil), nil)
+	tests.AssertEqual(t, nullableJSON.Get(), nil)
+}
+
+func TestScanContextJSON(t *testing.T) {
+	nullableJSON := nullable.NullJSON()
+	tests.AssertEqual(t, nullableJSON.ScanContext(context.Background(), `{"name":"cat"}`), nil)
+	tests.AssertEqual(t, nullableJSON, nullable.JSONFrom(json.RawMessage(`{"name":"cat"}`)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests.AssertEqual(t, nullableJSON.ScanContext(ctx, `[1,2,3]`), context.Canceled)
+	tests.AssertEqual(t, nullableJSON, nullable.JSONFrom(json.RawMessage(`{"name":"cat"}`)))
+
+	tests.AssertEqual(t, nullableJSON.ScanContext(context.Background(), nil), nil)
+	tests.AssertEqual(t, nullableJSON.IsNull(), true)
+}
+
+func TestScanRawBytesJSON(t *testing.T) {
+	nullableJSON := nullable.NewJSON(nil)
+
+	driverBuffer := sql.RawBytes(`{"name":"cat"}`)
+	tests.AssertEqual(t, nullableJSON.Scan(driverBuffer), nil)
+
+	// sql.RawBytes is only valid until the next row overwrites it
+	copy(driverBuffer, `{"name":"dog"}`)
+	tests.AssertEqual(t, nullableJSON.Get(), json.RawMessage(`{"name":"cat"}`))
+}
+
+func TestValueJSON(t *testing.T) {
+	value, err := nullable.JSONFrom(json.RawMessage(`{"theme":"dark"}`)).Value()
+	tests.AssertEqual(t, err, nil)
+	// A string rather than []byte, see JSON.Value
+	if text, ok := value.(string); !ok || text != `{"theme":"dark"}` {
+		t.Errorf("expected string %q, got %T %v", `{"theme":"dark"}`, value, value)
+	}
+
+	value, err = nullable.NullJSON().Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
+func TestNewJSON(t *testing.T) {
+	basicJSON := json.RawMessage(`{"name":"cat","lives":9}`)
+	nullableJSON1 := nullable.NewJSON(&basicJSON)
+	tests.AssertEqual(t, nullableJSON1.Get(), basicJSON)
+
+	nullableJSON2 := nullable.NewJSON(nil)
+	tests.AssertEqual(t, nullableJSON2.Get(), nil)
+}
+
+func TestSetJSON(t *testing.T) {
+	nullableJSON := nullable.NewJSON(nil)
+	tests.AssertEqual(t, nullableJSON.Get(), nil)
+
+	basicJSON := json.RawMessage(`{"name":"cat","lives":9}`)
+	nullableJSON.Set(&basicJSON)
+	tests.AssertEqual(t, nullableJSON.Get(), basicJSON)
+
+	nullableJSON.Set(nil)
+	tests.AssertEqual(t, nullableJSON.Get(), nil)
+}
+
+func TestJSONFrom(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	tests.AssertEqual(t, nullable.JSONFrom(basic), nullable.NewJSON(&basic))
+	tests.AssertEqual(t, nullable.JSONFrom(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullJSON(), nullable.NewJSON(nil))
+	tests.AssertEqual(t, nullable.NullJSON().IsNull(), true)
+}
+
+func TestCoalesceJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	var zero json.RawMessage = nil
+	tests.AssertEqual(t, nullable.CoalesceJSON(), nullable.JSON{})
+	tests.AssertEqual(t, nullable.CoalesceJSON(nullable.NullJSON(), nullable.NullJSON()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceJSON(nullable.NullJSON(), nullable.JSONFrom(basic), nullable.JSONFrom(zero)), nullable.JSONFrom(basic))
+	tests.AssertEqual(t, nullable.CoalesceJSON(nullable.JSONFrom(zero), nullable.JSONFrom(basic)), nullable.JSONFrom(zero))
+}
+
+func TestIsZeroJSON(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullJSON().IsZero(), true)
+	tests.AssertEqual(t, nullable.JSONFrom(nil).IsZero(), false)
+	tests.AssertEqual(t, nullable.JSONFrom(json.RawMessage(`{"name":"cat","lives":9}`)).IsZero(), false)
+}
+
+func TestCloneJSON(t *testing.T) {
+	original := nullable.JSONFrom(json.RawMessage(`{"name":"cat","lives":9}`))
+	clone := original.Clone()
+	tests.AssertEqual(t, clone, original)
+
+	clone.MustGet()[0]++
+	tests.AssertEqual(t, original, nullable.JSONFrom(json.RawMessage(`{"name":"cat","lives":9}`)))
+	tests.AssertEqual(t, clone.Equal(original), false)
+
+	tests.AssertEqual(t, nullable.NullJSON().Clone(), nullable.NullJSON())
+}
+
+func TestUnwrapJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	value, ok := nullable.JSONFrom(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullJSON().Unwrap()
+	tests.AssertEqual(t, value == nil, true)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.JSON
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value == nil, true)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseJSON(t *testing.T) {
+	parsed, err := nullable.ParseJSON("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullJSON())
+
+	text, err := nullable.JSONFrom(json.RawMessage(`{"name":"cat","lives":9}`)).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseJSON(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.JSONFrom(json.RawMessage(`{"name":"cat","lives":9}`))), true)
+
+	for _, text := range []string{"abc"} {
+		if _, err := nullable.ParseJSON(text); err == nil {
+			t.Errorf("parsing %q as raw JSON must fail", text)
+		}
+	}
+}
+
+func TestMustParseJSON(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseJSON(""), nullable.NullJSON())
+
+	text, err := nullable.JSONFrom(json.RawMessage(`{"name":"cat","lives":9}`)).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseJSON(string(text)).Equal(nullable.JSONFrom(json.RawMessage(`{"name":"cat","lives":9}`))), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseJSON of malformed text must panic")
+		}
+	}()
+	nullable.MustParseJSON("abc")
+}
+
+func TestPtrJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	tests.AssertEqual(t, nullable.JSONPtr(nullable.NewJSON(&basic)), basic)
+	tests.AssertEqual(t, nullable.JSONPtr(nullable.NewJSON(nil)), nil)
+	tests.AssertEqual(t, nullable.JSONValue(nullable.NewJSON(&basic)), basic)
+	tests.AssertEqual(t, nullable.JSONValue(nullable.NewJSON(nil)) == nil, true)
+}
+
+func TestSetValueJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	var nullableJSON nullable.JSON
+	nullableJSON.SetValue(basic)
+	tests.AssertEqual(t, nullableJSON, nullable.JSONFrom(basic))
+
+	nullableJSON.SetNull()
+	tests.AssertEqual(t, nullableJSON, nullable.NullJSON())
+}
+
+func TestZeroAsNullJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	var zero json.RawMessage = nil
+	tests.AssertEqual(t, nullable.JSONZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.JSONZeroAsNull(basic), nullable.JSONFrom(basic))
+
+	nullableJSON := nullable.JSONFrom(zero)
+	nullableJSON.NullIfZero()
+	tests.AssertEqual(t, nullableJSON, nullable.NullJSON())
+
+	nullableJSON = nullable.JSONFrom(basic)
+	nullableJSON.NullIfZero()
+	tests.AssertEqual(t, nullableJSON, nullable.JSONFrom(basic))
+}
+
+func TestIsNullJSON(t *testing.T) {
+	var zero nullable.JSON
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	nullableJSON := nullable.NewJSON(&basic)
+	tests.AssertEqual(t, nullableJSON.IsNull(), false)
+	tests.AssertEqual(t, nullableJSON.IsValid(), true)
+
+	nullableJSON.Set(nil)
+	tests.AssertEqual(t, nullableJSON.IsNull(), true)
+	tests.AssertEqual(t, nullableJSON.IsValid(), false)
+}
+
+func TestGetOrJSON(t *testing.T) {
+	var zero nullable.JSON
+	var fallback json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero() == nil, true)
+
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	nullableJSON := nullable.NewJSON(&basic)
+	tests.AssertEqual(t, nullableJSON.GetOr(nil), basic)
+	tests.AssertEqual(t, nullableJSON.GetOrZero(), basic)
+}
+
+func TestMustGetJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	nullableJSON := nullable.NewJSON(&basic)
+	tests.AssertEqual(t, nullableJSON.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL JSON")
+	}()
+	nullableJSON.Set(nil)
+	nullableJSON.MustGet()
+	t.Error("MustGet on NULL JSON must panic")
+}
+
+func TestJSONJSON(t *testing.T) {
+	basicJSON := json.RawMessage(`{"name":"cat","lives":9}`)
+	marshalUnmarshalJSON(t, nullable.NewJSON(&basicJSON))
+
+	marshalUnmarshalJSON(t, nullable.NewJSON(nil))
+
+	type Document struct {
+		Payload nullable.JSON `json:"payload"`
+	}
+
+	// Stored value must be written verbatim, not re-encoded
+	serialized, err := json.Marshal(Document{Payload: nullable.NewJSON(&basicJSON)})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `{"payload":{"name":"cat","lives":9}}`)
+
+	var unserialized Document
+	tests.AssertEqual(t, json.Unmarshal([]byte(`{"payload":[1, 2, 3]}`), &unserialized), nil)
+	tests.AssertEqual(t, string(*unserialized.Payload.Get()), `[1, 2, 3]`)
+
+	if err := unserialized.Payload.UnmarshalJSON([]byte("{not json")); err == nil {
+		t.Error("unmarshalling malformed JSON must fail")
+	}
+}
+
+func TestTextJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	marshalUnmarshalText(t, nullable.NewJSON(&basic))
+
+	marshalUnmarshalText(t, nullable.NewJSON(nil))
+}
+
+func TestCSVJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	marshalUnmarshalCSV(t, nullable.NewJSON(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewJSON(nil))
+}
+
+func TestYAMLJSON(t *testing.T) {
+	// YAML mapping doesn't keep key order, keys are written sorted
+	var basic json.RawMessage = json.RawMessage(`{"lives":9,"name":"cat"}`)
+	marshalUnmarshalYAML(t, nullable.NewJSON(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewJSON(nil))
+}
+
+func TestMsgpackJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	marshalUnmarshalMsgpack(t, nullable.NewJSON(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewJSON(nil))
+}
+
+func TestBSONJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	marshalUnmarshalBSON(t, nullable.NewJSON(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewJSON(nil))
+}
+
+func TestGobJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	marshalUnmarshalGob(t, nullable.NewJSON(&basic))
+
+	var zero json.RawMessage = nil
+	marshalUnmarshalGob(t, nullable.NewJSON(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewJSON(nil))
+}
+
+func TestStringerJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	nullableJSON := nullable.NewJSON(&basic)
+	tests.AssertEqual(t, nullableJSON.String(), "{\"name\":\"cat\",\"lives\":9}")
+	tests.AssertEqual(t, fmt.Sprint(nullableJSON), "{\"name\":\"cat\",\"lives\":9}")
+
+	nullableJSON.Set(nil)
+	tests.AssertEqual(t, nullableJSON.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableJSON), "<null>")
+}
+
+func TestChangedJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	value, null := nullable.NewJSON(&basic), nullable.NewJSON(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.JSONFrom(nil)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewJSON(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewJSON(nil)), false)
+}
+
+func TestEqualJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	var zero json.RawMessage = nil
+	tests.AssertEqual(t, nullable.NewJSON(nil).Equal(nullable.NewJSON(nil)), true)
+	tests.AssertEqual(t, nullable.NewJSON(nil).Equal(nullable.NewJSON(&basic)), false)
+	tests.AssertEqual(t, nullable.NewJSON(&basic).Equal(nullable.NewJSON(nil)), false)
+	tests.AssertEqual(t, nullable.NewJSON(&basic).Equal(nullable.NewJSON(&basic)), true)
+	tests.AssertEqual(t, nullable.NewJSON(&basic).Equal(nullable.NewJSON(&zero)), false)
+	tests.AssertEqual(t, nullable.NewJSON(&zero).Equal(nullable.NewJSON(nil)), false)
+}
+
+func TestXMLJSON(t *testing.T) {
+	var basic json.RawMessage = json.RawMessage(`{"name":"cat","lives":9}`)
+	marshalUnmarshalXML(t, nullable.NewJSON(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewJSON(nil))
+}
+
+func TestJSON(t *testing.T) {
+	type TestNullableJSON struct {
+		ID       uint
+		Name     string
+		Settings nullable.JSON
+	}
+
+	DB.Migrator().DropTable(&TestNullableJSON{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableJSON{}); err != nil {
+		t.Errorf("failed to migrate nullable json, got error: %v", err)
+	}
+
+	settings := json.RawMessage(`{"theme":"dark"}`)
+	configured := TestNullableJSON{
+		Name:     "configured",
+		Settings: nullable.NewJSON(&settings),
+	}
+	DB.Create(&configured)
+
+	fresh := TestNullableJSON{
+		Name:     "fresh",
+		Settings: nullable.NewJSON(nil),
+	}
+	DB.Create(&fresh)
+
+	var result1 TestNullableJSON
+	if err := DB.First(&result1, "name = ?", "configured").Error; err != nil {
+		t.Fatal("Cannot read json test record of \"configured\"")
+	}
+	var decoded map[string]string
+	tests.AssertEqual(t, json.Unmarshal(*result1.Settings.Get(), &decoded), nil)
+	tests.AssertEqual(t, decoded["theme"], "dark")
+
+	var result2 TestNullableJSON
+	if err := DB.First(&result2, "name = ?", "fresh").Error; err != nil {
+		t.Fatal("Cannot read json test record of \"fresh\"")
+	}
+	tests.AssertEqual(t, result2, fresh)
+
+	// Sent as string, the column holds JSON text the database can query
+	var stored string
+	switch DB.Dialector.Name() {
+	case "sqlite":
+		DB.Model(&TestNullableJSON{}).Select("typeof(settings) || ':' || json_extract(settings, '$.theme')").Where("name = ?", "configured").Scan(&stored)
+		tests.AssertEqual(t, stored, "text:dark")
+	case "mysql":
+		DB.Model(&TestNullableJSON{}).Select("settings->>'$.theme'").Where("name = ?", "configured").Scan(&stored)
+		tests.AssertEqual(t, stored, "dark")
+	case "postgres":
+		DB.Model(&TestNullableJSON{}).Select("settings->>'theme'").Where("name = ?", "configured").Scan(&stored)
+		tests.AssertEqual(t, stored, "dark")
+	}
+}
@@ -0,0 +1,255 @@
+//go:build goexperiment.jsonv2 && go1.27
+
+package nullable
+
+import "encoding/json/jsontext"
+
+// The MarshalJSONTo methods let encoding/json/v2 write straight into its
+// output stream. Numbers, booleans and strings are written as tokens without
+// an intermediate byte slice, the other types hand over what MarshalJSON
+// returns.
+
+// marshalJSONTo writes the JSON given by marshal, or null when not valid
+func marshalJSONTo(enc *jsontext.Encoder, isValid bool, marshal func() ([]byte, error)) error {
+	if !isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	data, err := marshal()
+	if err != nil {
+		return err
+	}
+	return enc.WriteValue(data)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Bool) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Bool(n.realValue))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Byte) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Bytes) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Float32) MarshalJSONTo(enc *jsontext.Encoder) error {
+	// A float64 token would print the float32 with float64 precision
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Float64) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	// jsontext.Float would write NaN and infinities as strings
+	if err := checkFinite(n.realValue); err != nil {
+		return err
+	}
+	return enc.WriteToken(jsontext.Float(n.realValue))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Int) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Int(int64(n.realValue)))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Int8) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Int(int64(n.realValue)))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Int16) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Int(int64(n.realValue)))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Int32) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Int(int64(n.realValue)))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Int64) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Int(n.realValue))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n String) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.String(n.realValue))
+}
+
+// MarshalJSONTo writes current value to enc, NULL is "" rather than null
+func (n StringEmptyForNull) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return enc.WriteToken(jsontext.String(n.realValue))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Time) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Uint) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Uint(uint64(n.realValue)))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Uint8) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Uint(uint64(n.realValue)))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Uint16) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Uint(uint64(n.realValue)))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Uint32) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Uint(uint64(n.realValue)))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Uint64) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.Uint(n.realValue))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n UUID) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Decimal) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Duration) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n DurationISO) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return enc.WriteToken(jsontext.String(formatISODuration(n.realValue)))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n JSON) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Date) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n IP) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n URL) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n BigInt) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Nullable[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Slice[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n MapOf[K, V]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Int64Array) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Uint64Array) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Point) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Money) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Rune) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !n.isValid {
+		return enc.WriteToken(jsontext.Null)
+	}
+	if err := checkRune(n.realValue); err != nil {
+		return err
+	}
+	return enc.WriteToken(jsontext.String(string(n.realValue)))
+}
+
+// MarshalJSONTo writes current value to enc
+func (n Enum[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	return marshalJSONTo(enc, n.isValid, n.MarshalJSON)
+}
@@ -0,0 +1,87 @@
+//go:build goexperiment.jsonv2 && go1.27
+
+package nullable_test
+
+import (
+	"encoding/json"
+	jsonv2 "encoding/json/v2"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestMarshalJSONTo(t *testing.T) {
+	values := []interface{}{
+		nullable.BoolFrom(true), nullable.NullBool(),
+		nullable.Int8From(-100), nullable.Int64From(math.MinInt64), nullable.NullInt64(),
+		nullable.Uint64From(math.MaxUint64), nullable.NullUint64(),
+		nullable.Float64From(3.14159265359), nullable.Float32From(3.14), nullable.Float32From(0.1), nullable.NullFloat64(),
+		nullable.StringFrom(`Hello "World"!`), nullable.NullString(),
+		nullable.StringEmptyForNullFrom("a"), nullable.NullStringEmptyForNull(),
+		nullable.TimeFrom(time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)), nullable.NullTime(),
+		nullable.DurationFrom(90 * time.Minute), nullable.DurationISOFrom(90 * time.Minute), nullable.NullDurationISO(),
+		nullable.BigIntFromInt64(42),
+		nullable.SliceFrom([]int{1, 2}), nullable.NullableFrom("cat"),
+		nullable.MapOfFrom(map[string]int{"b": 2, "a": 1}), nullable.NullMapOf[string, int](),
+		nullable.Int64ArrayFrom([]int64{-1, 2}), nullable.Uint64ArrayFrom([]uint64{math.MaxUint64}),
+		nullable.MustParseMoney("12.34 USD"), nullable.NullMoney(),
+		nullable.PointFrom(-0.1275, 51.507222), nullable.NullPoint(),
+		nullable.RuneFrom('A'), nullable.RuneFrom('"'), nullable.NullRune(),
+	}
+	for _, value := range values {
+		expected, err := json.Marshal(value)
+		tests.AssertEqual(t, err, nil)
+
+		serialized, err := jsonv2.Marshal(value)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(serialized), string(expected))
+	}
+
+	// encoding/json keeps escaping HTML, going through MarshalJSONTo or not
+	serialized, err := json.Marshal(nullable.StringFrom("<a & b>"))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `"\u003ca \u0026 b\u003e"`)
+
+	serialized, err = jsonv2.Marshal(nullable.StringFrom("<a & b>"))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `"<a & b>"`)
+
+	// Not written as the "NaN" string jsontext would fall back to
+	if _, err := jsonv2.Marshal(nullable.Float64From(math.NaN())); err == nil {
+		t.Error("marshalling NaN must fail")
+	}
+}
+
+// classicUint64 hides MarshalJSONTo, leaving encoding/json/v2 the
+// MarshalJSON path to compare against
+type classicUint64 struct {
+	value nullable.Uint64
+}
+
+func (c classicUint64) MarshalJSON() ([]byte, error) {
+	return c.value.MarshalJSON()
+}
+
+func BenchmarkMarshalJSONToUint64(b *testing.B) {
+	streamed := make([]nullable.Uint64, 100)
+	classic := make([]classicUint64, 100)
+	for i := range streamed {
+		streamed[i] = nullable.Uint64From(math.MaxUint64 - uint64(i))
+		classic[i] = classicUint64{streamed[i]}
+	}
+	b.Run("MarshalJSONTo", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			jsonSink, _ = jsonv2.Marshal(streamed)
+		}
+	})
+	b.Run("MarshalJSON", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			jsonSink, _ = jsonv2.Marshal(classic)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package nullable_test
+
+import (
+	"bytes"
+	"log/slog"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestLogValue(t *testing.T) {
+	instant := time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	cases := []struct {
+		value    slog.LogValuer
+		expected slog.Value
+	}{
+		{nullable.BoolFrom(false), slog.BoolValue(false)},
+		{nullable.Int8From(-100), slog.Int64Value(-100)},
+		{nullable.Int64From(math.MinInt64), slog.Int64Value(math.MinInt64)},
+		{nullable.Uint64From(math.MaxUint64), slog.Uint64Value(math.MaxUint64)},
+		{nullable.ByteFrom(0x7f), slog.Uint64Value(0x7f)},
+		{nullable.Float32From(3.14), slog.Float64Value(3.14)},
+		{nullable.Float64From(0), slog.Float64Value(0)},
+		{nullable.StringFrom(""), slog.StringValue("")},
+		{nullable.StringTrimmedFrom("abc"), slog.StringValue("abc")},
+		{nullable.TimeFrom(instant), slog.TimeValue(instant)},
+		{nullable.DurationFrom(90 * time.Minute), slog.DurationValue(90 * time.Minute)},
+		{nullable.DurationISOFrom(90 * time.Minute), slog.StringValue("PT1H30M")},
+		{nullable.RuneFrom('A'), slog.StringValue("A")},
+		{nullable.DecimalFrom(decimal.RequireFromString("0.10")), slog.StringValue("0.10")},
+		{nullable.IPFrom(net.ParseIP("192.168.1.10")), slog.StringValue("192.168.1.10")},
+		{nullable.UUIDFrom(uuid.Nil), slog.StringValue("00000000-0000-0000-0000-000000000000")},
+		{nullable.MustParseMoney("12.34 USD"), slog.StringValue("12.34 USD")},
+		{nullable.NullInt64(), slog.AnyValue(nil)},
+		{nullable.NullUint64(), slog.AnyValue(nil)},
+		{nullable.NullString(), slog.AnyValue(nil)},
+		{nullable.NullTime(), slog.AnyValue(nil)},
+		{nullable.NullSlice[int](), slog.AnyValue(nil)},
+		{nullable.NullMoney(), slog.AnyValue(nil)},
+	}
+	for _, c := range cases {
+		value := c.value.LogValue()
+		tests.AssertEqual(t, value.Kind(), c.expected.Kind())
+		if !value.Equal(c.expected) {
+			t.Errorf("LogValue of %v: expected %v, got %v", c.value, c.expected, value)
+		}
+	}
+
+	var counter nullable.AtomicUint64
+	tests.AssertEqual(t, counter.LogValue().Any(), nil)
+	counter.Store(nullable.Uint64From(3))
+	tests.AssertEqual(t, counter.LogValue().Uint64(), uint64(3))
+}
+
+func TestLogValueHandler(t *testing.T) {
+	var out bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&out, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, attr slog.Attr) slog.Attr {
+			if attr.Key == slog.TimeKey {
+				return slog.Attr{}
+			}
+			return attr
+		},
+	}))
+
+	logger.Info("order", "id", nullable.Uint64From(42), "note", nullable.NullString(), "paid", nullable.BoolFrom(true))
+	tests.AssertEqual(t, out.String(), `{"level":"INFO","msg":"order","id":42,"note":null,"paid":true}`+"\n")
+
+	out.Reset()
+	logger.Info("order", slog.Any("total", nullable.Float64From(12.5)), slog.Any("discount", nullable.NullFloat64()))
+	tests.AssertEqual(t, out.String(), `{"level":"INFO","msg":"order","total":12.5,"discount":null}`+"\n")
+}
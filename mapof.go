@@ -0,0 +1,469 @@
+package nullable
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// MapOf SQL type that can retrieve NULL value of a map, stored as JSON
+// object. It is named MapOf since Map maps a Nullable. NULL and an empty map
+// are told apart: a valid map is never nil. Scan also reads the text form of
+// PostgreSQL hstore, and HstoreValue writes it.
+type MapOf[K comparable, V any] struct {
+	core[map[K]V]
+}
+
+// NewMapOf creates a new nullable map
+func NewMapOf[K comparable, V any](value *map[K]V) MapOf[K, V] {
+	if value == nil {
+		return MapOf[K, V]{}
+	}
+	return MapOfFrom(*value)
+}
+
+// MapOfFrom creates a new valid nullable map from value, nil is an empty map
+func MapOfFrom[K comparable, V any](value map[K]V) MapOf[K, V] {
+	if value == nil {
+		value = map[K]V{}
+	}
+	return MapOf[K, V]{core: newCore(&value)}
+}
+
+// NullMapOf creates a new NULL map
+func NullMapOf[K comparable, V any]() MapOf[K, V] {
+	return NewMapOf[K, V](nil)
+}
+
+// Set either nil or map
+func (n *MapOf[K, V]) Set(value *map[K]V) {
+	*n = NewMapOf(value)
+}
+
+// Swap sets either nil or map like Set does and returns the previous value,
+// nil when it was NULL
+func (n *MapOf[K, V]) Swap(value *map[K]V) *map[K]V {
+	previous := n.Get()
+	n.Set(value)
+	return previous
+}
+
+// SetValue sets map and marks it as not NULL
+func (n *MapOf[K, V]) SetValue(value map[K]V) {
+	*n = MapOfFrom(value)
+}
+
+// Reset sets the value back to the zero NULL MapOf, the one a declared
+// MapOf starts with, letting go of the held map so pooled values can be
+// reused
+func (n *MapOf[K, V]) Reset() {
+	*n = MapOf[K, V]{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *MapOf[K, V]) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// MustGet either map or panic when NULL
+func (n MapOf[K, V]) MustGet() map[K]V {
+	return n.mustGet(reflect.TypeOf(n).String())
+}
+
+// Clone returns a copy of the value whose map is not shared with n, the
+// values themselves are copied shallowly
+func (n MapOf[K, V]) Clone() MapOf[K, V] {
+	n.realValue = maps.Clone(n.realValue)
+	return n
+}
+
+// String returns map in its natural text form with sorted keys, or "<null>"
+// when NULL
+func (n MapOf[K, V]) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return fmt.Sprint(n.realValue)
+}
+
+// DebugString returns MapOf[K,V](value) or MapOf[K,V](NULL), telling NULL
+// apart from an empty map in logs
+func (n MapOf[K, V]) DebugString() string {
+	return debugString("MapOf["+reflect.TypeFor[K]().String()+","+reflect.TypeFor[V]().String()+"]", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold deeply equal map
+func (n MapOf[K, V]) Equal(other MapOf[K, V]) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return reflect.DeepEqual(n.realValue, other.realValue)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n MapOf[K, V]) Changed(old MapOf[K, V]) bool {
+	return !n.Equal(old)
+}
+
+// MarshalJSON converts current value to JSON, an object or null
+func (n MapOf[K, V]) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.realValue)
+}
+
+// UnmarshalJSON writes JSON to this type
+func (n *MapOf[K, V]) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed map[K]V
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n MapOf[K, V]) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *MapOf[K, V]) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed map[K]V
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n MapOf[K, V]) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *MapOf[K, V]) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed map[K]V
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from empty map
+func (n MapOf[K, V]) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *MapOf[K, V]) GobDecode(data []byte) error {
+	var parsed map[K]V
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.SetNull()
+		return nil
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// Scan implements scanner interface, reading a JSON object, or hstore text
+// such as "a"=>"1", "b"=>NULL. A JSON null in the column is NULL as well, and
+// an hstore NULL fails unless V is a pointer or another type holding nil.
+func (n *MapOf[K, V]) Scan(value interface{}) error {
+	return scanError(n.ScanContext(context.Background(), value))
+}
+
+// ScanContext is Scan that gives up with ctx.Err(), leaving the value
+// unchanged, when ctx is done before parsing starts
+func (n *MapOf[K, V]) ScanContext(ctx context.Context, value interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	value = scanValue(value)
+	if value == nil {
+		n.SetNull()
+		return nil
+	}
+
+	var scanned []byte
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
+	}
+	trimmed := strings.TrimSpace(string(scanned))
+	if !strings.HasPrefix(trimmed, "{") && (trimmed == "" || strings.Contains(trimmed, "=>")) {
+		// hstore text, an empty one being empty text
+		pairs, err := parseHstore(trimmed)
+		if err != nil {
+			return scanError(err)
+		}
+		if err := n.checkHstoreNulls(pairs); err != nil {
+			return scanError(err)
+		}
+		if scanned, err = json.Marshal(pairs); err != nil {
+			return scanError(err)
+		}
+	}
+	return scanError(n.UnmarshalJSON(scanned))
+}
+
+// Value implements the driver Valuer interface, writing a JSON object
+func (n MapOf[K, V]) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	serialized, err := json.Marshal(n.realValue)
+	if err != nil {
+		return nil, err
+	}
+	// JSON columns expect text, so the object is sent as string
+	return string(serialized), nil
+}
+
+// checkHstoreNulls fails on a NULL hstore value when V has no nil to hold
+// it, rather than letting it turn into the zero value of V
+func (MapOf[K, V]) checkHstoreNulls(pairs map[string]*string) error {
+	switch reflect.TypeFor[V]().Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice:
+		return nil
+	}
+	for key, value := range pairs {
+		if value == nil {
+			return fmt.Errorf("nullable: hstore key %q is NULL, which %s cannot hold", key, reflect.TypeFor[V]())
+		}
+	}
+	return nil
+}
+
+// HstoreValue is Value for a PostgreSQL hstore column, writing text such as
+// "a"=>"1", "b"=>NULL with sorted keys. Keys and values are written the way
+// fmt prints them, nil pointers are NULL.
+func (n MapOf[K, V]) HstoreValue() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+
+	pairs := make(map[string]*string, len(n.realValue))
+	for key, value := range n.realValue {
+		keyText, isNull := hstoreText(reflect.ValueOf(key))
+		if isNull {
+			return nil, errors.New("nullable: hstore keys cannot be NULL")
+		}
+		valueText, isNull := hstoreText(reflect.ValueOf(value))
+		if _, ok := pairs[keyText]; ok {
+			return nil, fmt.Errorf("nullable: hstore key %q given twice", keyText)
+		}
+		if isNull {
+			pairs[keyText] = nil
+		} else {
+			pairs[keyText] = &valueText
+		}
+	}
+
+	var b strings.Builder
+	for i, key := range slices.Sorted(maps.Keys(pairs)) {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		b.WriteString(hstoreQuote(key) + "=>")
+		if pairs[key] == nil {
+			b.WriteString("NULL")
+		} else {
+			b.WriteString(hstoreQuote(*pairs[key]))
+		}
+	}
+	return b.String(), nil
+}
+
+// GormDataType gorm common data type
+func (MapOf[K, V]) GormDataType() string {
+	return "map_null"
+}
+
+// GormDBDataType gorm db data type
+func (n MapOf[K, V]) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n MapOf[K, V]) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (MapOf[K, V]) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
+	case "sqlite", "mysql":
+		return "JSON"
+	case "postgres", "cockroachdb":
+		return "jsonb"
+	case "sqlserver":
+		return "NVARCHAR(MAX)"
+	case "clickhouse":
+		return "Nullable(String)"
+	}
+	return ""
+}
+
+// hstoreText returns the text of value, and true when it is a nil pointer or
+// interface
+func hstoreText(value reflect.Value) (string, bool) {
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return "", true
+		}
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return "", true
+	}
+	return fmt.Sprint(value.Interface()), false
+}
+
+// hstoreQuote quotes text for hstore, escaping quotes and backslashes
+func hstoreQuote(text string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(text) + `"`
+}
+
+// parseHstore parses hstore text into its pairs, a nil value for NULL
+func parseHstore(text string) (map[string]*string, error) {
+	pairs := map[string]*string{}
+	rest := strings.TrimSpace(text)
+	for rest != "" {
+		key, isNull, remaining, err := hstoreToken(rest)
+		if err != nil {
+			return nil, err
+		}
+		if isNull {
+			return nil, fmt.Errorf("nullable: hstore keys cannot be NULL in %q", text)
+		}
+		remaining = strings.TrimSpace(remaining)
+		if !strings.HasPrefix(remaining, "=>") {
+			return nil, fmt.Errorf("nullable: expected => after hstore key %q in %q", key, text)
+		}
+
+		value, isNull, remaining, err := hstoreToken(strings.TrimSpace(remaining[2:]))
+		if err != nil {
+			return nil, err
+		}
+		if isNull {
+			pairs[key] = nil
+		} else {
+			pairs[key] = &value
+		}
+
+		rest = strings.TrimSpace(remaining)
+		if rest == "" {
+			break
+		}
+		if rest[0] != ',' {
+			return nil, fmt.Errorf("nullable: expected , between hstore pairs in %q", text)
+		}
+		rest = strings.TrimSpace(rest[1:])
+		if rest == "" {
+			return nil, fmt.Errorf("nullable: hstore ends with , in %q", text)
+		}
+	}
+	return pairs, nil
+}
+
+// hstoreToken reads one quoted or bare key or value off the front of text,
+// reporting whether it is an unquoted NULL, and returns what follows it
+func hstoreToken(text string) (string, bool, string, error) {
+	if text == "" {
+		return "", false, "", errors.New("nullable: hstore key or value expected")
+	}
+	if text[0] != '"' {
+		end := strings.IndexAny(text, " \t\n\r,=")
+		if end < 0 {
+			end = len(text)
+		}
+		if end == 0 {
+			return "", false, "", fmt.Errorf("nullable: hstore key or value expected at %q", text)
+		}
+		token := text[:end]
+		return token, strings.EqualFold(token, "NULL"), text[end:], nil
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(text); i++ {
+		switch text[i] {
+		case '\\':
+			if i+1 == len(text) {
+				return "", false, "", errors.New("nullable: hstore text ends with \\")
+			}
+			i++
+			b.WriteByte(text[i])
+		case '"':
+			return b.String(), false, text[i+1:], nil
+		default:
+			b.WriteByte(text[i])
+		}
+	}
+	return "", false, "", errors.New("nullable: unterminated quote in hstore text")
+}
@@ -0,0 +1,232 @@
+package nullable_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanMapOf(t *testing.T) {
+	nullableMap := nullable.NullMapOf[string, string]()
+
+	tests.AssertEqual(t, nullableMap.Scan(`{"a":"1","b":"2"}`), nil)
+	tests.AssertEqual(t, nullableMap.MustGet(), map[string]string{"a": "1", "b": "2"})
+
+	tests.AssertEqual(t, nullableMap.Scan([]byte(`{}`)), nil)
+	tests.AssertEqual(t, nullableMap.IsValid(), true)
+	tests.AssertEqual(t, len(nullableMap.MustGet()), 0)
+
+	tests.AssertEqual(t, nullableMap.Scan([]byte(`null`)), nil)
+	tests.AssertEqual(t, nullableMap.IsNull(), true)
+
+	for _, malformed := range []interface{}{`{"a":`, `{"a":1}`, `["a"]`, 42} {
+		if err := nullableMap.Scan(malformed); err == nil {
+			t.Errorf("scanning %v must fail", malformed)
+		}
+	}
+
+	tests.AssertEqual(t, nullableMap.Scan(nil), nil)
+	tests.AssertEqual(t, nullableMap.Get(), nil)
+}
+
+func TestScanHstoreMapOf(t *testing.T) {
+	nullableMap := nullable.NullMapOf[string, *string]()
+
+	tests.AssertEqual(t, nullableMap.Scan(`"a"=>"1", "quote \"x\""=>"back\\slash", "gone"=>NULL`), nil)
+	scanned := nullableMap.MustGet()
+	tests.AssertEqual(t, len(scanned), 3)
+	tests.AssertEqual(t, *scanned["a"], "1")
+	tests.AssertEqual(t, *scanned[`quote "x"`], `back\slash`)
+	tests.AssertEqual(t, scanned["gone"] == nil, true)
+
+	tests.AssertEqual(t, nullableMap.Scan(`a=>b,c => "d e"`), nil)
+	tests.AssertEqual(t, *nullableMap.MustGet()["a"], "b")
+	tests.AssertEqual(t, *nullableMap.MustGet()["c"], "d e")
+
+	tests.AssertEqual(t, nullableMap.Scan(""), nil)
+	tests.AssertEqual(t, nullableMap.IsValid(), true)
+	tests.AssertEqual(t, len(nullableMap.MustGet()), 0)
+
+	// A string has no NULL, so the value is left unchanged
+	labels := nullable.MapOfFrom(map[string]string{"kept": "yes"})
+	if err := labels.Scan(`"a"=>"1", "b"=>NULL`); err == nil {
+		t.Error("scanning an hstore NULL into MapOf[string, string] must fail")
+	}
+	tests.AssertEqual(t, labels.MustGet(), map[string]string{"kept": "yes"})
+	tests.AssertEqual(t, labels.Scan(`"a"=>"1"`), nil)
+	tests.AssertEqual(t, labels.MustGet(), map[string]string{"a": "1"})
+
+	for _, malformed := range []string{`"a"=>`, `"a"=>"1",`, `"a" "1"`, `"a=>"1"`, `NULL=>"1"`, `"a"=>"1" "b"=>"2"`} {
+		if err := nullableMap.Scan(malformed); err == nil {
+			t.Errorf("scanning %q must fail", malformed)
+		}
+	}
+}
+
+func TestValueMapOf(t *testing.T) {
+	value, err := nullable.MapOfFrom(map[string]string{"b": "2", "a": "1"}).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, `{"a":"1","b":"2"}`)
+
+	value, err = nullable.MapOfFrom(map[string]string(nil)).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, "{}")
+
+	value, err = nullable.NullMapOf[string, string]().Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
+func TestHstoreValueMapOf(t *testing.T) {
+	unit := "kg"
+	value, err := nullable.MapOfFrom(map[string]*string{"unit": &unit, "note": nil, `say "hi"\`: &unit}).HstoreValue()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, `"note"=>NULL, "say \"hi\"\\"=>"kg", "unit"=>"kg"`)
+
+	// What HstoreValue writes, Scan reads back
+	var unserialized nullable.MapOf[string, *string]
+	tests.AssertEqual(t, unserialized.Scan(value), nil)
+	tests.AssertEqual(t, len(unserialized.MustGet()), 3)
+	tests.AssertEqual(t, *unserialized.MustGet()[`say "hi"\`], "kg")
+
+	value, err = nullable.MapOfFrom(map[int]int{2: 20, 1: 10}).HstoreValue()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, `"1"=>"10", "2"=>"20"`)
+
+	value, err = nullable.MapOfFrom(map[string]string{}).HstoreValue()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, "")
+
+	value, err = nullable.NullMapOf[string, string]().HstoreValue()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
+func TestNewMapOf(t *testing.T) {
+	basic := map[string]int{"a": 1}
+	tests.AssertEqual(t, nullable.NewMapOf(&basic).MustGet(), basic)
+	tests.AssertEqual(t, nullable.NewMapOf[string, int](nil).IsNull(), true)
+
+	// A valid map is never nil, unlike NULL
+	if nullable.MapOfFrom[string, int](nil).MustGet() == nil {
+		t.Error("MapOfFrom(nil) must hold an empty map")
+	}
+	tests.AssertEqual(t, nullable.MapOfFrom[string, int](nil).Equal(nullable.NullMapOf[string, int]()), false)
+	tests.AssertEqual(t, nullable.MapOfFrom(map[string]int{}).Equal(nullable.MapOfFrom[string, int](nil)), true)
+}
+
+func TestSetMapOf(t *testing.T) {
+	nullableMap := nullable.NullMapOf[string, int]()
+	nullableMap.SetValue(map[string]int{"a": 1})
+	tests.AssertEqual(t, nullableMap.GetOr(nil)["a"], 1)
+
+	nullableMap.SetNull()
+	tests.AssertEqual(t, nullableMap.GetOrZero() == nil, true)
+
+	basic := map[string]int{"b": 2}
+	nullableMap.Set(&basic)
+	value, ok := nullableMap.Unwrap()
+	tests.AssertEqual(t, ok, true)
+	tests.AssertEqual(t, value["b"], 2)
+
+	nullableMap.Set(nil)
+	tests.AssertEqual(t, nullableMap.IsZero(), true)
+}
+
+func TestCloneMapOf(t *testing.T) {
+	original := nullable.MapOfFrom(map[string]int{"a": 1})
+	cloned := original.Clone()
+	cloned.MustGet()["a"] = 2
+	tests.AssertEqual(t, original.MustGet()["a"], 1)
+	tests.AssertEqual(t, original.Changed(cloned), true)
+}
+
+func TestScanContextMapOf(t *testing.T) {
+	nullableMap := nullable.MapOfFrom(map[string]int{"a": 1})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests.AssertEqual(t, nullableMap.ScanContext(ctx, `{"b":2}`), context.Canceled)
+	tests.AssertEqual(t, nullableMap.MustGet()["a"], 1)
+}
+
+func TestMsgpackMapOf(t *testing.T) {
+	marshalUnmarshalMsgpack(t, nullable.MapOfFrom(map[string]string{"a": "1"}))
+	marshalUnmarshalMsgpack(t, nullable.MapOfFrom(map[string]string{}))
+	marshalUnmarshalMsgpack(t, nullable.NullMapOf[string, string]())
+}
+
+func TestBSONMapOf(t *testing.T) {
+	marshalUnmarshalBSON(t, nullable.MapOfFrom(map[string]string{"a": "1"}))
+	marshalUnmarshalBSON(t, nullable.MapOfFrom(map[string]string{}))
+	marshalUnmarshalBSON(t, nullable.NullMapOf[string, string]())
+}
+
+func TestGobMapOf(t *testing.T) {
+	marshalUnmarshalGob(t, nullable.MapOfFrom(map[string]string{"a": "1"}))
+	marshalUnmarshalGob(t, nullable.MapOfFrom(map[string]string{}))
+	marshalUnmarshalGob(t, nullable.NullMapOf[string, string]())
+}
+
+func TestJSONMapOf(t *testing.T) {
+	cases := []struct {
+		value      nullable.MapOf[string, string]
+		serialized string
+	}{
+		{nullable.MapOfFrom(map[string]string{"b": "2", "a": "1"}), `{"a":"1","b":"2"}`},
+		{nullable.MapOfFrom(map[string]string{}), `{}`},
+		{nullable.NullMapOf[string, string](), `null`},
+	}
+	for _, c := range cases {
+		serialized, err := json.Marshal(c.value)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(serialized), c.serialized)
+
+		var unserialized nullable.MapOf[string, string]
+		tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+		if !reflect.DeepEqual(unserialized, c.value) {
+			t.Errorf("expected %v, got %v", c.value.DebugString(), unserialized.DebugString())
+		}
+	}
+
+	var unserialized nullable.MapOf[string, string]
+	if err := json.Unmarshal([]byte(`{"a":1}`), &unserialized); err == nil {
+		t.Error("unmarshalling object of wrong value type must fail")
+	}
+}
+
+func TestMapOf(t *testing.T) {
+	type TestNullableMapOf struct {
+		ID     uint
+		Name   string
+		Labels nullable.MapOf[string, string]
+	}
+
+	DB.Migrator().DropTable(&TestNullableMapOf{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableMapOf{}); err != nil {
+		t.Errorf("failed to migrate nullable map, got error: %v", err)
+	}
+
+	labelled := TestNullableMapOf{Name: "labelled", Labels: nullable.MapOfFrom(map[string]string{"env": "prod", "team": "core"})}
+	DB.Create(&labelled)
+
+	unlabelled := TestNullableMapOf{Name: "unlabelled", Labels: nullable.MapOfFrom(map[string]string{})}
+	DB.Create(&unlabelled)
+
+	unknown := TestNullableMapOf{Name: "unknown", Labels: nullable.NullMapOf[string, string]()}
+	DB.Create(&unknown)
+
+	for _, expected := range []TestNullableMapOf{labelled, unlabelled, unknown} {
+		var result TestNullableMapOf
+		if err := DB.First(&result, "name = ?", expected.Name).Error; err != nil {
+			t.Fatalf("Cannot read map test record of %q", expected.Name)
+		}
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %v, got %v", expected.Labels.DebugString(), result.Labels.DebugString())
+		}
+	}
+}
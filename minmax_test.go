@@ -0,0 +1,55 @@
+package nullable_test
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestMinMaxUint64(t *testing.T) {
+	cases := []struct {
+		values   []nullable.Uint64
+		min, max nullable.Uint64
+	}{
+		{nil, nullable.NullUint64(), nullable.NullUint64()},
+		{[]nullable.Uint64{nullable.NullUint64(), nullable.NullUint64()}, nullable.NullUint64(), nullable.NullUint64()},
+		{[]nullable.Uint64{nullable.NullUint64(), nullable.Uint64From(7), nullable.NullUint64(), nullable.Uint64From(3)}, nullable.Uint64From(3), nullable.Uint64From(7)},
+		{[]nullable.Uint64{nullable.Uint64From(math.MaxUint64), nullable.Uint64From(0), nullable.Uint64From(5)}, nullable.Uint64From(0), nullable.Uint64From(math.MaxUint64)},
+		{[]nullable.Uint64{nullable.Uint64From(0), nullable.NullUint64()}, nullable.Uint64From(0), nullable.Uint64From(0)},
+	}
+	for _, c := range cases {
+		tests.AssertEqual(t, nullable.MinUint64(c.values...), c.min)
+		tests.AssertEqual(t, nullable.MaxUint64(c.values...), c.max)
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	tests.AssertEqual(t, nullable.MinInt64(nullable.Int64From(-1), nullable.NullInt64(), nullable.Int64From(1)), nullable.Int64From(-1))
+	tests.AssertEqual(t, nullable.MaxInt8(nullable.NullInt8(), nullable.Int8From(math.MinInt8)), nullable.Int8From(math.MinInt8))
+	tests.AssertEqual(t, nullable.MaxFloat64(nullable.Float64From(-0.5), nullable.Float64From(2.5)), nullable.Float64From(2.5))
+	tests.AssertEqual(t, nullable.MinString(nullable.StringFrom("b"), nullable.NullString(), nullable.StringFrom("a")), nullable.StringFrom("a"))
+	tests.AssertEqual(t, nullable.MaxDuration(nullable.DurationFrom(time.Second), nullable.DurationFrom(time.Minute)), nullable.DurationFrom(time.Minute))
+
+	early := time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	late := early.Add(time.Hour)
+	tests.AssertEqual(t, nullable.MinTime(nullable.TimeFrom(late), nullable.TimeFrom(early)), nullable.TimeFrom(early))
+	tests.AssertEqual(t, nullable.MaxTime(nullable.NullTime()), nullable.NullTime())
+
+	tests.AssertEqual(t, nullable.MaxDecimal(nullable.DecimalFrom(decimal.RequireFromString("1.10")), nullable.DecimalFrom(decimal.RequireFromString("1.2"))).String(), "1.2")
+	tests.AssertEqual(t, nullable.MinBigInt(nullable.NewBigInt(new(big.Int).Lsh(big.NewInt(1), 100)), nullable.BigIntFromInt64(-1)).String(), "-1")
+	tests.AssertEqual(t, nullable.MinBigInt(nullable.NullBigInt(), nullable.NullBigInt()).IsNull(), true)
+}
+
+func TestMinMaxAllocs(t *testing.T) {
+	first, second, null := nullable.Uint64From(1), nullable.Uint64From(2), nullable.NullUint64()
+	allocs := testing.AllocsPerRun(100, func() {
+		nullable.MinUint64(first, null, second)
+		nullable.MaxUint64(first, null, second)
+	})
+	tests.AssertEqual(t, allocs, float64(0))
+}
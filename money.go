@@ -0,0 +1,590 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"log/slog"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Money SQL type that can retrieve NULL value of an amount in a currency.
+// NULL means no amount at all. It is stored in a single text column as the
+// amount and the ISO 4217 currency code, such as "12.34 USD".
+type Money struct {
+	amount   decimal.Decimal
+	currency string
+	isValid  bool
+}
+
+// MoneyValue is the amount and currency a valid Money holds, what Get and
+// MustGet return
+type MoneyValue struct {
+	Amount   decimal.Decimal
+	Currency string
+}
+
+// MoneyFrom creates a new valid nullable amount of money. It panics when
+// currency is not three uppercase letters, since that is a mistake in the
+// calling code.
+func MoneyFrom(amount decimal.Decimal, currency string) Money {
+	if err := checkCurrency(currency); err != nil {
+		panic(err)
+	}
+	return Money{
+		amount:   amount,
+		currency: currency,
+		isValid:  true,
+	}
+}
+
+// NullMoney creates a new NULL amount of money
+func NullMoney() Money {
+	return Money{}
+}
+
+// ParseMoney parses text like "12.34 USD", empty text is NULL
+func ParseMoney(text string) (Money, error) {
+	if len(text) == 0 {
+		return NullMoney(), nil
+	}
+	amount, currency, found := strings.Cut(strings.TrimSpace(text), " ")
+	if !found {
+		return Money{}, fmt.Errorf("nullable: invalid Money %q, expected amount and currency such as \"12.34 USD\"", text)
+	}
+	parsed, err := decimal.NewFromString(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	currency = strings.TrimSpace(currency)
+	if err := checkCurrency(currency); err != nil {
+		return Money{}, err
+	}
+	return MoneyFrom(parsed, currency), nil
+}
+
+// MustParseMoney is ParseMoney that panics when text is malformed. It is
+// meant for test fixtures and package-level values, never for user input.
+func MustParseMoney(text string) Money {
+	n, err := ParseMoney(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseMoney(%q): %v", text, err))
+	}
+	return n
+}
+
+// Amount either zero or amount of money
+func (n Money) Amount() decimal.Decimal {
+	return n.amount
+}
+
+// Currency either empty string or ISO 4217 currency code
+func (n Money) Currency() string {
+	return n.currency
+}
+
+// Get either nil or amount and currency
+func (n Money) Get() *MoneyValue {
+	if !n.isValid {
+		return nil
+	}
+	return &MoneyValue{Amount: n.amount, Currency: n.currency}
+}
+
+// Unwrap returns amount and currency and true, or zero value and false when
+// NULL, the comma-ok way without the allocation of Get
+func (n Money) Unwrap() (MoneyValue, bool) {
+	if !n.isValid {
+		return MoneyValue{}, false
+	}
+	return MoneyValue{Amount: n.amount, Currency: n.currency}, true
+}
+
+// Set sets amount and currency and marks it as not NULL. A currency that is
+// not three uppercase letters is rejected and leaves the value unchanged.
+func (n *Money) Set(amount decimal.Decimal, currency string) error {
+	if err := checkCurrency(currency); err != nil {
+		return err
+	}
+	*n = MoneyFrom(amount, currency)
+	return nil
+}
+
+// SetValue sets amount and currency and marks it as not NULL, the same as Set
+func (n *Money) SetValue(amount decimal.Decimal, currency string) error {
+	return n.Set(amount, currency)
+}
+
+// Swap sets amount and currency like Set does and returns the previous value, nil
+// when it was NULL. Values Set rejects are rejected with nil and leave the
+// value unchanged.
+func (n *Money) Swap(amount decimal.Decimal, currency string) (*MoneyValue, error) {
+	previous := n.Get()
+	if err := n.Set(amount, currency); err != nil {
+		return nil, err
+	}
+	return previous, nil
+}
+
+// SwapNull marks the value as NULL like SetNull does and returns the
+// previous value, nil when it was NULL
+func (n *Money) SwapNull() *MoneyValue {
+	previous := n.Get()
+	n.SetNull()
+	return previous
+}
+
+// SetNull marks the value as NULL
+func (n *Money) SetNull() {
+	*n = NullMoney()
+}
+
+// Reset sets the value back to the zero NULL Money, the one a declared
+// Money starts with, so pooled values can be reused
+func (n *Money) Reset() {
+	*n = Money{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Money) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// IsValid reports whether the value is not NULL
+func (n Money) IsValid() bool {
+	return n.isValid
+}
+
+// IsNull reports whether the value is NULL
+func (n Money) IsNull() bool {
+	return !n.isValid
+}
+
+// IsZero reports whether the value is NULL, so `json:",omitzero"` omits it.
+// A valid amount of 0 is not zero here.
+func (n Money) IsZero() bool {
+	return !n.isValid
+}
+
+// Validate runs fns on amount and currency unless NULL and joins their
+// errors, nil when all of them pass or the value is NULL
+func (n Money) Validate(fns ...func(MoneyValue) error) error {
+	return validate(n.isValid, MoneyValue{Amount: n.amount, Currency: n.currency}, fns)
+}
+
+// GetOr either fallback or amount and currency
+func (n Money) GetOr(fallback MoneyValue) MoneyValue {
+	if !n.isValid {
+		return fallback
+	}
+	return MoneyValue{Amount: n.amount, Currency: n.currency}
+}
+
+// GetOrZero either zero value or amount and currency
+func (n Money) GetOrZero() MoneyValue {
+	return n.GetOr(MoneyValue{})
+}
+
+// MustGet either amount and currency or panic when NULL
+func (n Money) MustGet() MoneyValue {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Money")
+	}
+	return MoneyValue{Amount: n.amount, Currency: n.currency}
+}
+
+// Clone returns a copy of the value that shares no memory with n
+func (n Money) Clone() Money {
+	n.amount = n.amount.Copy()
+	return n
+}
+
+// String returns amount and currency such as "12.34 USD", or "<null>" when NULL
+func (n Money) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return decimalText(n.amount) + " " + n.currency
+}
+
+// DebugString returns Money(value) or Money(NULL), telling NULL apart from a
+// zero value in logs
+func (n Money) DebugString() string {
+	return debugString("Money", n.isValid, n.String())
+}
+
+// LogValue implements slog.LogValuer, logging NULL as nil and the value as
+// amount and currency like String
+func (n Money) LogValue() slog.Value {
+	return logText(n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same amount
+// in the same currency, 1.5 USD being equal to 1.50 USD
+func (n Money) Equal(other Money) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.currency == other.currency && n.amount.Equal(other.amount)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Money) Changed(old Money) bool {
+	return !n.Equal(old)
+}
+
+// Add returns n plus other, or NULL when either is NULL like SQL does. It
+// fails when the currencies differ.
+func (n Money) Add(other Money) (Money, error) {
+	if !n.isValid || !other.isValid {
+		return NullMoney(), nil
+	}
+	if err := n.sameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return MoneyFrom(n.amount.Add(other.amount), n.currency), nil
+}
+
+// Sub returns n minus other, or NULL when either is NULL like SQL does. It
+// fails when the currencies differ.
+func (n Money) Sub(other Money) (Money, error) {
+	if !n.isValid || !other.isValid {
+		return NullMoney(), nil
+	}
+	if err := n.sameCurrency(other); err != nil {
+		return Money{}, err
+	}
+	return MoneyFrom(n.amount.Sub(other.amount), n.currency), nil
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL the amount without trailing zeros and the currency, telling NULL
+// apart from zero in cache keys
+func (n Money) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagMoney, n.isValid, []byte(n.amount.String()+" "+n.currency))
+}
+
+// moneyYAML is the YAML mapping of a valid Money
+type moneyYAML struct {
+	Amount   string `yaml:"amount"`
+	Currency string `yaml:"currency"`
+}
+
+// moneyJSON is the JSON object of a valid Money
+type moneyJSON struct {
+	Amount   json.RawMessage `json:"amount"`
+	Currency string          `json:"currency"`
+}
+
+// MarshalJSON converts current value to JSON, {"amount":"12.34","currency":"USD"}
+func (n Money) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	// The amount is quoted regardless of decimal.MarshalJSONWithoutQuotes
+	return json.Marshal(moneyJSON{
+		Amount:   json.RawMessage(`"` + decimalText(n.amount) + `"`),
+		Currency: n.currency,
+	})
+}
+
+// UnmarshalJSON writes JSON to this type, the amount may be a string or a number
+func (n *Money) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed moneyJSON
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+	if len(parsed.Amount) == 0 {
+		return jsonError(fmt.Errorf("nullable: Money JSON %s has no amount", data))
+	}
+	var amount decimal.Decimal
+	if err := amount.UnmarshalJSON(parsed.Amount); err != nil {
+		return jsonError(err)
+	}
+	return jsonError(n.Set(amount, parsed.Currency))
+}
+
+// MarshalText converts current value to text such as "12.34 USD", NULL is
+// empty text
+func (n Money) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Money) UnmarshalText(text []byte) error {
+	parsed, err := ParseMoney(string(text))
+	if err != nil {
+		return err
+	}
+	*n = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Money) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Money) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Money) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return copyText(n.String()), false
+}
+
+// MarshalYAML converts current value to YAML, a mapping of amount and
+// currency like JSON
+func (n Money) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return moneyYAML{Amount: decimalText(n.amount), Currency: n.currency}, nil
+}
+
+// UnmarshalYAML writes YAML to this type, the amount may be a string or a
+// number
+func (n *Money) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed moneyYAML
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+	if parsed.Amount == "" {
+		return fmt.Errorf("nullable: Money YAML at line %d has no amount", value.Line)
+	}
+	amount, err := decimal.NewFromString(parsed.Amount)
+	if err != nil {
+		return err
+	}
+	return n.Set(amount, parsed.Currency)
+}
+
+// MarshalXML converts current value to XML, text such as "12.34 USD"
+func (n Money) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.String(), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Money) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+	return n.parse(text)
+}
+
+// EncodeMsgpack converts current value to MessagePack, a string such as
+// "12.34 USD"
+func (n Money) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(n.String())
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Money) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	text, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+	return n.parse(text)
+}
+
+// MarshalBSONValue converts current value to BSON, a string such as
+// "12.34 USD". NULL is BSON null.
+func (n Money) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.String())
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Money) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.SetNull()
+		return nil
+	}
+	if t != bson.TypeString {
+		return bsonTypeError(t, "Money")
+	}
+	text, _, ok := bsoncore.ReadString(data)
+	if !ok {
+		return bsonTypeError(t, "Money")
+	}
+	return n.parse(text)
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Money) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.String())
+}
+
+// GobDecode writes gob to this type
+func (n *Money) GobDecode(data []byte) error {
+	var text string
+	isValid, err := gobDecode(data, &text)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.SetNull()
+		return nil
+	}
+	return n.parse(text)
+}
+
+// Scan implements scanner interface, reading text such as "12.34 USD"
+func (n *Money) Scan(value interface{}) error {
+	value = scanValue(value)
+	if value == nil {
+		n.SetNull()
+		return nil
+	}
+
+	var scanned string
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
+	}
+	return scanError(n.parse(scanned))
+}
+
+// Value implements the driver Valuer interface.
+func (n Money) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.String(), nil
+}
+
+// GormDataType gorm common data type
+func (Money) GormDataType() string {
+	return "money_null"
+}
+
+// GormDBDataType gorm db data type
+func (n Money) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Money) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Money) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
+	case "sqlite", "mysql":
+		return "VARCHAR(100)"
+	case "postgres", "cockroachdb":
+		return "text"
+	case "sqlserver":
+		return "NVARCHAR(100)"
+	case "clickhouse":
+		return "Nullable(String)"
+	}
+	return ""
+}
+
+// parse sets text such as "12.34 USD", which unlike for ParseMoney cannot be
+// empty, and leaves the value unchanged on error
+func (n *Money) parse(text string) error {
+	if len(text) == 0 {
+		return fmt.Errorf("nullable: invalid Money %q, expected amount and currency such as \"12.34 USD\"", text)
+	}
+	parsed, err := ParseMoney(text)
+	if err != nil {
+		return err
+	}
+	*n = parsed
+	return nil
+}
+
+// sameCurrency returns an error when n and other are in different currencies
+func (n Money) sameCurrency(other Money) error {
+	if n.currency != other.currency {
+		return fmt.Errorf("nullable: cannot combine %s with %s", n.currency, other.currency)
+	}
+	return nil
+}
+
+// checkCurrency returns an error unless currency has the form of an ISO 4217
+// code, three uppercase letters. Whether the code is assigned is not checked.
+func checkCurrency(currency string) error {
+	if len(currency) != 3 {
+		return fmt.Errorf("nullable: invalid currency %q, expected an ISO 4217 code such as USD", currency)
+	}
+	for _, letter := range []byte(currency) {
+		if letter < 'A' || letter > 'Z' {
+			return fmt.Errorf("nullable: invalid currency %q, expected an ISO 4217 code such as USD", currency)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,284 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/tee8z/nullable"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestMoneyFrom(t *testing.T) {
+	money := nullable.MoneyFrom(decimal.RequireFromString("12.34"), "USD")
+	tests.AssertEqual(t, money.IsValid(), true)
+	tests.AssertEqual(t, money.Amount().String(), "12.34")
+	tests.AssertEqual(t, money.Currency(), "USD")
+
+	null := nullable.NullMoney()
+	tests.AssertEqual(t, null.IsNull(), true)
+	tests.AssertEqual(t, null.Currency(), "")
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MoneyFrom with invalid currency must panic")
+		}
+	}()
+	nullable.MoneyFrom(decimal.NewFromInt(1), "usd")
+}
+
+func TestSetMoney(t *testing.T) {
+	money := nullable.NullMoney()
+	tests.AssertEqual(t, money.Set(decimal.RequireFromString("9.99"), "EUR"), nil)
+	tests.AssertEqual(t, money.String(), "9.99 EUR")
+
+	for _, currency := range []string{"", "EU", "EURO", "eur", "E1R"} {
+		if err := money.Set(decimal.NewFromInt(1), currency); err == nil {
+			t.Errorf("setting currency %q must fail", currency)
+		}
+	}
+	tests.AssertEqual(t, money.String(), "9.99 EUR")
+
+	money.SetNull()
+	tests.AssertEqual(t, money, nullable.NullMoney())
+	tests.AssertEqual(t, money.String(), "<null>")
+}
+
+func TestSwapMoney(t *testing.T) {
+	money := nullable.NullMoney()
+	previous, err := money.Swap(decimal.RequireFromString("9.99"), "EUR")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, previous == nil, true)
+	tests.AssertEqual(t, money.String(), "9.99 EUR")
+
+	previous, err = money.Swap(decimal.NewFromInt(1), "EU")
+	if err == nil {
+		t.Error("swapping in currency \"EU\" must fail")
+	}
+	tests.AssertEqual(t, previous == nil, true)
+	tests.AssertEqual(t, money.String(), "9.99 EUR")
+
+	previous = money.SwapNull()
+	tests.AssertEqual(t, *previous, nullable.MoneyValue{Amount: decimal.RequireFromString("9.99"), Currency: "EUR"})
+	tests.AssertEqual(t, money.IsNull(), true)
+	tests.AssertEqual(t, money.SwapNull() == nil, true)
+
+	tests.AssertEqual(t, money.SetValue(decimal.NewFromInt(5), "USD"), nil)
+	tests.AssertEqual(t, money.String(), "5 USD")
+	if err := money.SetValue(decimal.NewFromInt(1), "usd"); err == nil {
+		t.Error("setting currency \"usd\" must fail")
+	}
+	tests.AssertEqual(t, money.String(), "5 USD")
+}
+
+func TestParseMoney(t *testing.T) {
+	parsed, err := nullable.ParseMoney("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullMoney())
+
+	parsed, err = nullable.ParseMoney("-0.50 JPY")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.MoneyFrom(decimal.RequireFromString("-0.5"), "JPY")), true)
+
+	for _, text := range []string{"12.34", "USD", "abc USD", "12.34 usd", "12.34 US"} {
+		if _, err := nullable.ParseMoney(text); err == nil {
+			t.Errorf("parsing %q as money must fail", text)
+		}
+	}
+}
+
+func TestEqualMoney(t *testing.T) {
+	dollars := nullable.MustParseMoney("1.5 USD")
+	tests.AssertEqual(t, dollars.Equal(nullable.MustParseMoney("1.50 USD")), true)
+	tests.AssertEqual(t, dollars.Equal(nullable.MustParseMoney("1.5 EUR")), false)
+	tests.AssertEqual(t, dollars.Equal(nullable.NullMoney()), false)
+	tests.AssertEqual(t, nullable.NullMoney().Equal(nullable.NullMoney()), true)
+	tests.AssertEqual(t, dollars.Changed(nullable.NullMoney()), true)
+}
+
+func TestAddSubMoney(t *testing.T) {
+	sum, err := nullable.MustParseMoney("12.34 USD").Add(nullable.MustParseMoney("0.66 USD"))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, sum.String(), "13.00 USD")
+
+	difference, err := nullable.MustParseMoney("12.34 USD").Sub(nullable.MustParseMoney("20 USD"))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, difference.String(), "-7.66 USD")
+
+	sum, err = nullable.MustParseMoney("1 USD").Add(nullable.NullMoney())
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, sum, nullable.NullMoney())
+
+	_, err = nullable.MustParseMoney("1 USD").Add(nullable.MustParseMoney("1 EUR"))
+	tests.AssertEqual(t, err.Error(), "nullable: cannot combine USD with EUR")
+	_, err = nullable.MustParseMoney("1 USD").Sub(nullable.MustParseMoney("1 EUR"))
+	tests.AssertEqual(t, err.Error(), "nullable: cannot combine USD with EUR")
+}
+
+func TestCloneMoney(t *testing.T) {
+	original := nullable.MustParseMoney("12.34 USD")
+	tests.AssertEqual(t, original.Clone().Equal(original), true)
+	tests.AssertEqual(t, nullable.NullMoney().Clone(), nullable.NullMoney())
+}
+
+func TestScanMoney(t *testing.T) {
+	money := nullable.NullMoney()
+	tests.AssertEqual(t, money.Scan("12.34 USD"), nil)
+	tests.AssertEqual(t, money.String(), "12.34 USD")
+
+	tests.AssertEqual(t, money.Scan([]byte("7 GBP")), nil)
+	tests.AssertEqual(t, money.String(), "7 GBP")
+
+	for _, malformed := range []interface{}{"", "12.34", "12.34 dollars", 12.34} {
+		if err := money.Scan(malformed); err == nil {
+			t.Errorf("scanning %v must fail", malformed)
+		}
+	}
+	tests.AssertEqual(t, money.String(), "7 GBP")
+
+	tests.AssertEqual(t, money.Scan(nil), nil)
+	tests.AssertEqual(t, money.IsNull(), true)
+}
+
+func TestValueMoney(t *testing.T) {
+	value, err := nullable.MustParseMoney("12.34 USD").Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, "12.34 USD")
+
+	value, err = nullable.NullMoney().Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
+func TestJSONMoney(t *testing.T) {
+	cases := []struct {
+		value      nullable.Money
+		serialized string
+	}{
+		{nullable.MustParseMoney("12.34 USD"), `{"amount":"12.34","currency":"USD"}`},
+		{nullable.MustParseMoney("0 EUR"), `{"amount":"0","currency":"EUR"}`},
+		{nullable.NullMoney(), `null`},
+	}
+	for _, c := range cases {
+		serialized, err := json.Marshal(c.value)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(serialized), c.serialized)
+
+		var unserialized nullable.Money
+		tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+		tests.AssertEqual(t, unserialized.Equal(c.value), true)
+	}
+
+	var unserialized nullable.Money
+	tests.AssertEqual(t, json.Unmarshal([]byte(`{"amount":12.5,"currency":"USD"}`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.String(), "12.5 USD")
+
+	for _, data := range []string{`{"currency":"USD"}`, `{"amount":"1"}`, `{"amount":"x","currency":"USD"}`, `"12.34 USD"`, `12.34`} {
+		if err := json.Unmarshal([]byte(data), &unserialized); err == nil {
+			t.Errorf("unmarshalling %s as money must fail", data)
+		}
+	}
+	tests.AssertEqual(t, fmt.Sprint(unserialized), "12.5 USD")
+}
+
+func TestGetMoney(t *testing.T) {
+	money := nullable.MustParseMoney("12.34 USD")
+	expected := nullable.MoneyValue{Amount: decimal.RequireFromString("12.34"), Currency: "USD"}
+	tests.AssertEqual(t, *money.Get(), expected)
+	tests.AssertEqual(t, money.MustGet(), expected)
+	tests.AssertEqual(t, money.GetOr(nullable.MoneyValue{}), expected)
+	unwrapped, ok := money.Unwrap()
+	tests.AssertEqual(t, ok, true)
+	tests.AssertEqual(t, unwrapped, expected)
+
+	null := nullable.NullMoney()
+	tests.AssertEqual(t, null.Get() == nil, true)
+	fallback := nullable.MoneyValue{Amount: decimal.Zero, Currency: "EUR"}
+	tests.AssertEqual(t, null.GetOr(fallback), fallback)
+	tests.AssertEqual(t, null.GetOrZero(), nullable.MoneyValue{})
+	_, ok = null.Unwrap()
+	tests.AssertEqual(t, ok, false)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Money")
+	}()
+	null.MustGet()
+}
+
+func TestValidateMoney(t *testing.T) {
+	positive := func(value nullable.MoneyValue) error {
+		if !value.Amount.IsPositive() {
+			return fmt.Errorf("%s is not positive", value.Amount)
+		}
+		return nil
+	}
+	tests.AssertEqual(t, nullable.MustParseMoney("1 USD").Validate(positive), nil)
+	tests.AssertEqual(t, nullable.NullMoney().Validate(positive), nil)
+	if err := nullable.MustParseMoney("-1 USD").Validate(positive); err == nil {
+		t.Error("validating a negative amount must fail")
+	}
+}
+
+func TestCodecsMoney(t *testing.T) {
+	for _, money := range []nullable.Money{nullable.MustParseMoney("0.10 USD"), nullable.NullMoney()} {
+		marshalUnmarshalText(t, money)
+		marshalUnmarshalCSV(t, money)
+		marshalUnmarshalYAML(t, money)
+		marshalUnmarshalXML(t, money)
+		marshalUnmarshalMsgpack(t, money)
+		marshalUnmarshalBSON(t, money)
+		marshalUnmarshalGob(t, money)
+	}
+
+	serialized, err := yaml.Marshal(yamlEnvelope[nullable.Money]{Value: nullable.MustParseMoney("0.10 USD")})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "value:\n    amount: \"0.10\"\n    currency: USD\n")
+
+	var unserialized yamlEnvelope[nullable.Money]
+	tests.AssertEqual(t, yaml.Unmarshal([]byte("value: {amount: 12.5, currency: EUR}"), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.Value.String(), "12.5 EUR")
+	for _, data := range []string{"value: {currency: USD}", "value: {amount: 1, currency: usd}", "value: 12.34 USD"} {
+		if err := yaml.Unmarshal([]byte(data), &unserialized); err == nil {
+			t.Errorf("unmarshalling %q as money must fail", data)
+		}
+	}
+
+	var money nullable.Money
+	if err := money.UnmarshalXML(xml.NewDecoder(strings.NewReader("<money></money>")), xml.StartElement{Name: xml.Name{Local: "money"}}); err == nil {
+		t.Error("unmarshalling an empty XML element as money must fail")
+	}
+}
+
+func TestMoney(t *testing.T) {
+	type TestNullableMoney struct {
+		ID    uint
+		Name  string
+		Price nullable.Money
+	}
+
+	DB.Migrator().DropTable(&TestNullableMoney{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableMoney{}); err != nil {
+		t.Errorf("failed to migrate nullable money, got error: %v", err)
+	}
+
+	priced := TestNullableMoney{Name: "priced", Price: nullable.MustParseMoney("12.34 USD")}
+	DB.Create(&priced)
+
+	free := TestNullableMoney{Name: "free", Price: nullable.MustParseMoney("0 USD")}
+	DB.Create(&free)
+
+	unknown := TestNullableMoney{Name: "unknown", Price: nullable.NullMoney()}
+	DB.Create(&unknown)
+
+	for _, expected := range []TestNullableMoney{priced, free, unknown} {
+		var result TestNullableMoney
+		if err := DB.First(&result, "name = ?", expected.Name).Error; err != nil {
+			t.Fatalf("Cannot read money test record of %q", expected.Name)
+		}
+		tests.AssertEqual(t, result.Price.Equal(expected.Price), true)
+	}
+}
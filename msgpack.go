@@ -0,0 +1,18 @@
+package nullable
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+)
+
+// decodeMsgpackNil consumes msgpack nil, reporting whether the next value was nil
+func decodeMsgpackNil(dec *msgpack.Decoder) (bool, error) {
+	code, err := dec.PeekCode()
+	if err != nil {
+		return false, err
+	}
+	if code != msgpcode.Nil {
+		return false, nil
+	}
+	return true, dec.DecodeNil()
+}
@@ -0,0 +1,57 @@
+package nullable_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"github.com/vmihailenco/msgpack/v5"
+	"gorm.io/gorm/utils/tests"
+)
+
+func marshalUnmarshalMsgpack[T any](t *testing.T, target T) {
+	serialized, err := msgpack.Marshal(target)
+	if err != nil {
+		t.Fatalf("Failed to marshal %T because: %s", target, err)
+		return
+	}
+
+	var unserialized T
+	if err := msgpack.Unmarshal(serialized, &unserialized); err != nil {
+		t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+		return
+	}
+	tests.AssertEqual(t, unserialized, target)
+}
+
+func TestMsgpackNative(t *testing.T) {
+	serialized, err := msgpack.Marshal(nullable.NullUint64())
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, serialized, []byte{0xc0})
+
+	// Valid values must encode exactly like the plain Go value
+	serialized, err = msgpack.Marshal(nullable.Uint64From(math.MaxUint64))
+	tests.AssertEqual(t, err, nil)
+	expected, _ := msgpack.Marshal(uint64(math.MaxUint64))
+	tests.AssertEqual(t, serialized, expected)
+
+	var plain uint64
+	tests.AssertEqual(t, msgpack.Unmarshal(serialized, &plain), nil)
+	tests.AssertEqual(t, plain, uint64(math.MaxUint64))
+
+	// NULL inside a struct comes back NULL even over a valid value
+	type envelope struct {
+		Value nullable.Int64
+	}
+	serialized, err = msgpack.Marshal(envelope{})
+	tests.AssertEqual(t, err, nil)
+	unserialized := envelope{Value: nullable.Int64From(42)}
+	tests.AssertEqual(t, msgpack.Unmarshal(serialized, &unserialized), nil)
+	tests.AssertEqual(t, unserialized.Value.IsNull(), true)
+}
+
+func TestMsgpackNullable(t *testing.T) {
+	marshalUnmarshalMsgpack(t, nullable.NullableFrom(uint64(math.MaxUint64)))
+	marshalUnmarshalMsgpack(t, nullable.NullableFrom([]string{"a", "b"}))
+	marshalUnmarshalMsgpack(t, nullable.Null[string]())
+}
@@ -0,0 +1,326 @@
+// Package nullable provides SQL types that can hold NULL, for GORM and
+// database/sql. Like the values they hold, they are not safe for concurrent
+// use: one goroutine must not read a value while another writes it.
+// AtomicUint64 and AtomicBool can be shared without a lock.
+package nullable
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// nullString is returned by String when the value is NULL
+const nullString = "<null>"
+
+// debugString formats what DebugString returns, name(text) or name(NULL)
+func debugString(name string, isValid bool, text string) string {
+	if !isValid {
+		return name + "(NULL)"
+	}
+	return name + "(" + text + ")"
+}
+
+// validate runs fns on value when isValid, joining their errors
+func validate[T any](isValid bool, value T, fns []func(T) error) error {
+	if !isValid {
+		return nil
+	}
+	var errs []error
+	for _, fn := range fns {
+		if err := fn(value); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Nullable SQL type that can retrieve NULL value of any type
+type Nullable[T any] struct {
+	core[T]
+}
+
+// NewNullable creates a new nullable value of any type
+func NewNullable[T any](value *T) Nullable[T] {
+	return Nullable[T]{core: newCore(value)}
+}
+
+// NullableFrom creates a new valid nullable value from value
+func NullableFrom[T any](value T) Nullable[T] {
+	return NewNullable(&value)
+}
+
+// Null creates a new NULL value of any type
+func Null[T any]() Nullable[T] {
+	return NewNullable[T](nil)
+}
+
+// NullableFromSQL creates a new nullable value from sql.Null
+func NullableFromSQL[T any](value sql.Null[T]) Nullable[T] {
+	if !value.Valid {
+		return Null[T]()
+	}
+	return NullableFrom(value.V)
+}
+
+// Coalesce returns the first valid value, or NULL when all of them are NULL
+func Coalesce[T any](values ...Nullable[T]) Nullable[T] {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return Nullable[T]{}
+}
+
+// NullablePtr returns n.Get(), handy where a function value is needed
+func NullablePtr[T any](n Nullable[T]) *T {
+	return n.Get()
+}
+
+// NullableValue returns n.GetOrZero(), zero value when NULL
+func NullableValue[T any](n Nullable[T]) T {
+	return n.GetOrZero()
+}
+
+// Reset sets the value back to the zero NULL Nullable, the one a declared
+// Nullable starts with, so pooled values can be reused
+func (n *Nullable[T]) Reset() {
+	*n = Nullable[T]{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Nullable[T]) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// MustGet either value or panic when NULL
+func (n Nullable[T]) MustGet() T {
+	return n.mustGet(reflect.TypeOf(n).String())
+}
+
+// Clone returns a copy of the value, same as assigning it, so T is
+// copied by assignment as well
+func (n Nullable[T]) Clone() Nullable[T] {
+	return n
+}
+
+// String returns value in its natural text form, or "<null>" when NULL
+func (n Nullable[T]) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return fmt.Sprint(n.realValue)
+}
+
+// DebugString returns Nullable[T](value) or Nullable[T](NULL), telling NULL apart
+// from a zero value in logs
+func (n Nullable[T]) DebugString() string {
+	return debugString("Nullable["+reflect.TypeFor[T]().String()+"]", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold deeply equal value
+func (n Nullable[T]) Equal(other Nullable[T]) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return reflect.DeepEqual(n.realValue, other.realValue)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Nullable[T]) Changed(old Nullable[T]) bool {
+	return !n.Equal(old)
+}
+
+// ToSQL converts current value to sql.Null
+func (n Nullable[T]) ToSQL() sql.Null[T] {
+	return sql.Null[T]{V: n.realValue, Valid: n.isValid}
+}
+
+// MarshalJSON converts current value to JSON
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.Get())
+}
+
+// UnmarshalJSON writes JSON to this type
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.Set(nil)
+		return nil
+	}
+
+	var parsed T
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.Set(&parsed)
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Nullable[T]) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Nullable[T]) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.Set(nil)
+		return nil
+	}
+
+	var parsed T
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.Set(&parsed)
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Nullable[T]) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Nullable[T]) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.Set(nil)
+		return nil
+	}
+
+	var parsed T
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.Set(&parsed)
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Nullable[T]) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Nullable[T]) GobDecode(data []byte) error {
+	var parsed T
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.Set(nil)
+		return nil
+	}
+
+	n.Set(&parsed)
+	return nil
+}
+
+// Scan implements scanner interface
+func (n *Nullable[T]) Scan(value interface{}) error {
+	value = scanValue(value)
+	if value == nil {
+		n.Set(nil)
+		return nil
+	}
+
+	var scanned T
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
+	}
+
+	n.Set(&scanned)
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n Nullable[T]) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return convertValue(n.realValue)
+}
+
+// convertValue converts any Go value into driver.Value the same way
+// the hand-written types do, including uint64 above math.MaxInt64
+// which is stored as decimal string.
+func convertValue(value interface{}) (driver.Value, error) {
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		if _, ok := value.(driver.Valuer); !ok && rv.Uint() > math.MaxInt64 {
+			return strconv.FormatUint(rv.Uint(), 10), nil
+		}
+	}
+	return driver.DefaultParameterConverter.ConvertValue(value)
+}
+
+// unmarshalJSONNull reports whether data is the JSON null literal, spaces
+// around it allowed. Empty data is no JSON at all and is a syntax error.
+func unmarshalJSONNull(data []byte) (bool, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 {
+		var discard interface{}
+		return false, json.Unmarshal(trimmed, &discard)
+	}
+	return string(trimmed) == "null", nil
+}
+
+// Map applies f to the value of n, NULL stays NULL without calling f
+func Map[T, U any](n Nullable[T], f func(T) U) Nullable[U] {
+	if !n.isValid {
+		return Nullable[U]{}
+	}
+	return NullableFrom(f(n.realValue))
+}
+
+// Zip applies f to the values of a and b, NULL when either of them is NULL
+// without calling f
+func Zip[A, B, C any](a Nullable[A], b Nullable[B], f func(A, B) C) Nullable[C] {
+	if !a.isValid || !b.isValid {
+		return Nullable[C]{}
+	}
+	return NullableFrom(f(a.realValue, b.realValue))
+}
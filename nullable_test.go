@@ -0,0 +1,214 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestNewNullable(t *testing.T) {
+	basicString := "Hello World!"
+	nullableString := nullable.NewNullable(&basicString)
+	tests.AssertEqual(t, nullableString.Get(), basicString)
+	tests.AssertEqual(t, nullableString.IsValid(), true)
+
+	var basicUint uint64 = math.MaxUint64
+	nullableUint := nullable.NewNullable(&basicUint)
+	tests.AssertEqual(t, nullableUint.Get(), basicUint)
+
+	nullableNil := nullable.NewNullable[int64](nil)
+	tests.AssertEqual(t, nullableNil.Get(), nil)
+	tests.AssertEqual(t, nullableNil.IsNull(), true)
+}
+
+func TestSetNullable(t *testing.T) {
+	var nullableFloat nullable.Nullable[float64]
+	tests.AssertEqual(t, nullableFloat.Get(), nil)
+
+	basicFloat := 3.14
+	nullableFloat.Set(&basicFloat)
+	tests.AssertEqual(t, nullableFloat.Get(), basicFloat)
+
+	nullableFloat.Set(nil)
+	tests.AssertEqual(t, nullableFloat.Get(), nil)
+	tests.AssertEqual(t, nullableFloat.GetOrZero(), 0)
+}
+
+func TestSetValueNullable(t *testing.T) {
+	var nullableString nullable.Nullable[string]
+	nullableString.SetValue("Hello World!")
+	tests.AssertEqual(t, nullableString.Get(), "Hello World!")
+
+	nullableString.SetNull()
+	tests.AssertEqual(t, nullableString, nullable.Null[string]())
+}
+
+func TestScanNullable(t *testing.T) {
+	var nullableInt nullable.Nullable[int32]
+	tests.AssertEqual(t, nullableInt.Scan(int64(-1234567)), nil)
+	tests.AssertEqual(t, nullableInt.Get(), int32(-1234567))
+
+	tests.AssertEqual(t, nullableInt.Scan("654321"), nil)
+	tests.AssertEqual(t, nullableInt.Get(), int32(654321))
+
+	if err := nullableInt.Scan(int64(math.MaxInt64)); err == nil {
+		t.Error("scanning overflowing value into Nullable[int32] must fail")
+	}
+
+	tests.AssertEqual(t, nullableInt.Scan(nil), nil)
+	tests.AssertEqual(t, nullableInt.Get(), nil)
+
+	var nullableString nullable.Nullable[string]
+	tests.AssertEqual(t, nullableString.Scan([]byte("Hello World!")), nil)
+	tests.AssertEqual(t, nullableString.Get(), "Hello World!")
+
+	var nullableTime nullable.Nullable[time.Time]
+	basicTime := time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	tests.AssertEqual(t, nullableTime.Scan(basicTime), nil)
+	tests.AssertEqual(t, nullableTime.Get(), basicTime)
+}
+
+func TestValueNullable(t *testing.T) {
+	var basicUint uint64 = math.MaxUint64
+	value, err := nullable.NewNullable(&basicUint).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, "18446744073709551615")
+
+	var basicInt int16 = -12345
+	value, err = nullable.NewNullable(&basicInt).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, int64(-12345))
+
+	value, err = nullable.NewNullable[bool](nil).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
+func TestJSONNullable(t *testing.T) {
+	basicString := "Hello World!"
+	serialized, err := json.Marshal(nullable.NewNullable(&basicString))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `"Hello World!"`)
+
+	var unserialized nullable.Nullable[string]
+	tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+	tests.AssertEqual(t, unserialized, nullable.NewNullable(&basicString))
+
+	serialized, err = json.Marshal(nullable.NewNullable[string](nil))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "null")
+
+	tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+	tests.AssertEqual(t, unserialized.IsNull(), true)
+}
+
+func TestStringerNullable(t *testing.T) {
+	basicInt := int32(-1234567)
+	tests.AssertEqual(t, nullable.NewNullable(&basicInt).String(), "-1234567")
+	tests.AssertEqual(t, nullable.NewNullable[int32](nil).String(), "<null>")
+}
+
+func TestEqualNullable(t *testing.T) {
+	basicSlice := []int{1, 2, 3}
+	sameSlice := []int{1, 2, 3}
+	tests.AssertEqual(t, nullable.NewNullable(&basicSlice).Equal(nullable.NewNullable(&sameSlice)), true)
+	tests.AssertEqual(t, nullable.NewNullable(&basicSlice).Equal(nullable.NewNullable[[]int](nil)), false)
+	tests.AssertEqual(t, nullable.NewNullable[[]int](nil).Equal(nullable.NewNullable[[]int](nil)), true)
+}
+
+func TestMapNullable(t *testing.T) {
+	basicInt := 21
+	doubled := nullable.Map(nullable.NewNullable(&basicInt), func(value int) string {
+		return strconv.Itoa(value * 2)
+	})
+	tests.AssertEqual(t, doubled.Get(), "42")
+
+	called := false
+	mapped := nullable.Map(nullable.NewNullable[int](nil), func(value int) string {
+		called = true
+		return strconv.Itoa(value)
+	})
+	tests.AssertEqual(t, mapped.IsNull(), true)
+	tests.AssertEqual(t, called, false)
+}
+
+func TestZipNullable(t *testing.T) {
+	fullName := func(first, last string) string {
+		return first + " " + last
+	}
+	cases := []struct {
+		first, last nullable.Nullable[string]
+		expected    nullable.Nullable[string]
+		called      bool
+	}{
+		{nullable.NullableFrom("Ada"), nullable.NullableFrom("Lovelace"), nullable.NullableFrom("Ada Lovelace"), true},
+		{nullable.NullableFrom("Ada"), nullable.Null[string](), nullable.Null[string](), false},
+		{nullable.Null[string](), nullable.NullableFrom("Lovelace"), nullable.Null[string](), false},
+		{nullable.Null[string](), nullable.Null[string](), nullable.Null[string](), false},
+	}
+	for _, c := range cases {
+		called := false
+		zipped := nullable.Zip(c.first, c.last, func(first, last string) string {
+			called = true
+			return fullName(first, last)
+		})
+		tests.AssertEqual(t, zipped, c.expected)
+		tests.AssertEqual(t, called, c.called)
+	}
+
+	// The three types may all differ
+	basicInt := 3
+	repeated := nullable.Zip(nullable.NullableFrom("ab"), nullable.NewNullable(&basicInt), strings.Repeat)
+	tests.AssertEqual(t, repeated.Get(), "ababab")
+}
+
+func TestNullableFrom(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullableFrom(int32(42)).Get(), int32(42))
+	tests.AssertEqual(t, nullable.Null[int32]().IsNull(), true)
+	tests.AssertEqual(t, nullable.Null[int32](), nullable.NewNullable[int32](nil))
+}
+
+func TestCoalesceNullable(t *testing.T) {
+	tests.AssertEqual(t, nullable.Coalesce[string]().IsNull(), true)
+	tests.AssertEqual(t, nullable.Coalesce(nullable.Null[string](), nullable.NullableFrom("first"), nullable.NullableFrom("second")).Get(), "first")
+}
+
+func TestIsZeroNullable(t *testing.T) {
+	tests.AssertEqual(t, nullable.NewNullable[int](nil).IsZero(), true)
+	tests.AssertEqual(t, nullable.NullableFrom(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.NullableFrom(42).IsZero(), false)
+}
+
+func TestUnwrapNullable(t *testing.T) {
+	value, ok := nullable.NullableFrom(42).Unwrap()
+	tests.AssertEqual(t, value, 42)
+	tests.AssertEqual(t, ok, true)
+
+	var zero nullable.Nullable[int]
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestPtrNullable(t *testing.T) {
+	basic := 42
+	tests.AssertEqual(t, nullable.NullablePtr(nullable.NewNullable(&basic)), basic)
+	tests.AssertEqual(t, nullable.NullablePtr(nullable.Null[int]()), nil)
+	tests.AssertEqual(t, nullable.NullableValue(nullable.NewNullable(&basic)), basic)
+	tests.AssertEqual(t, nullable.NullableValue(nullable.Null[int]()), 0)
+}
+
+func TestNullableFromSQL(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullableFromSQL(sql.Null[uint64]{V: math.MaxUint64, Valid: true}), nullable.NullableFrom(uint64(math.MaxUint64)))
+	tests.AssertEqual(t, nullable.NullableFromSQL(sql.Null[uint64]{V: 1}), nullable.Null[uint64]())
+
+	tests.AssertEqual(t, nullable.NullableFrom("Hello World!").ToSQL(), sql.Null[string]{V: "Hello World!", Valid: true})
+	tests.AssertEqual(t, nullable.Null[string]().ToSQL(), sql.Null[string]{})
+}
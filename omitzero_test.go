@@ -0,0 +1,42 @@
+//go:build go1.24
+
+package nullable_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestJSONOmitZero(t *testing.T) {
+	type profile struct {
+		Name  nullable.String        `json:"name,omitzero"`
+		Age   nullable.Uint8         `json:"age,omitzero"`
+		Tags  nullable.Slice[int]    `json:"tags,omitzero"`
+		Score nullable.Nullable[int] `json:"score,omitzero"`
+		Note  nullable.String        `json:"note,omitempty"`
+	}
+
+	cases := []struct {
+		value      profile
+		serialized string
+	}{
+		{profile{}, `{"note":null}`},
+		{
+			profile{
+				Name:  nullable.StringFrom(""),
+				Age:   nullable.Uint8From(0),
+				Tags:  nullable.SliceFrom([]int{}),
+				Score: nullable.NullableFrom(0),
+			},
+			`{"name":"","age":0,"tags":[],"score":0,"note":null}`,
+		},
+	}
+	for _, c := range cases {
+		serialized, err := json.Marshal(c.value)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(serialized), c.serialized)
+	}
+}
@@ -0,0 +1,29 @@
+package nullable
+
+import (
+	"fmt"
+	"strings"
+)
+
+// parsePGArray splits the text form of a one-dimensional PostgreSQL array of
+// numbers, such as {1,2,3}, into its elements. {} has no elements.
+func parsePGArray(text string) ([]string, error) {
+	text = strings.TrimSpace(text)
+	if len(text) < 2 || text[0] != '{' || text[len(text)-1] != '}' {
+		return nil, fmt.Errorf("nullable: %q is not a PostgreSQL array", text)
+	}
+	inner := text[1 : len(text)-1]
+	if strings.TrimSpace(inner) == "" {
+		return []string{}, nil
+	}
+	elements := strings.Split(inner, ",")
+	for i, element := range elements {
+		elements[i] = strings.TrimSpace(element)
+	}
+	return elements, nil
+}
+
+// formatPGArray joins elements into PostgreSQL array text form
+func formatPGArray(elements []string) string {
+	return "{" + strings.Join(elements, ",") + "}"
+}
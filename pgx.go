@@ -0,0 +1,186 @@
+package nullable
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// The Int64Value and ScanInt64 methods let jackc/pgx bind and read the
+// integer types in its binary format, instead of going through Value and
+// Scan. Value is still what lib/pq and other database/sql drivers use. Uint64
+// is stored in numeric columns on PostgreSQL, so it has NumericValue and
+// ScanNumeric as well.
+
+// Int64Value implements pgtype.Int64Valuer
+func (n Int) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.realValue), Valid: n.isValid}, nil
+}
+
+// ScanInt64 implements pgtype.Int64Scanner
+func (n *Int) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		n.SetNull()
+		return nil
+	}
+	return n.Scan(v.Int64)
+}
+
+// Int64Value implements pgtype.Int64Valuer
+func (n Int8) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.realValue), Valid: n.isValid}, nil
+}
+
+// ScanInt64 implements pgtype.Int64Scanner
+func (n *Int8) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		n.SetNull()
+		return nil
+	}
+	return n.Scan(v.Int64)
+}
+
+// Int64Value implements pgtype.Int64Valuer
+func (n Int16) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.realValue), Valid: n.isValid}, nil
+}
+
+// ScanInt64 implements pgtype.Int64Scanner
+func (n *Int16) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		n.SetNull()
+		return nil
+	}
+	return n.Scan(v.Int64)
+}
+
+// Int64Value implements pgtype.Int64Valuer
+func (n Int32) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.realValue), Valid: n.isValid}, nil
+}
+
+// ScanInt64 implements pgtype.Int64Scanner
+func (n *Int32) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		n.SetNull()
+		return nil
+	}
+	return n.Scan(v.Int64)
+}
+
+// Int64Value implements pgtype.Int64Valuer
+func (n Int64) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: n.realValue, Valid: n.isValid}, nil
+}
+
+// ScanInt64 implements pgtype.Int64Scanner
+func (n *Int64) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		n.SetNull()
+		return nil
+	}
+	return n.Scan(v.Int64)
+}
+
+// Int64Value implements pgtype.Int64Valuer
+func (n Uint8) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.realValue), Valid: n.isValid}, nil
+}
+
+// ScanInt64 implements pgtype.Int64Scanner
+func (n *Uint8) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		n.SetNull()
+		return nil
+	}
+	return n.Scan(v.Int64)
+}
+
+// Int64Value implements pgtype.Int64Valuer
+func (n Uint16) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.realValue), Valid: n.isValid}, nil
+}
+
+// ScanInt64 implements pgtype.Int64Scanner
+func (n *Uint16) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		n.SetNull()
+		return nil
+	}
+	return n.Scan(v.Int64)
+}
+
+// Int64Value implements pgtype.Int64Valuer
+func (n Uint32) Int64Value() (pgtype.Int8, error) {
+	return pgtype.Int8{Int64: int64(n.realValue), Valid: n.isValid}, nil
+}
+
+// ScanInt64 implements pgtype.Int64Scanner
+func (n *Uint32) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		n.SetNull()
+		return nil
+	}
+	return n.Scan(v.Int64)
+}
+
+// Int64Value implements pgtype.Int64Valuer, failing for values above
+// math.MaxInt64 that don't fit a bigint
+func (n Uint64) Int64Value() (pgtype.Int8, error) {
+	if n.isValid && n.realValue > math.MaxInt64 {
+		return pgtype.Int8{}, withSentinel(ErrOverflow, fmt.Errorf("nullable: Uint64 %d does not fit a PostgreSQL bigint", n.realValue))
+	}
+	return pgtype.Int8{Int64: int64(n.realValue), Valid: n.isValid}, nil
+}
+
+// ScanInt64 implements pgtype.Int64Scanner
+func (n *Uint64) ScanInt64(v pgtype.Int8) error {
+	if !v.Valid {
+		n.SetNull()
+		return nil
+	}
+	return n.Scan(v.Int64)
+}
+
+// NumericValue implements pgtype.NumericValuer, so the whole uint64 range
+// binds to numeric columns in binary
+func (n Uint64) NumericValue() (pgtype.Numeric, error) {
+	if !n.isValid {
+		return pgtype.Numeric{}, nil
+	}
+	return pgtype.Numeric{Int: new(big.Int).SetUint64(n.realValue), Valid: true}, nil
+}
+
+// ScanNumeric implements pgtype.NumericScanner, failing on NaN, infinity,
+// fractions, and numbers out of the uint64 range
+func (n *Uint64) ScanNumeric(v pgtype.Numeric) error {
+	if !v.Valid {
+		n.SetNull()
+		return nil
+	}
+	if v.NaN || v.InfinityModifier != pgtype.Finite {
+		return withSentinel(ErrInvalidType, fmt.Errorf("nullable: cannot scan non-finite numeric into Uint64"))
+	}
+
+	value := new(big.Int)
+	if v.Int != nil {
+		value.Set(v.Int)
+	}
+	if v.Exp > 0 {
+		value.Mul(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(v.Exp)), nil))
+	} else if v.Exp < 0 {
+		var remainder big.Int
+		value.QuoRem(value, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-v.Exp)), nil), &remainder)
+		if remainder.Sign() != 0 {
+			return withSentinel(ErrInvalidType, fmt.Errorf("nullable: cannot scan numeric with fractional part into Uint64"))
+		}
+	}
+	if value.Sign() < 0 || !value.IsUint64() {
+		return withSentinel(ErrOverflow, fmt.Errorf("nullable: numeric %s is out of Uint64 range", value))
+	}
+
+	n.SetValue(value.Uint64())
+	return nil
+}
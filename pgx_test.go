@@ -0,0 +1,122 @@
+package nullable_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestPgxBinaryInt64(t *testing.T) {
+	m := pgtype.NewMap()
+	for _, value := range []nullable.Int64{nullable.Int64From(math.MaxInt64), nullable.Int64From(math.MinInt64), nullable.Int64From(0), nullable.NullInt64()} {
+		encoded, err := m.Encode(pgtype.Int8OID, pgtype.BinaryFormatCode, value, nil)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, encoded == nil, value.IsNull())
+
+		var decoded nullable.Int64
+		tests.AssertEqual(t, m.Scan(pgtype.Int8OID, pgtype.BinaryFormatCode, encoded, &decoded), nil)
+		tests.AssertEqual(t, decoded, value)
+	}
+
+	// The narrower types refuse values they cannot hold
+	encoded, err := m.Encode(pgtype.Int4OID, pgtype.BinaryFormatCode, nullable.Int32From(math.MaxInt32), nil)
+	tests.AssertEqual(t, err, nil)
+	var narrow nullable.Int16
+	tests.AssertEqual(t, m.Scan(pgtype.Int4OID, pgtype.BinaryFormatCode, encoded, &narrow) != nil, true)
+
+	encoded, err = m.Encode(pgtype.Int2OID, pgtype.BinaryFormatCode, nullable.Int16From(-1), nil)
+	tests.AssertEqual(t, err, nil)
+	var unsigned nullable.Uint16
+	tests.AssertEqual(t, m.Scan(pgtype.Int2OID, pgtype.BinaryFormatCode, encoded, &unsigned) != nil, true)
+}
+
+func TestPgxBinaryUint64(t *testing.T) {
+	m := pgtype.NewMap()
+	for _, value := range []nullable.Uint64{nullable.Uint64From(math.MaxUint64), nullable.Uint64From(0), nullable.Uint64From(1 << 63), nullable.NullUint64()} {
+		encoded, err := m.Encode(pgtype.NumericOID, pgtype.BinaryFormatCode, value, nil)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, encoded == nil, value.IsNull())
+
+		var decoded nullable.Uint64
+		tests.AssertEqual(t, m.Scan(pgtype.NumericOID, pgtype.BinaryFormatCode, encoded, &decoded), nil)
+		tests.AssertEqual(t, decoded, value)
+	}
+
+	// A bigint column cannot hold the upper half of the range
+	_, err := m.Encode(pgtype.Int8OID, pgtype.BinaryFormatCode, nullable.Uint64From(math.MaxUint64), nil)
+	tests.AssertEqual(t, err != nil, true)
+
+	var decoded nullable.Uint64
+	for _, malformed := range []string{"-1", "1.5", "18446744073709551616", "NaN"} {
+		var numeric pgtype.Numeric
+		tests.AssertEqual(t, numeric.Scan(malformed), nil)
+		if err := decoded.ScanNumeric(numeric); err == nil {
+			t.Errorf("scanning numeric %s must fail", malformed)
+		}
+	}
+
+	var scaled pgtype.Numeric
+	tests.AssertEqual(t, scaled.Scan("42.000"), nil)
+	tests.AssertEqual(t, decoded.ScanNumeric(scaled), nil)
+	tests.AssertEqual(t, decoded, nullable.Uint64From(42))
+}
+
+func TestPgx(t *testing.T) {
+	if !SupportedDriver("postgres") {
+		t.Skip("pgx needs a PostgreSQL connection")
+	}
+
+	sqlDB, err := DB.DB()
+	if err != nil {
+		t.Fatalf("Cannot get database connection: %v", err)
+	}
+	conn, err := sqlDB.Conn(context.Background())
+	if err != nil {
+		t.Fatalf("Cannot get database connection: %v", err)
+	}
+	defer conn.Close()
+
+	err = conn.Raw(func(driverConn interface{}) error {
+		pgxConn := driverConn.(*stdlib.Conn).Conn()
+		ctx := context.Background()
+		if _, err := pgxConn.Exec(ctx, "CREATE TEMPORARY TABLE test_nullable_pgx (signed bigint, unsigned numeric)"); err != nil {
+			return err
+		}
+		defer pgxConn.Exec(ctx, "DROP TABLE test_nullable_pgx")
+
+		rows := []struct {
+			signed   nullable.Int64
+			unsigned nullable.Uint64
+		}{
+			{nullable.Int64From(math.MaxInt64), nullable.Uint64From(math.MaxUint64)},
+			{nullable.Int64From(math.MinInt64), nullable.Uint64From(0)},
+			{nullable.NullInt64(), nullable.NullUint64()},
+		}
+		for _, row := range rows {
+			if _, err := pgxConn.Exec(ctx, "DELETE FROM test_nullable_pgx"); err != nil {
+				return err
+			}
+			if _, err := pgxConn.Exec(ctx, "INSERT INTO test_nullable_pgx VALUES ($1, $2)", row.signed, row.unsigned); err != nil {
+				return err
+			}
+
+			var signed nullable.Int64
+			var unsigned nullable.Uint64
+			if err := pgxConn.QueryRow(ctx, "SELECT signed, unsigned FROM test_nullable_pgx", pgx.QueryResultFormats{pgx.BinaryFormatCode}).Scan(&signed, &unsigned); err != nil {
+				return err
+			}
+			tests.AssertEqual(t, signed, row.signed)
+			tests.AssertEqual(t, unsigned, row.unsigned)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Errorf("pgx round trip failed: %v", err)
+	}
+}
@@ -0,0 +1,679 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// pointSRID is the spatial reference of every Point, WGS 84 longitude and
+// latitude in degrees
+const pointSRID = 4326
+
+// Point SQL type that can retrieve NULL value of a longitude and latitude in
+// degrees. It is stored as the extended WKT text "SRID=4326;POINT(lon lat)",
+// in a geometry(Point,4326) column on PostgreSQL, and marshalled into JSON as
+// a GeoJSON point.
+type Point struct {
+	longitude float64
+	latitude  float64
+	isValid   bool
+}
+
+// PointValue is the longitude and latitude a valid Point holds, what Get
+// and MustGet return
+type PointValue struct {
+	Longitude float64
+	Latitude  float64
+}
+
+// PointFrom creates a new valid nullable point. It panics when longitude is
+// not within -180 and 180 or latitude not within -90 and 90, since that is a
+// mistake in the calling code.
+func PointFrom(longitude, latitude float64) Point {
+	if err := checkPoint(longitude, latitude); err != nil {
+		panic(err)
+	}
+	return Point{
+		longitude: longitude,
+		latitude:  latitude,
+		isValid:   true,
+	}
+}
+
+// NullPoint creates a new NULL point
+func NullPoint() Point {
+	return Point{}
+}
+
+// ParsePoint parses WKT text like "POINT(13.4 52.5)", with or without the
+// "SRID=4326;" prefix, empty text is NULL
+func ParsePoint(text string) (Point, error) {
+	if len(text) == 0 {
+		return NullPoint(), nil
+	}
+	longitude, latitude, err := parsePointWKT(text)
+	if err != nil {
+		return Point{}, err
+	}
+	if err := checkPoint(longitude, latitude); err != nil {
+		return Point{}, err
+	}
+	return PointFrom(longitude, latitude), nil
+}
+
+// MustParsePoint is ParsePoint that panics when text is malformed. It is
+// meant for test fixtures and package-level values, never for user input.
+func MustParsePoint(text string) Point {
+	n, err := ParsePoint(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParsePoint(%q): %v", text, err))
+	}
+	return n
+}
+
+// Longitude either zero or longitude in degrees
+func (n Point) Longitude() float64 {
+	return n.longitude
+}
+
+// Latitude either zero or latitude in degrees
+func (n Point) Latitude() float64 {
+	return n.latitude
+}
+
+// Get either nil or longitude and latitude
+func (n Point) Get() *PointValue {
+	if !n.isValid {
+		return nil
+	}
+	return &PointValue{Longitude: n.longitude, Latitude: n.latitude}
+}
+
+// Unwrap returns longitude and latitude and true, or zero value and false
+// when NULL, the comma-ok way without the allocation of Get
+func (n Point) Unwrap() (PointValue, bool) {
+	if !n.isValid {
+		return PointValue{}, false
+	}
+	return PointValue{Longitude: n.longitude, Latitude: n.latitude}, true
+}
+
+// Set sets longitude and latitude and marks it as not NULL. Coordinates out
+// of range are rejected and leave the value unchanged.
+func (n *Point) Set(longitude, latitude float64) error {
+	if err := checkPoint(longitude, latitude); err != nil {
+		return err
+	}
+	*n = PointFrom(longitude, latitude)
+	return nil
+}
+
+// SetValue sets longitude and latitude and marks it as not NULL, the same as Set
+func (n *Point) SetValue(longitude, latitude float64) error {
+	return n.Set(longitude, latitude)
+}
+
+// Swap sets longitude and latitude like Set does and returns the previous value, nil
+// when it was NULL. Values Set rejects are rejected with nil and leave the
+// value unchanged.
+func (n *Point) Swap(longitude, latitude float64) (*PointValue, error) {
+	previous := n.Get()
+	if err := n.Set(longitude, latitude); err != nil {
+		return nil, err
+	}
+	return previous, nil
+}
+
+// SwapNull marks the value as NULL like SetNull does and returns the
+// previous value, nil when it was NULL
+func (n *Point) SwapNull() *PointValue {
+	previous := n.Get()
+	n.SetNull()
+	return previous
+}
+
+// SetNull marks the value as NULL
+func (n *Point) SetNull() {
+	*n = NullPoint()
+}
+
+// Reset sets the value back to the zero NULL Point, the one a declared
+// Point starts with, so pooled values can be reused
+func (n *Point) Reset() {
+	*n = Point{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Point) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// IsValid reports whether the value is not NULL
+func (n Point) IsValid() bool {
+	return n.isValid
+}
+
+// IsNull reports whether the value is NULL
+func (n Point) IsNull() bool {
+	return !n.isValid
+}
+
+// IsZero reports whether the value is NULL, so `json:",omitzero"` omits it.
+// A valid point at 0, 0 is not zero here.
+func (n Point) IsZero() bool {
+	return !n.isValid
+}
+
+// Validate runs fns on longitude and latitude unless NULL and joins their
+// errors, nil when all of them pass or the value is NULL
+func (n Point) Validate(fns ...func(PointValue) error) error {
+	return validate(n.isValid, PointValue{Longitude: n.longitude, Latitude: n.latitude}, fns)
+}
+
+// GetOr either fallback or longitude and latitude
+func (n Point) GetOr(fallback PointValue) PointValue {
+	if !n.isValid {
+		return fallback
+	}
+	return PointValue{Longitude: n.longitude, Latitude: n.latitude}
+}
+
+// GetOrZero either zero value or longitude and latitude
+func (n Point) GetOrZero() PointValue {
+	return n.GetOr(PointValue{})
+}
+
+// MustGet either longitude and latitude or panic when NULL
+func (n Point) MustGet() PointValue {
+	if !n.isValid {
+		panic("nullable: MustGet called on NULL Point")
+	}
+	return PointValue{Longitude: n.longitude, Latitude: n.latitude}
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Point) Clone() Point {
+	return n
+}
+
+// String returns the point such as "SRID=4326;POINT(13.4 52.5)", or
+// "<null>" when NULL
+func (n Point) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return "SRID=" + strconv.Itoa(pointSRID) + ";POINT(" + formatCoordinate(n.longitude) + " " + formatCoordinate(n.latitude) + ")"
+}
+
+// DebugString returns Point(SRID=4326;POINT(13.4 52.5)) or Point(NULL),
+// telling NULL apart from a point at 0, 0 in logs
+func (n Point) DebugString() string {
+	return debugString("Point", n.isValid, n.String())
+}
+
+// LogValue implements slog.LogValuer, logging NULL as nil and the value as
+// its extended WKT like String
+func (n Point) LogValue() slog.Value {
+	return logText(n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same
+// longitude and latitude
+func (n Point) Equal(other Point) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.longitude == other.longitude && n.latitude == other.latitude
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Point) Changed(old Point) bool {
+	return !n.Equal(old)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL 8 big-endian bytes of longitude then of latitude, telling NULL apart
+// from zero in cache keys
+func (n Point) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagPoint, n.isValid, append(hashFloat(n.longitude), hashFloat(n.latitude)...))
+}
+
+// pointJSON is the GeoJSON object of a valid Point, in JSON and in YAML
+type pointJSON struct {
+	Type        string    `json:"type" yaml:"type"`
+	Coordinates []float64 `json:"coordinates" yaml:"coordinates,flow"`
+}
+
+// MarshalJSON converts current value to JSON, a GeoJSON point such as
+// {"type":"Point","coordinates":[13.4,52.5]}
+func (n Point) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(pointJSON{
+		Type:        "Point",
+		Coordinates: []float64{n.longitude, n.latitude},
+	})
+}
+
+// UnmarshalJSON writes JSON to this type, a GeoJSON point whose longitude
+// and latitude have to be in range. An altitude is refused rather than
+// silently dropped.
+func (n *Point) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed pointJSON
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+	return jsonError(n.setGeoJSON(parsed, "JSON "+string(data)))
+}
+
+// MarshalText converts current value to extended WKT text such as
+// "SRID=4326;POINT(13.4 52.5)", NULL is empty text
+func (n Point) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText writes WKT text to this type, empty text is NULL
+func (n *Point) UnmarshalText(text []byte) error {
+	parsed, err := ParsePoint(string(text))
+	if err != nil {
+		return err
+	}
+	*n = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Point) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Point) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Point) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return n.String(), false
+}
+
+// MarshalYAML converts current value to YAML, a GeoJSON point like JSON
+func (n Point) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return pointJSON{
+		Type:        "Point",
+		Coordinates: []float64{n.longitude, n.latitude},
+	}, nil
+}
+
+// UnmarshalYAML writes YAML to this type, a GeoJSON point checked like JSON
+func (n *Point) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed pointJSON
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+	return n.setGeoJSON(parsed, fmt.Sprintf("YAML at line %d", value.Line))
+}
+
+// MarshalXML converts current value to XML, extended WKT text such as
+// "SRID=4326;POINT(13.4 52.5)"
+func (n Point) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.String(), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Point) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+	return n.parse(text)
+}
+
+// EncodeMsgpack converts current value to MessagePack, extended WKT text
+// such as "SRID=4326;POINT(13.4 52.5)"
+func (n Point) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(n.String())
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Point) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	text, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+	return n.parse(text)
+}
+
+// MarshalBSONValue converts current value to BSON, extended WKT text such
+// as "SRID=4326;POINT(13.4 52.5)". NULL is BSON null.
+func (n Point) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.String())
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Point) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.SetNull()
+		return nil
+	}
+	if t != bson.TypeString {
+		return bsonTypeError(t, "Point")
+	}
+	text, _, ok := bsoncore.ReadString(data)
+	if !ok {
+		return bsonTypeError(t, "Point")
+	}
+	return n.parse(text)
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero
+// value. The coordinates are written in the fewest digits that read back the
+// same, so they round trip exactly.
+func (n Point) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.String())
+}
+
+// GobDecode writes gob to this type
+func (n *Point) GobDecode(data []byte) error {
+	var text string
+	isValid, err := gobDecode(data, &text)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.SetNull()
+		return nil
+	}
+	return n.parse(text)
+}
+
+// Scan implements scanner interface, reading WKT text such as
+// "SRID=4326;POINT(13.4 52.5)" or the hex EWKB PostGIS gives for geometry
+// columns
+func (n *Point) Scan(value interface{}) error {
+	value = scanValue(value)
+	if value == nil {
+		n.SetNull()
+		return nil
+	}
+
+	var scanned string
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
+	}
+
+	var longitude, latitude float64
+	var err error
+	switch {
+	case len(scanned) > 0 && (scanned[0] == 0 || scanned[0] == 1):
+		// Binary EWKB starts with its byte order
+		longitude, latitude, err = parsePointEWKB([]byte(scanned))
+	case strings.Contains(scanned, "("):
+		longitude, latitude, err = parsePointWKT(scanned)
+	default:
+		var decoded []byte
+		if decoded, err = hex.DecodeString(strings.TrimSpace(scanned)); err != nil {
+			err = fmt.Errorf("nullable: invalid Point %q, expected WKT or hex EWKB", scanned)
+			break
+		}
+		longitude, latitude, err = parsePointEWKB(decoded)
+	}
+	if err == nil {
+		err = checkPoint(longitude, latitude)
+	}
+	if err != nil {
+		return scanError(err)
+	}
+
+	*n = PointFrom(longitude, latitude)
+	return nil
+}
+
+// Value implements the driver Valuer interface, writing extended WKT text
+// such as "SRID=4326;POINT(13.4 52.5)"
+func (n Point) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.String(), nil
+}
+
+// GormDataType gorm common data type
+func (Point) GormDataType() string {
+	return "point_null"
+}
+
+// GormDBDataType gorm db data type
+func (n Point) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Point) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field. Only
+// PostgreSQL, with PostGIS, and CockroachDB get a spatial column, the other
+// dialects store the WKT text.
+func (Point) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
+	case "sqlite", "mysql":
+		return "VARCHAR(100)"
+	case "postgres":
+		return "geometry(Point,4326)"
+	case "cockroachdb":
+		return "GEOMETRY(POINT,4326)"
+	case "sqlserver":
+		return "NVARCHAR(100)"
+	case "clickhouse":
+		return "Nullable(String)"
+	}
+	return ""
+}
+
+// formatCoordinate writes a coordinate in the fewest digits that read back
+// the same, never in exponent form
+func formatCoordinate(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// parsePointWKT parses "POINT(lon lat)", case-insensitive and with or
+// without the "SRID=4326;" prefix
+func parsePointWKT(text string) (float64, float64, error) {
+	malformed := fmt.Errorf("nullable: invalid Point %q, expected WKT such as \"SRID=4326;POINT(13.4 52.5)\"", text)
+
+	rest := strings.TrimSpace(text)
+	if srid, geometry, found := strings.Cut(rest, ";"); found {
+		code, ok := strings.CutPrefix(strings.ToUpper(strings.TrimSpace(srid)), "SRID=")
+		if !ok {
+			return 0, 0, malformed
+		}
+		if code != strconv.Itoa(pointSRID) {
+			return 0, 0, fmt.Errorf("nullable: Point %q is not in SRID %d", text, pointSRID)
+		}
+		rest = strings.TrimSpace(geometry)
+	}
+	if len(rest) < 5 || !strings.EqualFold(rest[:5], "POINT") {
+		return 0, 0, malformed
+	}
+	rest = strings.TrimSpace(rest[5:])
+	if !strings.HasPrefix(rest, "(") || !strings.HasSuffix(rest, ")") {
+		return 0, 0, malformed
+	}
+
+	fields := strings.Fields(rest[1 : len(rest)-1])
+	if len(fields) != 2 {
+		return 0, 0, malformed
+	}
+	longitude, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, 0, malformed
+	}
+	latitude, err := strconv.ParseFloat(fields[1], 64)
+	if err != nil {
+		return 0, 0, malformed
+	}
+	return longitude, latitude, nil
+}
+
+// EWKB flags PostGIS sets in the geometry type
+const (
+	ewkbFlagZ    = 0x80000000
+	ewkbFlagM    = 0x40000000
+	ewkbFlagSRID = 0x20000000
+)
+
+// parsePointEWKB parses a two-dimensional point in WKB, or in the EWKB of
+// PostGIS when it carries an SRID
+func parsePointEWKB(data []byte) (float64, float64, error) {
+	malformed := fmt.Errorf("nullable: invalid Point EWKB %x", data)
+	if len(data) < 5 {
+		return 0, 0, malformed
+	}
+
+	var order binary.ByteOrder
+	switch data[0] {
+	case 0:
+		order = binary.BigEndian
+	case 1:
+		order = binary.LittleEndian
+	default:
+		return 0, 0, malformed
+	}
+	geometry := order.Uint32(data[1:5])
+	rest := data[5:]
+	if geometry&(ewkbFlagZ|ewkbFlagM) != 0 || geometry&0xffff != 1 {
+		return 0, 0, fmt.Errorf("nullable: Point EWKB %x is not a two-dimensional point", data)
+	}
+	if geometry&ewkbFlagSRID != 0 {
+		if len(rest) < 4 {
+			return 0, 0, malformed
+		}
+		if srid := order.Uint32(rest); srid != pointSRID {
+			return 0, 0, fmt.Errorf("nullable: Point EWKB %x is in SRID %d, not %d", data, srid, pointSRID)
+		}
+		rest = rest[4:]
+	}
+	if len(rest) != 16 {
+		return 0, 0, malformed
+	}
+	return math.Float64frombits(order.Uint64(rest)), math.Float64frombits(order.Uint64(rest[8:])), nil
+}
+
+// setGeoJSON sets the coordinates of a decoded GeoJSON point, source
+// naming where it came from in errors
+func (n *Point) setGeoJSON(parsed pointJSON, source string) error {
+	if parsed.Type != "Point" {
+		return fmt.Errorf("nullable: Point %s is not a GeoJSON point", source)
+	}
+	if len(parsed.Coordinates) != 2 {
+		return fmt.Errorf("nullable: Point %s needs exactly longitude and latitude", source)
+	}
+	return n.Set(parsed.Coordinates[0], parsed.Coordinates[1])
+}
+
+// parse sets WKT text such as "SRID=4326;POINT(13.4 52.5)", which unlike
+// for ParsePoint cannot be empty, and leaves the value unchanged on error
+func (n *Point) parse(text string) error {
+	if len(text) == 0 {
+		return fmt.Errorf("nullable: invalid Point %q, expected WKT such as \"SRID=4326;POINT(13.4 52.5)\"", text)
+	}
+	parsed, err := ParsePoint(text)
+	if err != nil {
+		return err
+	}
+	*n = parsed
+	return nil
+}
+
+// checkPoint returns an error unless longitude is within -180 and 180 and
+// latitude within -90 and 90 degrees. NaN is never in range.
+func checkPoint(longitude, latitude float64) error {
+	if !(longitude >= -180 && longitude <= 180) {
+		return fmt.Errorf("nullable: longitude %v of Point is not within -180 and 180", longitude)
+	}
+	if !(latitude >= -90 && latitude <= 90) {
+		return fmt.Errorf("nullable: latitude %v of Point is not within -90 and 90", latitude)
+	}
+	return nil
+}
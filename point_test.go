@@ -0,0 +1,288 @@
+package nullable_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestPointFrom(t *testing.T) {
+	berlin := nullable.PointFrom(13.4, 52.5)
+	tests.AssertEqual(t, berlin.IsValid(), true)
+	tests.AssertEqual(t, berlin.Longitude(), 13.4)
+	tests.AssertEqual(t, berlin.Latitude(), 52.5)
+	tests.AssertEqual(t, berlin.String(), "SRID=4326;POINT(13.4 52.5)")
+	tests.AssertEqual(t, nullable.NullPoint().IsNull(), true)
+	tests.AssertEqual(t, nullable.NullPoint().String(), "<null>")
+	tests.AssertEqual(t, nullable.PointFrom(0, 0).IsZero(), false)
+
+	for _, coordinates := range [][2]float64{{180.5, 0}, {0, -90.5}, {math.NaN(), 0}, {0, math.Inf(1)}} {
+		func() {
+			defer func() {
+				tests.AssertEqual(t, recover() != nil, true)
+			}()
+			nullable.PointFrom(coordinates[0], coordinates[1])
+		}()
+	}
+}
+
+func TestSetPoint(t *testing.T) {
+	var point nullable.Point
+	tests.AssertEqual(t, point.Set(-180, 90), nil)
+	tests.AssertEqual(t, point, nullable.PointFrom(-180, 90))
+
+	// Coordinates out of range leave the value unchanged
+	tests.AssertEqual(t, point.Set(0, 100) != nil, true)
+	tests.AssertEqual(t, point, nullable.PointFrom(-180, 90))
+
+	point.SetNull()
+	tests.AssertEqual(t, point.IsNull(), true)
+}
+
+func TestSwapPoint(t *testing.T) {
+	var point nullable.Point
+	previous, err := point.Swap(-180, 90)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, previous == nil, true)
+	tests.AssertEqual(t, point, nullable.PointFrom(-180, 90))
+
+	// Coordinates out of range leave the value unchanged
+	previous, err = point.Swap(0, 100)
+	tests.AssertEqual(t, err != nil, true)
+	tests.AssertEqual(t, previous == nil, true)
+	tests.AssertEqual(t, point, nullable.PointFrom(-180, 90))
+
+	previous = point.SwapNull()
+	tests.AssertEqual(t, *previous, nullable.PointValue{Longitude: -180, Latitude: 90})
+	tests.AssertEqual(t, point.IsNull(), true)
+	tests.AssertEqual(t, point.SwapNull() == nil, true)
+
+	tests.AssertEqual(t, point.SetValue(13.4, 52.5), nil)
+	tests.AssertEqual(t, point, nullable.PointFrom(13.4, 52.5))
+	tests.AssertEqual(t, point.SetValue(200, 0) != nil, true)
+	tests.AssertEqual(t, point, nullable.PointFrom(13.4, 52.5))
+}
+
+func TestParsePoint(t *testing.T) {
+	for _, text := range []string{"POINT(13.4 52.5)", "SRID=4326;POINT(13.4 52.5)", " srid=4326; point ( 13.4  52.5 ) "} {
+		parsed, err := nullable.ParsePoint(text)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, parsed, nullable.PointFrom(13.4, 52.5))
+	}
+
+	parsed, err := nullable.ParsePoint("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.IsNull(), true)
+
+	for _, text := range []string{"13.4 52.5", "POINT(13.4)", "POINT(1 2 3)", "LINESTRING(0 0, 1 1)", "SRID=3857;POINT(1 2)", "POINT(200 0)"} {
+		if _, err := nullable.ParsePoint(text); err == nil {
+			t.Errorf("parsing %q must fail", text)
+		}
+	}
+	tests.AssertEqual(t, nullable.MustParsePoint("POINT(1 2)"), nullable.PointFrom(1, 2))
+}
+
+func TestEqualPoint(t *testing.T) {
+	point := nullable.PointFrom(1, 2)
+	tests.AssertEqual(t, point.Equal(nullable.PointFrom(1, 2)), true)
+	tests.AssertEqual(t, point.Equal(nullable.PointFrom(2, 1)), false)
+	tests.AssertEqual(t, point.Equal(nullable.NullPoint()), false)
+	tests.AssertEqual(t, nullable.NullPoint().Equal(nullable.NullPoint()), true)
+	tests.AssertEqual(t, point.Changed(nullable.NullPoint()), true)
+}
+
+func TestScanPoint(t *testing.T) {
+	ewkb := "0101000020E6100000CDCCCCCCCCCC2A400000000000404A40"
+	binaryEWKB, _ := hex.DecodeString(ewkb)
+	bigEndianWKB, _ := hex.DecodeString("0000000001bfc051eb851eb8524049c0eca686e7e6")
+	cases := []struct {
+		value    interface{}
+		expected nullable.Point
+	}{
+		{"SRID=4326;POINT(13.4 52.5)", nullable.PointFrom(13.4, 52.5)},
+		{[]byte("POINT(13.4 52.5)"), nullable.PointFrom(13.4, 52.5)},
+		{ewkb, nullable.PointFrom(13.4, 52.5)},
+		{[]byte(ewkb), nullable.PointFrom(13.4, 52.5)},
+		{binaryEWKB, nullable.PointFrom(13.4, 52.5)},
+		{bigEndianWKB, nullable.PointFrom(-0.1275, 51.507222)},
+		{nil, nullable.NullPoint()},
+	}
+	for _, c := range cases {
+		var point nullable.Point
+		tests.AssertEqual(t, point.Scan(c.value), nil)
+		tests.AssertEqual(t, point, c.expected)
+	}
+
+	for _, value := range []interface{}{
+		"", "not a point", "0101",
+		// A point with an altitude
+		"0101000080000000000000f03f00000000000000400000000000000840",
+		// A point in SRID 3857
+		"0101000020110f0000000000000000f03f0000000000000040",
+		"POINT(0 95)", int64(1),
+	} {
+		var point nullable.Point
+		err := point.Scan(value)
+		if !errors.Is(err, nullable.ErrInvalidType) {
+			t.Errorf("scanning %#v into Point: expected %v, got %v", value, nullable.ErrInvalidType, err)
+		}
+	}
+}
+
+func TestValuePoint(t *testing.T) {
+	value, err := nullable.PointFrom(-0.1275, 51.507222).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, "SRID=4326;POINT(-0.1275 51.507222)")
+
+	value, err = nullable.NullPoint().Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
+func TestJSONPoint(t *testing.T) {
+	serialized, err := json.Marshal(nullable.PointFrom(13.4, 52.5))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `{"type":"Point","coordinates":[13.4,52.5]}`)
+
+	serialized, err = json.Marshal(nullable.NullPoint())
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `null`)
+
+	var unserialized nullable.Point
+	tests.AssertEqual(t, json.Unmarshal([]byte(`{"coordinates":[-180,-90],"type":"Point"}`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized, nullable.PointFrom(-180, -90))
+
+	tests.AssertEqual(t, json.Unmarshal([]byte(`null`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.IsNull(), true)
+
+	for _, data := range []string{
+		`{"type":"Point","coordinates":[180.1,0]}`,
+		`{"type":"Point","coordinates":[0,-90.1]}`,
+		`{"type":"Point","coordinates":[1,2,3]}`,
+		`{"type":"Point","coordinates":[1]}`,
+		`{"type":"LineString","coordinates":[[0,0],[1,1]]}`,
+		`{"coordinates":[1,2]}`,
+		`[1,2]`,
+	} {
+		point := nullable.PointFrom(1, 1)
+		err := json.Unmarshal([]byte(data), &point)
+		if !errors.Is(err, nullable.ErrMalformedJSON) {
+			t.Errorf("unmarshalling %s into Point: expected %v, got %v", data, nullable.ErrMalformedJSON, err)
+		}
+		tests.AssertEqual(t, point, nullable.PointFrom(1, 1))
+	}
+}
+
+func TestGetPoint(t *testing.T) {
+	point := nullable.PointFrom(13.4, 52.5)
+	expected := nullable.PointValue{Longitude: 13.4, Latitude: 52.5}
+	tests.AssertEqual(t, *point.Get(), expected)
+	tests.AssertEqual(t, point.MustGet(), expected)
+	tests.AssertEqual(t, point.GetOr(nullable.PointValue{}), expected)
+	unwrapped, ok := point.Unwrap()
+	tests.AssertEqual(t, ok, true)
+	tests.AssertEqual(t, unwrapped, expected)
+
+	null := nullable.NullPoint()
+	tests.AssertEqual(t, null.Get() == nil, true)
+	fallback := nullable.PointValue{Longitude: -0.1, Latitude: 51.5}
+	tests.AssertEqual(t, null.GetOr(fallback), fallback)
+	tests.AssertEqual(t, null.GetOrZero(), nullable.PointValue{})
+	_, ok = null.Unwrap()
+	tests.AssertEqual(t, ok, false)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Point")
+	}()
+	null.MustGet()
+}
+
+func TestValidatePoint(t *testing.T) {
+	northern := func(value nullable.PointValue) error {
+		if value.Latitude < 0 {
+			return fmt.Errorf("latitude %v is south of the equator", value.Latitude)
+		}
+		return nil
+	}
+	tests.AssertEqual(t, nullable.PointFrom(13.4, 52.5).Validate(northern), nil)
+	tests.AssertEqual(t, nullable.NullPoint().Validate(northern), nil)
+	if err := nullable.PointFrom(151.2, -33.9).Validate(northern); err == nil {
+		t.Error("validating a southern point must fail")
+	}
+}
+
+func TestCodecsPoint(t *testing.T) {
+	for _, point := range []nullable.Point{nullable.PointFrom(0.1, -33.868820), nullable.PointFrom(0, 0), nullable.NullPoint()} {
+		marshalUnmarshalText(t, point)
+		marshalUnmarshalCSV(t, point)
+		marshalUnmarshalYAML(t, point)
+		marshalUnmarshalXML(t, point)
+		marshalUnmarshalMsgpack(t, point)
+		marshalUnmarshalBSON(t, point)
+		marshalUnmarshalGob(t, point)
+	}
+
+	serialized, err := yaml.Marshal(yamlEnvelope[nullable.Point]{Value: nullable.PointFrom(13.4, 52.5)})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "value:\n    type: Point\n    coordinates: [13.4, 52.5]\n")
+
+	var unserialized yamlEnvelope[nullable.Point]
+	for _, data := range []string{
+		"value: {type: Point, coordinates: [180.1, 0]}",
+		"value: {type: Point, coordinates: [1, 2, 3]}",
+		"value: {coordinates: [1, 2]}",
+		"value: POINT(1 2)",
+	} {
+		if err := yaml.Unmarshal([]byte(data), &unserialized); err == nil {
+			t.Errorf("unmarshalling %q as point must fail", data)
+		}
+	}
+
+	var point nullable.Point
+	if err := point.UnmarshalXML(xml.NewDecoder(strings.NewReader("<point></point>")), xml.StartElement{Name: xml.Name{Local: "point"}}); err == nil {
+		t.Error("unmarshalling an empty XML element as point must fail")
+	}
+}
+
+func TestPoint(t *testing.T) {
+	if SupportedDriver("postgres") && DB.Exec("CREATE EXTENSION IF NOT EXISTS postgis").Error != nil {
+		t.Skip("Point needs PostGIS on PostgreSQL")
+	}
+
+	type TestNullablePoint struct {
+		ID       uint
+		Name     string
+		Location nullable.Point
+	}
+
+	DB.Migrator().DropTable(&TestNullablePoint{})
+	if err := DB.Migrator().AutoMigrate(&TestNullablePoint{}); err != nil {
+		t.Errorf("failed to migrate nullable point, got error: %v", err)
+	}
+
+	london := TestNullablePoint{Name: "london", Location: nullable.PointFrom(-0.1275, 51.507222)}
+	DB.Create(&london)
+
+	origin := TestNullablePoint{Name: "origin", Location: nullable.PointFrom(0, 0)}
+	DB.Create(&origin)
+
+	unknown := TestNullablePoint{Name: "unknown", Location: nullable.NullPoint()}
+	DB.Create(&unknown)
+
+	for _, expected := range []TestNullablePoint{london, origin, unknown} {
+		var result TestNullablePoint
+		if err := DB.First(&result, "name = ?", expected.Name).Error; err != nil {
+			t.Fatalf("Cannot read point test record of %q", expected.Name)
+		}
+		tests.AssertEqual(t, result.Location, expected.Location)
+	}
+}
@@ -0,0 +1,98 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestReset(t *testing.T) {
+	nullableBytes := nullable.BytesFrom([]byte{0x1, 0x2})
+	nullableBytes.Reset()
+	if !reflect.DeepEqual(nullableBytes, nullable.Bytes{}) {
+		t.Errorf("reset Bytes must be the zero value, got %#v", nullableBytes)
+	}
+
+	nullableJSON := nullable.JSONFrom(json.RawMessage(`{"a":1}`))
+	nullableJSON.Reset()
+	if !reflect.DeepEqual(nullableJSON, nullable.JSON{}) {
+		t.Errorf("reset JSON must be the zero value, got %#v", nullableJSON)
+	}
+
+	nullableDecimal := nullable.DecimalFrom(decimal.RequireFromString("12.34"))
+	nullableDecimal.Reset()
+	if !reflect.DeepEqual(nullableDecimal, nullable.Decimal{}) {
+		t.Errorf("reset Decimal must be the zero value, got %#v", nullableDecimal)
+	}
+
+	// Unlike SetNull, Reset drops the location and epoch unit
+	nullableTime := nullable.TimeUnix()
+	nullableTime.SetValue(time.Unix(1700000000, 0))
+	nullableTime.SetNull()
+	nullableTime.SetValue(time.Unix(1700000000, 0))
+	serialized, err := json.Marshal(nullableTime)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "1700000000")
+	nullableTime.Reset()
+	if !reflect.DeepEqual(nullableTime, nullable.Time{}) {
+		t.Errorf("reset Time must be the zero value, got %#v", nullableTime)
+	}
+
+	nullableUint64 := nullable.Uint64From(42)
+	nullableUint64.Reset()
+	tests.AssertEqual(t, nullableUint64.IsNull(), true)
+	tests.AssertEqual(t, nullableUint64, nullable.Uint64{})
+
+	nullableStringTrimmed := nullable.StringTrimmedFrom("abc")
+	nullableStringTrimmed.Reset()
+	tests.AssertEqual(t, nullableStringTrimmed, nullable.StringTrimmed{})
+}
+
+type pooledRecord struct {
+	ID      nullable.Uint64
+	Payload nullable.Bytes
+	Meta    nullable.JSON
+	Price   nullable.Decimal
+}
+
+func (r *pooledRecord) Reset() {
+	r.ID.Reset()
+	r.Payload.Reset()
+	r.Meta.Reset()
+	r.Price.Reset()
+}
+
+func (r *pooledRecord) fill() {
+	r.ID.SetValue(42)
+	r.Payload.Scan([]byte{0x0, 0x7f, 0xff})
+	r.Meta.Scan(`{"name":"cat"}`)
+	r.Price.Scan("12.34")
+}
+
+var pooledSink *pooledRecord
+
+func BenchmarkFreshRecord(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		record := new(pooledRecord)
+		record.fill()
+		pooledSink = record
+	}
+}
+
+func BenchmarkPooledRecord(b *testing.B) {
+	pool := sync.Pool{New: func() any { return new(pooledRecord) }}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		record := pool.Get().(*pooledRecord)
+		record.fill()
+		record.Reset()
+		pool.Put(record)
+	}
+}
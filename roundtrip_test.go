@@ -0,0 +1,151 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"net"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+// cachedRecord holds every nullable type, the way a cached row would
+type cachedRecord struct {
+	BigInt        nullable.BigInt
+	Bool          nullable.Bool
+	Byte          nullable.Byte
+	Bytes         nullable.Bytes
+	Date          nullable.Date
+	Decimal       nullable.Decimal
+	Duration      nullable.Duration
+	DurationISO   nullable.DurationISO
+	Float32       nullable.Float32
+	Float64       nullable.Float64
+	Int           nullable.Int
+	Int8          nullable.Int8
+	Int16         nullable.Int16
+	Int32         nullable.Int32
+	Int64         nullable.Int64
+	Int64Array    nullable.Int64Array
+	IP            nullable.IP
+	JSON          nullable.JSON
+	Money         nullable.Money
+	Point         nullable.Point
+	Rune          nullable.Rune
+	String        nullable.String
+	StringTrimmed nullable.StringTrimmed
+	Time          nullable.Time
+	Uint          nullable.Uint
+	Uint8         nullable.Uint8
+	Uint16        nullable.Uint16
+	Uint32        nullable.Uint32
+	Uint64        nullable.Uint64
+	Uint64Array   nullable.Uint64Array
+	URL           nullable.URL
+	UUID          nullable.UUID
+	Nullable      nullable.Nullable[int]
+	Slice         nullable.Slice[string]
+	MapOf         nullable.MapOf[string, string]
+}
+
+func roundTripValid() cachedRecord {
+	return cachedRecord{
+		BigInt:        nullable.NewBigInt(new(big.Int).Lsh(big.NewInt(1), 100)),
+		Bool:          nullable.BoolFrom(false),
+		Byte:          nullable.ByteFrom(math.MaxUint8),
+		Bytes:         nullable.BytesFrom([]byte{}),
+		Date:          nullable.DateFrom(time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)),
+		Decimal:       nullable.DecimalFrom(decimal.RequireFromString("-12.345678901234567890")),
+		Duration:      nullable.DurationFrom(math.MinInt64),
+		DurationISO:   nullable.DurationISOFrom(math.MinInt64),
+		Float32:       nullable.Float32From(math.MaxFloat32),
+		Float64:       nullable.Float64From(math.SmallestNonzeroFloat64),
+		Int:           nullable.IntFrom(math.MinInt),
+		Int8:          nullable.Int8From(math.MinInt8),
+		Int16:         nullable.Int16From(math.MaxInt16),
+		Int32:         nullable.Int32From(math.MinInt32),
+		Int64:         nullable.Int64From(math.MaxInt64),
+		Int64Array:    nullable.Int64ArrayFrom([]int64{}),
+		IP:            nullable.IPFrom(net.ParseIP("2001:db8::1")),
+		JSON:          nullable.JSONFrom(json.RawMessage(`{"nested":null}`)),
+		Money:         nullable.MustParseMoney("0.10 USD"),
+		Point:         nullable.PointFrom(-180, 90),
+		Rune:          nullable.RuneFrom('世'),
+		String:        nullable.StringFrom(""),
+		StringTrimmed: nullable.StringTrimmedFrom("  padded  "),
+		Time:          nullable.TimeFrom(time.Date(2021, time.March, 4, 5, 6, 7, 123456789, time.UTC)),
+		Uint:          nullable.UintFrom(math.MaxUint),
+		Uint8:         nullable.Uint8From(0),
+		Uint16:        nullable.Uint16From(math.MaxUint16),
+		Uint32:        nullable.Uint32From(math.MaxUint32),
+		Uint64:        nullable.Uint64From(math.MaxUint64),
+		Uint64Array:   nullable.Uint64ArrayFrom([]uint64{math.MaxUint64}),
+		URL:           nullable.URLFrom(url.URL{Scheme: "https", Host: "example.com", Path: "/a b", RawQuery: "q=1"}),
+		UUID:          nullable.UUIDFrom(uuid.Nil),
+		Nullable:      nullable.NullableFrom(0),
+		Slice:         nullable.SliceFrom([]string{}),
+		MapOf:         nullable.MapOfFrom(map[string]string{}),
+	}
+}
+
+func TestJSONRoundTripEveryType(t *testing.T) {
+	valid := roundTripValid()
+
+	for _, record := range []cachedRecord{valid, {}} {
+		serialized, err := json.Marshal(record)
+		tests.AssertEqual(t, err, nil)
+
+		var unserialized cachedRecord
+		tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+		assertSameRecord(t, unserialized, record)
+
+		again, err := json.Marshal(unserialized)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(again), string(serialized))
+	}
+
+	// A zero struct is all NULL, which must come back as NULL and not as zero values
+	serialized, err := json.Marshal(cachedRecord{})
+	tests.AssertEqual(t, err, nil)
+	unserialized := valid
+	tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+	tests.AssertEqual(t, unserialized.Time.IsNull(), true)
+	tests.AssertEqual(t, unserialized.Uint64.IsNull(), true)
+	tests.AssertEqual(t, unserialized.String.IsNull(), true)
+}
+
+func TestJSONRoundTripNilBytes(t *testing.T) {
+	// A valid nil array must not be written as null, which reads back as NULL
+	record := cachedRecord{Bytes: nullable.BytesFrom(nil)}
+	serialized, err := json.Marshal(record)
+	tests.AssertEqual(t, err, nil)
+	var unserialized cachedRecord
+	tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+	assertSameRecord(t, unserialized, record)
+
+	// SetValue keeps nil as is, which is still written as an empty array
+	var set nullable.Bytes
+	set.SetValue(nil)
+	serialized, err = json.Marshal(set)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `""`)
+}
+
+// assertSameRecord compares field by field with reflect.DeepEqual, since
+// tests.AssertEqual falls back to comparing printed values
+func assertSameRecord(t *testing.T, got, expected cachedRecord) {
+	t.Helper()
+	gotValue, expectedValue := reflect.ValueOf(got), reflect.ValueOf(expected)
+	for i := 0; i < gotValue.NumField(); i++ {
+		if !reflect.DeepEqual(gotValue.Field(i).Interface(), expectedValue.Field(i).Interface()) {
+			t.Errorf("%s did not round trip: expect %#v, got %#v", gotValue.Type().Field(i).Name, expectedValue.Field(i).Interface(), gotValue.Field(i).Interface())
+		}
+	}
+}
@@ -0,0 +1,491 @@
+package nullable
+
+import (
+	"cmp"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"log/slog"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Rune SQL type that can retrieve NULL value of a single character. It is
+// stored as its integer code point like Int32, but marshalled into JSON and
+// text as the character itself, "A" instead of 65.
+type Rune struct {
+	core[rune]
+}
+
+// NewRune creates a new nullable character
+func NewRune(value *rune) Rune {
+	return Rune{core: newCore(value)}
+}
+
+// RuneFrom creates a new valid nullable character from value
+func RuneFrom(value rune) Rune {
+	return NewRune(&value)
+}
+
+// NullRune creates a new NULL character
+func NullRune() Rune {
+	return NewRune(nil)
+}
+
+// ParseRune parses text holding exactly one character, empty text is NULL
+func ParseRune(text string) (Rune, error) {
+	var n Rune
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Rune{}, err
+	}
+	return n, nil
+}
+
+// MustParseRune is ParseRune that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseRune(text string) Rune {
+	n, err := ParseRune(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseRune(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL Rune, the one a declared
+// Rune starts with, so pooled values can be reused
+func (n *Rune) Reset() {
+	*n = Rune{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Rune) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// MustGet either character or panic when NULL
+func (n Rune) MustGet() rune {
+	return n.mustGet("Rune")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Rune) Clone() Rune {
+	return n
+}
+
+// String returns the character, or "<null>" when NULL
+func (n Rune) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return string(n.realValue)
+}
+
+// DebugString returns Rune('A') or Rune(NULL), telling NULL apart from a
+// zero value in logs
+func (n Rune) DebugString() string {
+	if !n.isValid {
+		return debugString("Rune", false, "")
+	}
+	return debugString("Rune", true, fmt.Sprintf("%q", n.realValue))
+}
+
+// LogValue implements slog.LogValuer, logging NULL as nil and the value as
+// the character as a String
+func (n Rune) LogValue() slog.Value {
+	return logText(n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same character
+func (n Rune) Equal(other Rune) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Rune) Changed(old Rune) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other by code point. NULL sorts before any
+// character.
+func (n Rune) Compare(other Rune) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// ToSQL converts current value to sql.NullInt32
+func (n Rune) ToSQL() sql.NullInt32 {
+	return sql.NullInt32{Int32: n.realValue, Valid: n.isValid}
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL 8 big-endian bytes of the code point, telling NULL apart from zero in
+// cache keys
+func (n Rune) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagRune, n.isValid, hashUint64(uint64(n.realValue)))
+}
+
+// MarshalJSON converts current value to JSON, a one-character string such as "A"
+func (n Rune) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	if err := checkRune(n.realValue); err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(n.realValue))
+}
+
+// UnmarshalJSON writes JSON to this type, a string of exactly one character
+func (n *Rune) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return jsonError(err)
+	}
+	parsed, err := singleRune(text)
+	if err != nil {
+		return jsonError(err)
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Rune) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	if err := checkRune(n.realValue); err != nil {
+		return nil, err
+	}
+	return utf8.AppendRune(nil, n.realValue), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Rune) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.SetNull()
+		return nil
+	}
+
+	parsed, err := singleRune(string(text))
+	if err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Rune) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Rune) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Rune) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return strconv.FormatInt(int64(n.realValue), 10), false
+}
+
+// MarshalYAML converts current value to YAML, a one-character string like
+// JSON
+func (n Rune) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	if err := checkRune(n.realValue); err != nil {
+		return nil, err
+	}
+	return string(n.realValue), nil
+}
+
+// UnmarshalYAML writes YAML to this type, a string of exactly one character
+func (n *Rune) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.SetNull()
+		return nil
+	}
+
+	var text string
+	if err := value.Decode(&text); err != nil {
+		return err
+	}
+	parsed, err := singleRune(text)
+	if err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalXML converts current value to XML, a one-character text
+func (n Rune) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	if err := checkRune(n.realValue); err != nil {
+		return err
+	}
+	return e.EncodeElement(string(n.realValue), start)
+}
+
+// UnmarshalXML writes XML to this type. The text is not trimmed, since a
+// space is a character as well.
+func (n *Rune) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	parsed, err := singleRune(text)
+	if err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack, a one-character string
+func (n Rune) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	if err := checkRune(n.realValue); err != nil {
+		return err
+	}
+	return enc.EncodeString(string(n.realValue))
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Rune) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	text, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+	parsed, err := singleRune(text)
+	if err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, a one-character string.
+// NULL is BSON null.
+func (n Rune) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	if err := checkRune(n.realValue); err != nil {
+		return 0, nil, err
+	}
+	return bson.MarshalValue(string(n.realValue))
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Rune) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.SetNull()
+		return nil
+	}
+	if t != bson.TypeString {
+		return bsonTypeError(t, "Rune")
+	}
+	text, _, ok := bsoncore.ReadString(data)
+	if !ok {
+		return bsonTypeError(t, "Rune")
+	}
+	parsed, err := singleRune(text)
+	if err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Rune) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type, refusing a code point that is not valid
+func (n *Rune) GobDecode(data []byte) error {
+	var parsed rune
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.SetNull()
+		return nil
+	}
+	if err := checkRune(parsed); err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// Scan implements scanner interface, reading the integer code point
+func (n *Rune) Scan(value interface{}) error {
+	value = scanValue(value)
+	if value == nil {
+		n.SetNull()
+		return nil
+	}
+
+	var scanned int32
+	if floatValue, ok := value.(float64); ok {
+		parsed, err := scanFloatInt(floatValue, 32, "rune")
+		if err != nil {
+			return scanError(err)
+		}
+		scanned = int32(parsed)
+	} else if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
+	}
+	if err := checkRune(scanned); err != nil {
+		return scanError(err)
+	}
+
+	n.SetValue(scanned)
+	return nil
+}
+
+// Value implements the driver Valuer interface, writing the integer code point
+func (n Rune) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	if err := checkRune(n.realValue); err != nil {
+		return nil, err
+	}
+	return int64(n.realValue), nil
+}
+
+// GormDataType gorm common data type
+func (Rune) GormDataType() string {
+	return "rune_null"
+}
+
+// GormDBDataType gorm db data type
+func (n Rune) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Rune) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Rune) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
+	case "sqlite", "mysql":
+		return "INT"
+	case "postgres":
+		return "integer"
+	case "sqlserver":
+		return "INT"
+	case "clickhouse":
+		return "Nullable(Int32)"
+	case "cockroachdb":
+		return "INT4"
+	}
+	return ""
+}
+
+// singleRune returns the only character of text, failing on empty text,
+// several characters, or invalid UTF-8
+func singleRune(text string) (rune, error) {
+	value, size := utf8.DecodeRuneInString(text)
+	if size == 0 || size != len(text) {
+		return 0, fmt.Errorf("nullable: Rune needs exactly one character, got %q", text)
+	}
+	if value == utf8.RuneError && size == 1 {
+		return 0, fmt.Errorf("nullable: Rune got invalid UTF-8 %q", text)
+	}
+	return value, nil
+}
+
+// checkRune returns an error when value is not a Unicode code point that
+// can be written as UTF-8, such as a negative number or a surrogate half
+func checkRune(value rune) error {
+	if !utf8.ValidRune(value) {
+		return fmt.Errorf("nullable: %d is not a valid Unicode code point for Rune", value)
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"github.com/vmihailenco/msgpack/v5"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanRune(t *testing.T) {
+	nullableRune := nullable.NullRune()
+
+	tests.AssertEqual(t, nullableRune.Scan(int64(65)), nil)
+	tests.AssertEqual(t, nullableRune.MustGet(), 'A')
+
+	tests.AssertEqual(t, nullableRune.Scan([]byte("19990")), nil)
+	tests.AssertEqual(t, nullableRune.MustGet(), '世')
+
+	tests.AssertEqual(t, nullableRune.Scan(float64(0)), nil)
+	tests.AssertEqual(t, nullableRune.IsValid(), true)
+	tests.AssertEqual(t, nullableRune.MustGet(), rune(0))
+
+	for _, malformed := range []interface{}{int64(-1), int64(0xD800), int64(0x110000), int64(1 << 32), 65.5, "A"} {
+		if err := nullableRune.Scan(malformed); err == nil {
+			t.Errorf("scanning %v must fail", malformed)
+		}
+	}
+	tests.AssertEqual(t, nullableRune, nullable.RuneFrom(0))
+
+	tests.AssertEqual(t, nullableRune.Scan(nil), nil)
+	tests.AssertEqual(t, nullableRune.IsNull(), true)
+}
+
+func TestValueRune(t *testing.T) {
+	value, err := nullable.RuneFrom('A').Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, int64(65))
+
+	value, err = nullable.NullRune().Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+
+	_, err = nullable.RuneFrom(-1).Value()
+	tests.AssertEqual(t, err != nil, true)
+}
+
+func TestNewRune(t *testing.T) {
+	letter := 'Z'
+	tests.AssertEqual(t, nullable.NewRune(&letter).MustGet(), 'Z')
+	tests.AssertEqual(t, nullable.NewRune(nil).Get(), nil)
+	tests.AssertEqual(t, nullable.RuneFrom(0).IsValid(), true)
+}
+
+func TestSetRune(t *testing.T) {
+	nullableRune := nullable.NullRune()
+
+	letter := 'é'
+	nullableRune.Set(&letter)
+	tests.AssertEqual(t, nullableRune.GetOrZero(), 'é')
+
+	nullableRune.Set(nil)
+	tests.AssertEqual(t, nullableRune, nullable.NullRune())
+
+	nullableRune.SetValue('x')
+	tests.AssertEqual(t, nullableRune.GetOr('y'), 'x')
+
+	nullableRune.SetNull()
+	tests.AssertEqual(t, nullableRune.GetOr('y'), 'y')
+}
+
+func TestParseRune(t *testing.T) {
+	parsed, err := nullable.ParseRune("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullRune())
+
+	tests.AssertEqual(t, nullable.MustParseRune("€"), nullable.RuneFrom('€'))
+
+	for _, text := range []string{"ab", "e\u0301", "\xff", "65"} {
+		if _, err := nullable.ParseRune(text); err == nil {
+			t.Errorf("parsing %q as rune must fail", text)
+		}
+	}
+}
+
+func TestStringerRune(t *testing.T) {
+	tests.AssertEqual(t, nullable.RuneFrom('A').String(), "A")
+	tests.AssertEqual(t, fmt.Sprint(nullable.RuneFrom('世')), "世")
+	tests.AssertEqual(t, nullable.NullRune().String(), "<null>")
+}
+
+func TestCompareRune(t *testing.T) {
+	tests.AssertEqual(t, nullable.RuneFrom('a').Compare(nullable.RuneFrom('b')), -1)
+	tests.AssertEqual(t, nullable.RuneFrom('a').Compare(nullable.NullRune()), 1)
+	tests.AssertEqual(t, nullable.RuneFrom('a').Equal(nullable.RuneFrom('a')), true)
+	tests.AssertEqual(t, nullable.RuneFrom(0).Changed(nullable.NullRune()), true)
+}
+
+func TestTextRune(t *testing.T) {
+	text, err := nullable.RuneFrom('世').MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(text), "世")
+
+	cell, err := nullable.NullRune().MarshalCSV()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, cell, "")
+
+	var unserialized nullable.Rune
+	tests.AssertEqual(t, unserialized.UnmarshalCSV("q"), nil)
+	tests.AssertEqual(t, unserialized, nullable.RuneFrom('q'))
+}
+
+func TestJSONRune(t *testing.T) {
+	cases := []struct {
+		value      nullable.Rune
+		serialized string
+	}{
+		{nullable.RuneFrom('A'), `"A"`},
+		{nullable.RuneFrom('世'), `"世"`},
+		{nullable.RuneFrom('"'), `"\""`},
+		{nullable.RuneFrom(0), `"\u0000"`},
+		{nullable.NullRune(), `null`},
+	}
+	for _, c := range cases {
+		serialized, err := json.Marshal(c.value)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(serialized), c.serialized)
+
+		var unserialized nullable.Rune
+		tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+		tests.AssertEqual(t, unserialized, c.value)
+	}
+
+	var unserialized nullable.Rune
+	for _, data := range []string{`""`, `"AB"`, `"e\u0301"`, `65`, `true`} {
+		if err := json.Unmarshal([]byte(data), &unserialized); err == nil {
+			t.Errorf("unmarshalling %s as rune must fail", data)
+		}
+	}
+
+	_, err := json.Marshal(nullable.RuneFrom(0xD800))
+	tests.AssertEqual(t, err != nil, true)
+}
+
+func TestYAMLRune(t *testing.T) {
+	marshalUnmarshalYAML(t, nullable.RuneFrom('世'))
+	marshalUnmarshalYAML(t, nullable.RuneFrom(' '))
+	marshalUnmarshalYAML(t, nullable.NullRune())
+
+	var unserialized yamlEnvelope[nullable.Rune]
+	for _, data := range []string{`value: ""`, "value: ab", "value: [a]"} {
+		if err := yaml.Unmarshal([]byte(data), &unserialized); err == nil {
+			t.Errorf("unmarshalling %q as rune must fail", data)
+		}
+	}
+}
+
+func TestXMLRune(t *testing.T) {
+	marshalUnmarshalXML(t, nullable.RuneFrom('世'))
+	marshalUnmarshalXML(t, nullable.RuneFrom(' '))
+	marshalUnmarshalXML(t, nullable.NullRune())
+
+	var unserialized nullable.Rune
+	if err := xml.Unmarshal([]byte("<Rune>ab</Rune>"), &unserialized); err == nil {
+		t.Error("unmarshalling two characters as rune must fail")
+	}
+}
+
+func TestMsgpackRune(t *testing.T) {
+	marshalUnmarshalMsgpack(t, nullable.RuneFrom('A'))
+	marshalUnmarshalMsgpack(t, nullable.NullRune())
+
+	if _, err := msgpack.Marshal(nullable.RuneFrom(-1)); err == nil {
+		t.Error("marshalling an invalid code point must fail")
+	}
+}
+
+func TestBSONRune(t *testing.T) {
+	marshalUnmarshalBSON(t, nullable.RuneFrom('世'))
+	marshalUnmarshalBSON(t, nullable.NullRune())
+}
+
+func TestGobRune(t *testing.T) {
+	marshalUnmarshalGob(t, nullable.RuneFrom('世'))
+	marshalUnmarshalGob(t, nullable.RuneFrom(0))
+	marshalUnmarshalGob(t, nullable.NullRune())
+}
+
+func TestRune(t *testing.T) {
+	type TestNullableRune struct {
+		ID      uint
+		Name    string
+		Initial nullable.Rune
+	}
+
+	DB.Migrator().DropTable(&TestNullableRune{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableRune{}); err != nil {
+		t.Errorf("failed to migrate nullable rune, got error: %v", err)
+	}
+
+	latin := TestNullableRune{Name: "latin", Initial: nullable.RuneFrom('A')}
+	DB.Create(&latin)
+
+	wide := TestNullableRune{Name: "wide", Initial: nullable.RuneFrom('😀')}
+	DB.Create(&wide)
+
+	unknown := TestNullableRune{Name: "unknown", Initial: nullable.NullRune()}
+	DB.Create(&unknown)
+
+	for _, expected := range []TestNullableRune{latin, wide, unknown} {
+		var result TestNullableRune
+		if err := DB.First(&result, "name = ?", expected.Name).Error; err != nil {
+			t.Fatalf("Cannot read rune test record of %q", expected.Name)
+		}
+		tests.AssertEqual(t, result, expected)
+	}
+}
@@ -0,0 +1,77 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// maxScanWrapping is how many levels of *interface{} Scan looks through
+const maxScanWrapping = 8
+
+// scanValue returns the value behind the *interface{} some drivers hand to
+// Scan, looking through up to maxScanWrapping levels of it. A nil pointer is
+// NULL. Deeper wrapping is left as is and fails like any unsupported type.
+func scanValue(value interface{}) interface{} {
+	for depth := 0; depth < maxScanWrapping; depth++ {
+		wrapped, ok := value.(*interface{})
+		if !ok {
+			return value
+		}
+		if wrapped == nil {
+			return nil
+		}
+		value = *wrapped
+	}
+	return value
+}
+
+// interfaceValue returns what SetFromInterface hands to Scan: nil for a nil
+// pointer, what a pointer points to, and the Value of a driver.Valuer
+func interfaceValue(value interface{}) (interface{}, error) {
+	for {
+		if value == nil {
+			return nil, nil
+		}
+		if valuer, ok := value.(driver.Valuer); ok {
+			if pointer := reflect.ValueOf(value); pointer.Kind() == reflect.Ptr && pointer.IsNil() {
+				return nil, nil
+			}
+			return valuer.Value()
+		}
+		pointer := reflect.ValueOf(value)
+		if pointer.Kind() != reflect.Ptr {
+			return value, nil
+		}
+		if pointer.IsNil() {
+			return nil, nil
+		}
+		value = pointer.Elem().Interface()
+	}
+}
+
+// scanFloatInt converts float64 handed out by drivers such as SQLite for
+// integer columns, rejecting fractional parts and values out of range.
+func scanFloatInt(value float64, bitSize int, typeName string) (int64, error) {
+	if value != math.Trunc(value) {
+		return 0, withSentinel(ErrInvalidType, fmt.Errorf("converting driver.Value type float64 (%v) to a %s: has fractional part", value, typeName))
+	}
+	limit := math.Ldexp(1, bitSize-1)
+	if value < -limit || value >= limit {
+		return 0, withSentinel(ErrOverflow, fmt.Errorf("converting driver.Value type float64 (%v) to a %s: %w", value, typeName, strconv.ErrRange))
+	}
+	return int64(value), nil
+}
+
+// scanFloatUint is scanFloatInt for unsigned integers
+func scanFloatUint(value float64, bitSize int, typeName string) (uint64, error) {
+	if value != math.Trunc(value) {
+		return 0, withSentinel(ErrInvalidType, fmt.Errorf("converting driver.Value type float64 (%v) to a %s: has fractional part", value, typeName))
+	}
+	if value < 0 || value >= math.Ldexp(1, bitSize) {
+		return 0, withSentinel(ErrOverflow, fmt.Errorf("converting driver.Value type float64 (%v) to a %s: %w", value, typeName, strconv.ErrRange))
+	}
+	return uint64(value), nil
+}
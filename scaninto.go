@@ -0,0 +1,71 @@
+package nullable
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+
+// ScanInto scans the current row of rows into the struct dest points to,
+// going through the Scan of each field. A column is matched to the field
+// whose `db` tag names it, or else to the field whose name equals it when
+// case and underscores are ignored, so user_id fills UserID. Fields tagged
+// `db:"-"` are skipped. Every column needs a field that is a sql.Scanner,
+// such as the nullable types, otherwise nothing is scanned.
+//
+// Call it after rows.Next, like rows.Scan.
+func ScanInto(rows *sql.Rows, dest interface{}) error {
+	if rows == nil {
+		return errors.New("nullable: ScanInto called with nil rows")
+	}
+	target := reflect.ValueOf(dest)
+	if target.Kind() != reflect.Ptr || target.IsNil() || target.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("nullable: ScanInto needs a non-nil pointer to struct, got %T", dest)
+	}
+	target = target.Elem()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	fields := make([]interface{}, len(columns))
+	for i, column := range columns {
+		index, ok := scanIntoField(target.Type(), column)
+		if !ok {
+			return fmt.Errorf("nullable: no field of %s for column %q", target.Type(), column)
+		}
+		field := target.Field(index).Addr()
+		if !field.Type().Implements(scannerType) {
+			return fmt.Errorf("nullable: field %s of %s for column %q is %s, which is not a sql.Scanner",
+				target.Type().Field(index).Name, target.Type(), column, target.Type().Field(index).Type)
+		}
+		fields[i] = field.Interface()
+	}
+	return rows.Scan(fields...)
+}
+
+// scanIntoField returns the index of the exported field of structType that
+// column is scanned into
+func scanIntoField(structType reflect.Type, column string) (int, bool) {
+	byName := -1
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		if name, _, _ := strings.Cut(field.Tag.Get("db"), ","); name != "" {
+			if name == column {
+				return i, true
+			}
+			continue
+		}
+		if byName < 0 && strings.EqualFold(field.Name, strings.ReplaceAll(column, "_", "")) {
+			byName = i
+		}
+	}
+	return byName, byName >= 0
+}
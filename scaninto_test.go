@@ -0,0 +1,107 @@
+package nullable_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanInto(t *testing.T) {
+	type TestNullableScanInto struct {
+		ID       uint
+		Name     nullable.String
+		Age      nullable.Uint64
+		Verified nullable.Bool
+	}
+
+	DB.Migrator().DropTable(&TestNullableScanInto{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableScanInto{}); err != nil {
+		t.Fatalf("failed to migrate scan into test table, got error: %v", err)
+	}
+	DB.Create(&TestNullableScanInto{Name: nullable.StringFrom("cat"), Age: nullable.Uint64From(9), Verified: nullable.NullBool()})
+	DB.Create(&TestNullableScanInto{Name: nullable.NullString(), Age: nullable.NullUint64(), Verified: nullable.BoolFrom(true)})
+
+	type person struct {
+		FullName nullable.String `db:"name"`
+		Age      nullable.Uint64
+		IsValid  nullable.Bool   `db:"verified"`
+		Ignored  nullable.String `db:"-"`
+	}
+
+	rows, err := DB.Table("test_nullable_scan_intos").Select("name, age, verified").Order("id").Rows()
+	if err != nil {
+		t.Fatalf("failed to query scan into test table, got error: %v", err)
+	}
+	defer rows.Close()
+
+	var scanned []person
+	for rows.Next() {
+		var result person
+		if err := nullable.ScanInto(rows, &result); err != nil {
+			t.Fatalf("failed to scan row, got error: %v", err)
+		}
+		scanned = append(scanned, result)
+	}
+	tests.AssertEqual(t, rows.Err(), nil)
+	tests.AssertEqual(t, scanned, []person{
+		{FullName: nullable.StringFrom("cat"), Age: nullable.Uint64From(9), IsValid: nullable.NullBool()},
+		{FullName: nullable.NullString(), Age: nullable.NullUint64(), IsValid: nullable.BoolFrom(true)},
+	})
+}
+
+func TestScanIntoErrors(t *testing.T) {
+	type TestNullableScanIntoErrors struct {
+		ID        uint
+		UserEmail nullable.String
+	}
+
+	DB.Migrator().DropTable(&TestNullableScanIntoErrors{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableScanIntoErrors{}); err != nil {
+		t.Fatalf("failed to migrate scan into errors test table, got error: %v", err)
+	}
+	DB.Create(&TestNullableScanIntoErrors{UserEmail: nullable.StringFrom("not a number")})
+
+	type matched struct {
+		UserEmail nullable.String
+	}
+	type missing struct {
+		Email nullable.String
+	}
+	type plain struct {
+		UserEmail string
+	}
+	type mismatched struct {
+		UserEmail nullable.Int64
+	}
+	cases := []struct {
+		dest    interface{}
+		message string
+	}{
+		{&matched{}, ""},
+		{&missing{}, `no field of nullable_test.missing for column "user_email"`},
+		{&plain{}, "is string, which is not a sql.Scanner"},
+		{&mismatched{}, `column index 0, name "user_email"`},
+		{matched{}, "needs a non-nil pointer to struct"},
+		{(*matched)(nil), "needs a non-nil pointer to struct"},
+	}
+	for _, c := range cases {
+		rows, err := DB.Table("test_nullable_scan_into_errors").Select("user_email").Rows()
+		if err != nil {
+			t.Fatalf("failed to query scan into errors test table, got error: %v", err)
+		}
+		tests.AssertEqual(t, rows.Next(), true)
+		err = nullable.ScanInto(rows, c.dest)
+		rows.Close()
+
+		if c.message == "" {
+			tests.AssertEqual(t, err, nil)
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), c.message) {
+			t.Errorf("scanning into %T must fail with %q, got %v", c.dest, c.message, err)
+		}
+	}
+	tests.AssertEqual(t, strings.Contains(nullable.ScanInto(nil, &matched{}).Error(), "nil rows"), true)
+}
@@ -0,0 +1,49 @@
+package nullable
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm/schema"
+)
+
+func init() {
+	schema.RegisterSerializer("nullable", Serializer{})
+}
+
+// Serializer is GORM serializer registered as "nullable", for fields tagged
+// `gorm:"serializer:nullable"`. It reads and writes through the field's own
+// Scan and Value, so the column holds the same as without the tag.
+//
+// The types here cannot implement schema.SerializerInterface themselves,
+// its Scan and Value clash with sql.Scanner and driver.Valuer. They do work
+// with the built-in "json" and "gob" serializers through MarshalJSON and
+// GobEncode, NULL included.
+type Serializer struct{}
+
+// Scan implements serializer interface
+func (Serializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue interface{}) error {
+	fieldValue := reflect.New(field.FieldType)
+	scanner, ok := fieldValue.Interface().(sql.Scanner)
+	if !ok {
+		return fmt.Errorf("nullable: field %s of type %s does not implement sql.Scanner", field.Name, field.FieldType)
+	}
+	if err := scanner.Scan(dbValue); err != nil {
+		return err
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(fieldValue.Elem())
+	return nil
+}
+
+// Value implements serializer interface
+func (Serializer) Value(ctx context.Context, field *schema.Field, dst reflect.Value, fieldValue interface{}) (interface{}, error) {
+	valuer, ok := fieldValue.(driver.Valuer)
+	if !ok {
+		return nil, fmt.Errorf("nullable: field %s of type %T does not implement driver.Valuer", field.Name, fieldValue)
+	}
+	return valuer.Value()
+}
@@ -0,0 +1,74 @@
+package nullable_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/schema"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestRegisteredSerializer(t *testing.T) {
+	serializer, ok := schema.GetSerializer("nullable")
+	tests.AssertEqual(t, ok, true)
+	tests.AssertEqual(t, serializer, nullable.Serializer{})
+}
+
+func TestSerializer(t *testing.T) {
+	type TestNullableSerializer struct {
+		ID       uint
+		Name     string
+		Age      nullable.Int64         `gorm:"serializer:nullable"`
+		Nickname nullable.String        `gorm:"serializer:nullable"`
+		Score    nullable.Float64       `gorm:"serializer:json"`
+		Tags     nullable.Slice[string] `gorm:"serializer:json"`
+		Born     nullable.Time          `gorm:"serializer:nullable"`
+	}
+
+	DB.Migrator().DropTable(&TestNullableSerializer{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableSerializer{}); err != nil {
+		t.Errorf("failed to migrate nullable serializer, got error: %v", err)
+	}
+
+	present := TestNullableSerializer{
+		Name:     "present",
+		Age:      nullable.Int64From(42),
+		Nickname: nullable.StringFrom("Wendell"),
+		Score:    nullable.Float64From(9.5),
+		Tags:     nullable.SliceFrom([]string{"go"}),
+		Born:     nullable.TimeFrom(time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)),
+	}
+	DB.Create(&present)
+
+	missing := TestNullableSerializer{
+		Name:     "missing",
+		Age:      nullable.NullInt64(),
+		Nickname: nullable.NullString(),
+		Score:    nullable.NullFloat64(),
+		Tags:     nullable.NullSlice[string](),
+		Born:     nullable.NullTime(),
+	}
+	DB.Create(&missing)
+
+	var result1 TestNullableSerializer
+	if err := DB.First(&result1, "name = ?", "present").Error; err != nil {
+		t.Fatalf("Cannot read serializer test record of \"present\": %v", err)
+	}
+	tests.AssertEqual(t, result1.Age, present.Age)
+	tests.AssertEqual(t, result1.Nickname, present.Nickname)
+	tests.AssertEqual(t, result1.Score, present.Score)
+	tests.AssertEqual(t, result1.Tags, present.Tags)
+	tests.AssertEqual(t, result1.Born.Get().Equal(*present.Born.Get()), true)
+
+	var result2 TestNullableSerializer
+	if err := DB.First(&result2, "name = ?", "missing").Error; err != nil {
+		t.Fatalf("Cannot read serializer test record of \"missing\": %v", err)
+	}
+	tests.AssertEqual(t, result2, missing)
+
+	// NULL is written as SQL NULL by both serializers, not as JSON null
+	var nulls int64
+	DB.Model(&TestNullableSerializer{}).Where("age IS NULL AND score IS NULL AND tags IS NULL AND born IS NULL").Count(&nulls)
+	tests.AssertEqual(t, nulls, int64(1))
+}
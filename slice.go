@@ -0,0 +1,304 @@
+package nullable
+
+import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Slice SQL type that can retrieve NULL value of a slice, stored as JSON
+// array. NULL and an empty slice are told apart: a valid slice is never nil.
+type Slice[T any] struct {
+	core[[]T]
+}
+
+// NewSlice creates a new nullable slice
+func NewSlice[T any](value *[]T) Slice[T] {
+	if value == nil {
+		return Slice[T]{}
+	}
+	return SliceFrom(*value)
+}
+
+// SliceFrom creates a new valid nullable slice from value, nil is an empty slice
+func SliceFrom[T any](value []T) Slice[T] {
+	if value == nil {
+		value = []T{}
+	}
+	return Slice[T]{core: newCore(&value)}
+}
+
+// NullSlice creates a new NULL slice
+func NullSlice[T any]() Slice[T] {
+	return NewSlice[T](nil)
+}
+
+// SlicePtr returns n.Get(), handy where a function value is needed
+func SlicePtr[T any](n Slice[T]) *[]T {
+	return n.Get()
+}
+
+// SliceValue returns n.GetOrZero(), nil when NULL
+func SliceValue[T any](n Slice[T]) []T {
+	return n.GetOrZero()
+}
+
+// Set either nil or slice
+func (n *Slice[T]) Set(value *[]T) {
+	*n = NewSlice(value)
+}
+
+// Swap sets either nil or slice like Set does and returns the previous
+// value, nil when it was NULL
+func (n *Slice[T]) Swap(value *[]T) *[]T {
+	previous := n.Get()
+	n.Set(value)
+	return previous
+}
+
+// SetValue sets slice and marks it as not NULL
+func (n *Slice[T]) SetValue(value []T) {
+	*n = SliceFrom(value)
+}
+
+// Reset sets the value back to the zero NULL Slice, the one a declared
+// Slice starts with, letting go of the held slice so pooled values can be
+// reused
+func (n *Slice[T]) Reset() {
+	*n = Slice[T]{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Slice[T]) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// MustGet either slice or panic when NULL
+func (n Slice[T]) MustGet() []T {
+	return n.mustGet(reflect.TypeOf(n).String())
+}
+
+// Clone returns a copy of the value that shares no memory with n
+func (n Slice[T]) Clone() Slice[T] {
+	n.realValue = slices.Clone(n.realValue)
+	return n
+}
+
+// String returns slice in its natural text form, or "<null>" when NULL
+func (n Slice[T]) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return fmt.Sprint(n.realValue)
+}
+
+// DebugString returns Slice[T](value) or Slice[T](NULL), telling NULL apart
+// from a zero value in logs
+func (n Slice[T]) DebugString() string {
+	return debugString("Slice["+reflect.TypeFor[T]().String()+"]", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold deeply equal slice
+func (n Slice[T]) Equal(other Slice[T]) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return reflect.DeepEqual(n.realValue, other.realValue)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Slice[T]) Changed(old Slice[T]) bool {
+	return !n.Equal(old)
+}
+
+// MarshalJSON converts current value to JSON
+func (n Slice[T]) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.realValue)
+}
+
+// UnmarshalJSON writes JSON to this type
+func (n *Slice[T]) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed []T
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Slice[T]) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Slice[T]) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed []T
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Slice[T]) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Slice[T]) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed []T
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from empty slice
+func (n Slice[T]) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Slice[T]) GobDecode(data []byte) error {
+	var parsed []T
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.SetNull()
+		return nil
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// Scan implements scanner interface, reading JSON array. A JSON null in
+// the column is NULL as well.
+func (n *Slice[T]) Scan(value interface{}) error {
+	return scanError(n.ScanContext(context.Background(), value))
+}
+
+// ScanContext is Scan that gives up with ctx.Err(), leaving the value
+// unchanged, when ctx is done before parsing starts
+func (n *Slice[T]) ScanContext(ctx context.Context, value interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	value = scanValue(value)
+	if value == nil {
+		n.SetNull()
+		return nil
+	}
+
+	var scanned []byte
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
+	}
+	return scanError(n.UnmarshalJSON(scanned))
+}
+
+// Value implements the driver Valuer interface.
+func (n Slice[T]) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	serialized, err := json.Marshal(n.realValue)
+	if err != nil {
+		return nil, err
+	}
+	// JSON columns expect text, so the array is sent as string
+	return string(serialized), nil
+}
+
+// GormDataType gorm common data type
+func (Slice[T]) GormDataType() string {
+	return "slice_null"
+}
+
+// GormDBDataType gorm db data type
+func (n Slice[T]) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Slice[T]) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Slice[T]) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
+	case "sqlite", "mysql":
+		return "JSON"
+	case "postgres", "cockroachdb":
+		return "jsonb"
+	case "sqlserver":
+		return "NVARCHAR(MAX)"
+	case "clickhouse":
+		return "Nullable(String)"
+	}
+	return ""
+}
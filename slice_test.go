@@ -0,0 +1,256 @@
+package nullable_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanSlice(t *testing.T) {
+	nullableSlice := nullable.NewSlice[string](nil)
+
+	tests.AssertEqual(t, nullableSlice.Scan(`["a","b"]`), nil)
+	tests.AssertEqual(t, nullableSlice.Get(), []string{"a", "b"})
+
+	tests.AssertEqual(t, nullableSlice.Scan([]byte(`[]`)), nil)
+	tests.AssertEqual(t, nullableSlice.IsValid(), true)
+	tests.AssertEqual(t, nullableSlice.Get(), []string{})
+
+	tests.AssertEqual(t, nullableSlice.Scan([]byte(`null`)), nil)
+	tests.AssertEqual(t, nullableSlice.IsNull(), true)
+
+	if err := nullableSlice.Scan(`["a",`); err == nil {
+		t.Error("scanning malformed JSON array must fail")
+	}
+	if err := nullableSlice.Scan(`[1, 2]`); err == nil {
+		t.Error("scanning JSON array of wrong element type must fail")
+	}
+
+	tests.AssertEqual(t, nullableSlice.Scan(nil), nil)
+	tests.AssertEqual(t, nullableSlice.Get(), nil)
+}
+
+func TestScanContextSlice(t *testing.T) {
+	nullableSlice := nullable.NullSlice[int]()
+	tests.AssertEqual(t, nullableSlice.ScanContext(context.Background(), `[1,2]`), nil)
+	tests.AssertEqual(t, nullableSlice, nullable.SliceFrom([]int{1, 2}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	tests.AssertEqual(t, nullableSlice.ScanContext(ctx, `[3]`), context.Canceled)
+	tests.AssertEqual(t, nullableSlice, nullable.SliceFrom([]int{1, 2}))
+
+	tests.AssertEqual(t, nullableSlice.ScanContext(context.Background(), nil), nil)
+	tests.AssertEqual(t, nullableSlice.IsNull(), true)
+}
+
+func TestValueSlice(t *testing.T) {
+	value, err := nullable.SliceFrom([]int{1, 2, 3}).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, "[1,2,3]")
+
+	value, err = nullable.SliceFrom([]int(nil)).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, "[]")
+
+	value, err = nullable.NullSlice[int]().Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
+func TestNewSlice(t *testing.T) {
+	basic := []int{1, 2, 3}
+	nullableSlice1 := nullable.NewSlice(&basic)
+	tests.AssertEqual(t, nullableSlice1.Get(), basic)
+
+	nullableSlice2 := nullable.NewSlice[int](nil)
+	tests.AssertEqual(t, nullableSlice2.Get(), nil)
+
+	var empty []int
+	nullableSlice3 := nullable.NewSlice(&empty)
+	tests.AssertEqual(t, nullableSlice3.IsValid(), true)
+	tests.AssertEqual(t, nullableSlice3.Get(), []int{})
+}
+
+func TestSetSlice(t *testing.T) {
+	nullableSlice := nullable.NewSlice[int](nil)
+	tests.AssertEqual(t, nullableSlice.Get(), nil)
+
+	basic := []int{1, 2, 3}
+	nullableSlice.Set(&basic)
+	tests.AssertEqual(t, nullableSlice.Get(), basic)
+
+	nullableSlice.Set(nil)
+	tests.AssertEqual(t, nullableSlice.Get(), nil)
+
+	nullableSlice.SetValue(nil)
+	tests.AssertEqual(t, nullableSlice, nullable.SliceFrom([]int{}))
+
+	nullableSlice.SetNull()
+	tests.AssertEqual(t, nullableSlice, nullable.NullSlice[int]())
+}
+
+func TestGetOrSlice(t *testing.T) {
+	tests.AssertEqual(t, nullable.SliceFrom([]int{1}).GetOr([]int{2}), []int{1})
+	tests.AssertEqual(t, nullable.NullSlice[int]().GetOr([]int{2}), []int{2})
+	tests.AssertEqual(t, nullable.NullSlice[int]().GetOrZero() == nil, true)
+	tests.AssertEqual(t, nullable.SliceFrom([]int{1}).MustGet(), []int{1})
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustGet on NULL slice must panic")
+		}
+	}()
+	nullable.NullSlice[int]().MustGet()
+}
+
+func TestIsZeroSlice(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullSlice[int]().IsZero(), true)
+	tests.AssertEqual(t, nullable.SliceFrom([]int{}).IsZero(), false)
+	tests.AssertEqual(t, nullable.SliceFrom([]int{1, 2}).IsZero(), false)
+}
+
+func TestCloneSlice(t *testing.T) {
+	original := nullable.SliceFrom([]int{1, 2})
+	clone := original.Clone()
+	tests.AssertEqual(t, clone, original)
+
+	clone.MustGet()[0] = 3
+	tests.AssertEqual(t, original, nullable.SliceFrom([]int{1, 2}))
+	tests.AssertEqual(t, clone, nullable.SliceFrom([]int{3, 2}))
+
+	tests.AssertEqual(t, nullable.SliceFrom([]int{}).Clone(), nullable.SliceFrom([]int{}))
+	tests.AssertEqual(t, nullable.NullSlice[int]().Clone(), nullable.NullSlice[int]())
+}
+
+func TestUnwrapSlice(t *testing.T) {
+	value, ok := nullable.SliceFrom([]int{}).Unwrap()
+	tests.AssertEqual(t, value, []int{})
+	tests.AssertEqual(t, ok, true)
+
+	var zero nullable.Slice[int]
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value == nil, true)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestPtrSlice(t *testing.T) {
+	tests.AssertEqual(t, nullable.SlicePtr(nullable.SliceFrom([]int{1})), []int{1})
+	tests.AssertEqual(t, nullable.SlicePtr(nullable.NullSlice[int]()), nil)
+	tests.AssertEqual(t, nullable.SliceValue(nullable.SliceFrom([]int{1})), []int{1})
+	tests.AssertEqual(t, nullable.SliceValue(nullable.NullSlice[int]()) == nil, true)
+}
+
+func TestMsgpackSlice(t *testing.T) {
+	marshalUnmarshalMsgpack(t, nullable.SliceFrom([]string{"a", "b"}))
+
+	marshalUnmarshalMsgpack(t, nullable.SliceFrom([]string{}))
+
+	marshalUnmarshalMsgpack(t, nullable.NullSlice[string]())
+}
+
+func TestBSONSlice(t *testing.T) {
+	marshalUnmarshalBSON(t, nullable.SliceFrom([]string{"a", "b"}))
+
+	marshalUnmarshalBSON(t, nullable.SliceFrom([]string{}))
+
+	marshalUnmarshalBSON(t, nullable.NullSlice[string]())
+}
+
+func TestGobSlice(t *testing.T) {
+	marshalUnmarshalGob(t, nullable.SliceFrom([]string{"a", "b"}))
+
+	marshalUnmarshalGob(t, nullable.SliceFrom([]string{}))
+
+	marshalUnmarshalGob(t, nullable.NullSlice[string]())
+}
+
+func TestStringerSlice(t *testing.T) {
+	nullableSlice := nullable.SliceFrom([]int{1, 2, 3})
+	tests.AssertEqual(t, nullableSlice.String(), "[1 2 3]")
+	tests.AssertEqual(t, fmt.Sprint(nullableSlice), "[1 2 3]")
+
+	nullableSlice.Set(nil)
+	tests.AssertEqual(t, nullableSlice.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableSlice), "<null>")
+}
+
+func TestEqualSlice(t *testing.T) {
+	basic := nullable.SliceFrom([]int{1, 2, 3})
+	empty := nullable.SliceFrom([]int{})
+	tests.AssertEqual(t, nullable.NullSlice[int]().Equal(nullable.NullSlice[int]()), true)
+	tests.AssertEqual(t, nullable.NullSlice[int]().Equal(basic), false)
+	tests.AssertEqual(t, basic.Equal(nullable.NullSlice[int]()), false)
+	tests.AssertEqual(t, basic.Equal(nullable.SliceFrom([]int{1, 2, 3})), true)
+	tests.AssertEqual(t, basic.Equal(empty), false)
+	tests.AssertEqual(t, empty.Equal(nullable.SliceFrom([]int(nil))), true)
+	tests.AssertEqual(t, empty.Equal(nullable.NullSlice[int]()), false)
+}
+
+func TestJSONSlice(t *testing.T) {
+	cases := []struct {
+		value      nullable.Slice[string]
+		serialized string
+	}{
+		{nullable.SliceFrom([]string{"a", "b"}), `["a","b"]`},
+		{nullable.SliceFrom([]string{}), `[]`},
+		{nullable.NullSlice[string](), `null`},
+	}
+	for _, c := range cases {
+		serialized, err := json.Marshal(c.value)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(serialized), c.serialized)
+
+		var unserialized nullable.Slice[string]
+		tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+		tests.AssertEqual(t, unserialized, c.value)
+	}
+
+	var unserialized nullable.Slice[string]
+	if err := json.Unmarshal([]byte(`{"a":1}`), &unserialized); err == nil {
+		t.Error("unmarshalling JSON object into slice must fail")
+	}
+}
+
+func TestSlice(t *testing.T) {
+	type TestNullableSlice struct {
+		ID   uint
+		Name string
+		Tags nullable.Slice[string]
+	}
+
+	DB.Migrator().DropTable(&TestNullableSlice{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableSlice{}); err != nil {
+		t.Errorf("failed to migrate nullable slice, got error: %v", err)
+	}
+
+	tagged := TestNullableSlice{
+		Name: "tagged",
+		Tags: nullable.SliceFrom([]string{"go", "sql"}),
+	}
+	DB.Create(&tagged)
+
+	untagged := TestNullableSlice{
+		Name: "untagged",
+		Tags: nullable.SliceFrom([]string{}),
+	}
+	DB.Create(&untagged)
+
+	unknown := TestNullableSlice{
+		Name: "unknown",
+		Tags: nullable.NullSlice[string](),
+	}
+	DB.Create(&unknown)
+
+	for _, expected := range []TestNullableSlice{tagged, untagged, unknown} {
+		var result TestNullableSlice
+		if err := DB.First(&result, "name = ?", expected.Name).Error; err != nil {
+			t.Fatalf("Cannot read slice test record of %q", expected.Name)
+		}
+		tests.AssertEqual(t, result, expected)
+	}
+}
@@ -1,55 +1,222 @@
 package nullable
 
 import (
+	"cmp"
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
 	"fmt"
+	"hash"
+	"strconv"
+	"time"
 	"unicode/utf8"
 
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 )
 
 // String SQL type that can retrieve NULL value
 type String struct {
-	realValue string
-	isValid   bool
+	core[string]
 }
 
 // NewString creates a new nullable string
 func NewString(value *string) String {
 	if value == nil {
-		return String{
-			realValue: "",
-			isValid:   false,
-		}
+		return String{}
 	}
 	isValid := utf8.Valid([]byte(*value))
 	if !isValid {
 		*value = fmt.Sprintf("%q", *value)
 	}
-	return String{
-		realValue: *value,
-		isValid:   true,
+	return String{core: newCore(value)}
+}
+
+// StringFrom creates a new valid nullable string from value
+func StringFrom(value string) String {
+	return NewString(&value)
+}
+
+// NullString creates a new NULL string
+func NullString() String {
+	return NewString(nil)
+}
+
+// StringZeroAsNull creates a new nullable string that is NULL when value is the zero value
+func StringZeroAsNull(value string) String {
+	if value == "" {
+		return NullString()
+	}
+	return StringFrom(value)
+}
+
+// StringFromSQL creates a new nullable string from sql.NullString
+func StringFromSQL(value sql.NullString) String {
+	if !value.Valid {
+		return NullString()
+	}
+	return StringFrom(value.String)
+}
+
+// CoalesceString returns the first valid value, or NULL when all of them are NULL
+func CoalesceString(values ...String) String {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return String{}
+}
+
+// MinString returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinString(values ...String) String {
+	var result String
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// MaxString returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxString(values ...String) String {
+	var result String
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// StringPtr returns n.Get(), handy where a function value is needed
+func StringPtr(n String) *string {
+	return n.Get()
+}
+
+// StringValue returns n.GetOrZero(), zero value when NULL
+func StringValue(n String) string {
+	return n.GetOrZero()
+}
+
+// ParseString parses text like UnmarshalText does, empty text is NULL
+func ParseString(text string) (String, error) {
+	var n String
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return String{}, err
 	}
+	return n, nil
+}
+
+// MustParseString is ParseString that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseString(text string) String {
+	n, err := ParseString(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseString(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL String, the one a declared
+// String starts with, so pooled values can be reused
+func (n *String) Reset() {
+	*n = String{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *String) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *String) NullIfZero() {
+	if n.isValid && n.realValue == "" {
+		n.SetNull()
+	}
+}
+
+// MustGet either string or panic when NULL
+func (n String) MustGet() string {
+	return n.mustGet("String")
 }
 
-// Get either nil or string
-func (n String) Get() *string {
+// Clone returns a copy of the value, same as assigning it
+func (n String) Clone() String {
+	return n
+}
+
+// String returns string in its natural text form, or "<null>" when NULL
+func (n String) String() string {
 	if !n.isValid {
-		return nil
+		return nullString
 	}
-	return &n.realValue
+	return n.realValue
 }
 
-// Set either nil or string
-func (n *String) Set(value *string) {
-	n.isValid = (value != nil)
-	if n.isValid {
-		n.realValue = *value
-	} else {
-		n.realValue = ""
+// DebugString returns String("value") or String(NULL), telling NULL apart
+// from an empty string in logs
+func (n String) DebugString() string {
+	return debugString("String", n.isValid, strconv.Quote(n.realValue))
+}
+
+// Equal reports whether both values are NULL or both hold the same string
+func (n String) Equal(other String) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
 	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n String) Changed(old String) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any string.
+func (n String) Compare(other String) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// ToSQL converts current value to sql.NullString
+func (n String) ToSQL() sql.NullString {
+	return sql.NullString{String: n.realValue, Valid: n.isValid}
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL the text, telling NULL apart from zero in cache keys
+func (n String) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagString, n.isValid, []byte(n.realValue))
 }
 
 // MarshalJSON converts current value to JSON
@@ -59,8 +226,11 @@ func (n String) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON writes JSON to this type
 func (n *String) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
 		n.isValid = false
 		n.realValue = ""
 		return nil
@@ -68,22 +238,205 @@ func (n *String) UnmarshalJSON(data []byte) error {
 
 	var parsed string
 	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n String) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(n.realValue), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *String) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = ""
+		return nil
+	}
+
+	parsed := string(text)
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n String) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *String) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n String) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return copyText(n.realValue), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n String) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *String) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = ""
+		return nil
+	}
+
+	var parsed string
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n String) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue, start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *String) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = ""
+		return nil
+	}
+
+	parsed := text
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n String) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *String) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = ""
+		return nil
+	}
+
+	var parsed string
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n String) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *String) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = ""
+		return nil
+	}
+
+	var parsed string
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n String) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *String) GobDecode(data []byte) error {
+	var parsed string
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
 		return err
 	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = ""
+		return nil
+	}
 
 	n.isValid = true
 	n.realValue = parsed
 	return nil
 }
 
-// Scan implements scanner interface
+// Scan implements scanner interface, a time.Time is written in RFC 3339
+// with nanoseconds
 func (n *String) Scan(value interface{}) error {
+	value = scanValue(value)
 	if value == nil {
 		n.realValue, n.isValid = "", false
 		return nil
 	}
+	if timeValue, ok := value.(time.Time); ok {
+		// A timestamp column read as text gets the layout Time uses in JSON,
+		// in the zone the driver gave it
+		n.SetValue(timeValue.Format(time.RFC3339Nano))
+		return nil
+	}
 	n.isValid = true
-	return convertAssign(&n.realValue, value)
+	return scanError(convertAssign(&n.realValue, value))
 }
 
 // Value implements the driver Valuer interface.
@@ -101,12 +454,38 @@ func (String) GormDataType() string {
 }
 
 // GormDBDataType gorm db data type
-func (String) GormDBDataType(db *gorm.DB, field *schema.Field) string {
-	switch db.Dialector.Name() {
+func (n String) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n String) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (String) columnType(dialect string, field *schema.Field) string {
+	size := fieldSize(field)
+	switch dialect {
 	case "sqlite", "mysql":
+		if size > 0 {
+			return fmt.Sprintf("VARCHAR(%d)", size)
+		}
 		return "TEXT"
-	case "postgres":
+	case "postgres", "cockroachdb":
+		if size > 0 {
+			return fmt.Sprintf("varchar(%d)", size)
+		}
 		return "text"
+	case "sqlserver":
+		// NVARCHAR takes at most 4000 characters before it has to be MAX
+		if size > 0 && size <= 4000 {
+			return fmt.Sprintf("NVARCHAR(%d)", size)
+		}
+		return "NVARCHAR(MAX)"
+	case "clickhouse":
+		return "Nullable(String)"
 	}
 	return ""
 }
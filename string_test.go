@@ -1,10 +1,15 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"fmt"
+	"slices"
 	"testing"
+	"time"
 	"unicode/utf8"
 
 	"github.com/tee8z/nullable"
+	"gorm.io/gorm/schema"
 	"gorm.io/gorm/utils/tests"
 )
 
@@ -45,6 +50,36 @@ func TestScanString(t *testing.T) {
 	tests.AssertEqual(t, nullableString.Get(), nil)
 }
 
+func TestScanTimeString(t *testing.T) {
+	nullableString := nullable.NullString()
+
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	tests.AssertEqual(t, nullableString.Scan(time.Date(2021, time.March, 4, 5, 6, 7, 0, jakarta)), nil)
+	tests.AssertEqual(t, nullableString.MustGet(), "2021-03-04T05:06:07+07:00")
+
+	tests.AssertEqual(t, nullableString.Scan(time.Date(2021, time.March, 4, 5, 6, 7, 120000000, time.UTC)), nil)
+	tests.AssertEqual(t, nullableString.MustGet(), "2021-03-04T05:06:07.12Z")
+
+	var wrapped interface{} = time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)
+	tests.AssertEqual(t, nullableString.Scan(&wrapped), nil)
+	tests.AssertEqual(t, nullableString.MustGet(), "2021-03-04T00:00:00Z")
+
+	trimmed := nullable.NullStringTrimmed()
+	tests.AssertEqual(t, trimmed.Scan(time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)), nil)
+	tests.AssertEqual(t, trimmed.MustGet(), "2021-03-04T00:00:00Z")
+}
+
+func TestScanRawBytesString(t *testing.T) {
+	nullableString := nullable.NewString(nil)
+
+	driverBuffer := sql.RawBytes("first")
+	tests.AssertEqual(t, nullableString.Scan(driverBuffer), nil)
+
+	// sql.RawBytes is only valid until the next row overwrites it
+	copy(driverBuffer, "other")
+	tests.AssertEqual(t, nullableString.Get(), "first")
+}
+
 func TestNewString(t *testing.T) {
 	basicString1 := ""
 	nullableString1 := nullable.NewString(&basicString1)
@@ -94,6 +129,288 @@ func TestSetString(t *testing.T) {
 	tests.AssertEqual(t, nullableString.Get(), nil)
 }
 
+func TestStringFrom(t *testing.T) {
+	var basic string = "Hello World!"
+	tests.AssertEqual(t, nullable.StringFrom(basic), nullable.NewString(&basic))
+	tests.AssertEqual(t, nullable.StringFrom(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullString(), nullable.NewString(nil))
+	tests.AssertEqual(t, nullable.NullString().IsNull(), true)
+}
+
+func TestCoalesceString(t *testing.T) {
+	var basic string = "Hello World!"
+	var zero string = ""
+	tests.AssertEqual(t, nullable.CoalesceString(), nullable.String{})
+	tests.AssertEqual(t, nullable.CoalesceString(nullable.NullString(), nullable.NullString()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceString(nullable.NullString(), nullable.StringFrom(basic), nullable.StringFrom(zero)), nullable.StringFrom(basic))
+	tests.AssertEqual(t, nullable.CoalesceString(nullable.StringFrom(zero), nullable.StringFrom(basic)), nullable.StringFrom(zero))
+}
+
+func TestIsZeroString(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullString().IsZero(), true)
+	tests.AssertEqual(t, nullable.StringFrom("").IsZero(), false)
+	tests.AssertEqual(t, nullable.StringFrom("Hello World!").IsZero(), false)
+}
+
+func TestCloneString(t *testing.T) {
+	tests.AssertEqual(t, nullable.StringFrom("Hello World!").Clone(), nullable.StringFrom("Hello World!"))
+	tests.AssertEqual(t, nullable.NullString().Clone(), nullable.NullString())
+}
+
+func TestUnwrapString(t *testing.T) {
+	var basic string = "Hello World!"
+	value, ok := nullable.StringFrom(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullString().Unwrap()
+	tests.AssertEqual(t, value, "")
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.String
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, "")
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseString(t *testing.T) {
+	parsed, err := nullable.ParseString("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullString())
+
+	text, err := nullable.StringFrom("Hello World!").MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseString(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.StringFrom("Hello World!")), true)
+}
+
+func TestMustParseString(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseString(""), nullable.NullString())
+
+	text, err := nullable.StringFrom("Hello World!").MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseString(string(text)).Equal(nullable.StringFrom("Hello World!")), true)
+}
+
+func TestPtrString(t *testing.T) {
+	var basic string = "Hello World!"
+	tests.AssertEqual(t, nullable.StringPtr(nullable.NewString(&basic)), basic)
+	tests.AssertEqual(t, nullable.StringPtr(nullable.NewString(nil)), nil)
+	tests.AssertEqual(t, nullable.StringValue(nullable.NewString(&basic)), basic)
+	tests.AssertEqual(t, nullable.StringValue(nullable.NewString(nil)), "")
+}
+
+func TestValidateString(t *testing.T) {
+	short := func(value string) error {
+		if utf8.RuneCountInString(value) > 3 {
+			return fmt.Errorf("%q is longer than 3 characters", value)
+		}
+		return nil
+	}
+
+	tests.AssertEqual(t, nullable.StringFrom("abc").Validate(short), nil)
+	tests.AssertEqual(t, nullable.StringFrom("abcd").Validate(short).Error(), `"abcd" is longer than 3 characters`)
+	tests.AssertEqual(t, nullable.NullString().Validate(short), nil)
+	tests.AssertEqual(t, nullable.StringTrimmedFrom("abcd").Validate(short) != nil, true)
+}
+
+func TestSetFromInterfaceString(t *testing.T) {
+	text := "pointed"
+	nullableString := nullable.NullString()
+	tests.AssertEqual(t, nullableString.SetFromInterface("Hello"), nil)
+	tests.AssertEqual(t, nullableString, nullable.StringFrom("Hello"))
+	tests.AssertEqual(t, nullableString.SetFromInterface(&text), nil)
+	tests.AssertEqual(t, nullableString, nullable.StringFrom("pointed"))
+	tests.AssertEqual(t, nullableString.SetFromInterface(42), nil)
+	tests.AssertEqual(t, nullableString, nullable.StringFrom("42"))
+
+	if err := nullableString.SetFromInterface(struct{}{}); err == nil {
+		t.Error("setting a struct as String must fail")
+	}
+	tests.AssertEqual(t, nullableString, nullable.StringFrom("42"))
+
+	tests.AssertEqual(t, nullableString.SetFromInterface(nil), nil)
+	tests.AssertEqual(t, nullableString.IsNull(), true)
+}
+
+func TestSetValueString(t *testing.T) {
+	var basic string = "Hello World!"
+	var nullableString nullable.String
+	nullableString.SetValue(basic)
+	tests.AssertEqual(t, nullableString, nullable.StringFrom(basic))
+
+	nullableString.SetNull()
+	tests.AssertEqual(t, nullableString, nullable.NullString())
+}
+
+func TestZeroAsNullString(t *testing.T) {
+	var basic string = "Hello World!"
+	var zero string = ""
+	tests.AssertEqual(t, nullable.StringZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.StringZeroAsNull(basic), nullable.StringFrom(basic))
+
+	nullableString := nullable.StringFrom(zero)
+	nullableString.NullIfZero()
+	tests.AssertEqual(t, nullableString, nullable.NullString())
+
+	nullableString = nullable.StringFrom(basic)
+	nullableString.NullIfZero()
+	tests.AssertEqual(t, nullableString, nullable.StringFrom(basic))
+}
+
+func TestStringFromSQL(t *testing.T) {
+	var basic string = "Hello World!"
+	tests.AssertEqual(t, nullable.StringFromSQL(sql.NullString{String: basic, Valid: true}), nullable.StringFrom(basic))
+	tests.AssertEqual(t, nullable.StringFromSQL(sql.NullString{String: basic}), nullable.NullString())
+
+	tests.AssertEqual(t, nullable.StringFrom(basic).ToSQL(), sql.NullString{String: basic, Valid: true})
+	tests.AssertEqual(t, nullable.NullString().ToSQL(), sql.NullString{})
+}
+
+func TestIsNullString(t *testing.T) {
+	var zero nullable.String
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic string = "Hello World!"
+	nullableString := nullable.NewString(&basic)
+	tests.AssertEqual(t, nullableString.IsNull(), false)
+	tests.AssertEqual(t, nullableString.IsValid(), true)
+
+	nullableString.Set(nil)
+	tests.AssertEqual(t, nullableString.IsNull(), true)
+	tests.AssertEqual(t, nullableString.IsValid(), false)
+}
+
+func TestGetOrString(t *testing.T) {
+	var zero nullable.String
+	var fallback string = "Hello World!"
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), "")
+
+	var basic string = "Hello World!"
+	nullableString := nullable.NewString(&basic)
+	tests.AssertEqual(t, nullableString.GetOr(""), basic)
+	tests.AssertEqual(t, nullableString.GetOrZero(), basic)
+}
+
+func TestMustGetString(t *testing.T) {
+	var basic string = "Hello World!"
+	nullableString := nullable.NewString(&basic)
+	tests.AssertEqual(t, nullableString.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL String")
+	}()
+	nullableString.Set(nil)
+	nullableString.MustGet()
+	t.Error("MustGet on NULL String must panic")
+}
+
+func TestTextString(t *testing.T) {
+	var basic string = "Hello World!"
+	marshalUnmarshalText(t, nullable.NewString(&basic))
+
+	marshalUnmarshalText(t, nullable.NewString(nil))
+}
+
+func TestCSVString(t *testing.T) {
+	var basic string = "Hello World!"
+	marshalUnmarshalCSV(t, nullable.NewString(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewString(nil))
+}
+
+func TestYAMLString(t *testing.T) {
+	var basic string = "Hello World!"
+	marshalUnmarshalYAML(t, nullable.NewString(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewString(nil))
+}
+
+func TestMsgpackString(t *testing.T) {
+	var basic string = "Hello World!"
+	marshalUnmarshalMsgpack(t, nullable.NewString(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewString(nil))
+}
+
+func TestBSONString(t *testing.T) {
+	var basic string = "Hello World!"
+	marshalUnmarshalBSON(t, nullable.NewString(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewString(nil))
+}
+
+func TestGobString(t *testing.T) {
+	var basic string = "Hello World!"
+	marshalUnmarshalGob(t, nullable.NewString(&basic))
+
+	var zero string = ""
+	marshalUnmarshalGob(t, nullable.NewString(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewString(nil))
+}
+
+func TestStringerString(t *testing.T) {
+	var basic string = "Hello World!"
+	nullableString := nullable.NewString(&basic)
+	tests.AssertEqual(t, nullableString.String(), "Hello World!")
+	tests.AssertEqual(t, fmt.Sprint(nullableString), "Hello World!")
+
+	nullableString.Set(nil)
+	tests.AssertEqual(t, nullableString.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableString), "<null>")
+}
+
+func TestChangedString(t *testing.T) {
+	var basic string = "Hello World!"
+	value, null := nullable.NewString(&basic), nullable.NewString(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.StringFrom("")), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewString(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewString(nil)), false)
+}
+
+func TestEqualString(t *testing.T) {
+	var basic string = "Hello World!"
+	var zero string = ""
+	tests.AssertEqual(t, nullable.NewString(nil).Equal(nullable.NewString(nil)), true)
+	tests.AssertEqual(t, nullable.NewString(nil).Equal(nullable.NewString(&basic)), false)
+	tests.AssertEqual(t, nullable.NewString(&basic).Equal(nullable.NewString(nil)), false)
+	tests.AssertEqual(t, nullable.NewString(&basic).Equal(nullable.NewString(&basic)), true)
+	tests.AssertEqual(t, nullable.NewString(&basic).Equal(nullable.NewString(&zero)), false)
+	tests.AssertEqual(t, nullable.NewString(&zero).Equal(nullable.NewString(nil)), false)
+}
+
+func TestCompareString(t *testing.T) {
+	var lesser string = "Apple"
+	var greater string = "Banana"
+	null := nullable.NewString(nil)
+	small := nullable.NewString(&lesser)
+	big := nullable.NewString(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewString(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewString(&greater)), 0)
+
+	values := []nullable.String{big, null, small}
+	slices.SortFunc(values, nullable.String.Compare)
+	tests.AssertEqual(t, values, []nullable.String{null, small, big})
+}
+
+func TestXMLString(t *testing.T) {
+	var basic string = "Hello World!"
+	marshalUnmarshalXML(t, nullable.NewString(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewString(nil))
+}
+
 func TestJSONString(t *testing.T) {
 	basicString1 := ""
 	marshalUnmarshalJSON(t, nullable.NewString(&basicString1))
@@ -202,3 +519,55 @@ func TestString(t *testing.T) {
 	}
 	tests.AssertEqual(t, result6, product6)
 }
+
+func TestStringDBDataType(t *testing.T) {
+	sized := &schema.Field{Size: 255}
+	huge := &schema.Field{Size: 5000}
+	cases := []struct {
+		dialect  string
+		field    *schema.Field
+		expected string
+	}{
+		{"mysql", &schema.Field{}, "TEXT"},
+		{"mysql", sized, "VARCHAR(255)"},
+		{"sqlite", sized, "VARCHAR(255)"},
+		{"postgres", &schema.Field{}, "text"},
+		{"postgres", sized, "varchar(255)"},
+		{"sqlserver", sized, "NVARCHAR(255)"},
+		{"sqlserver", huge, "NVARCHAR(MAX)"},
+		{"clickhouse", sized, "Nullable(String)"},
+		{"mysql", nil, "TEXT"},
+	}
+	for _, c := range cases {
+		tests.AssertEqual(t, nullable.String{}.GormDBDataType(DialectDB(c.dialect), c.field), c.expected)
+	}
+}
+
+func TestSizedString(t *testing.T) {
+	type TestNullableSizedString struct {
+		ID    uint
+		Code  nullable.String `gorm:"size:32"`
+		Notes nullable.String
+	}
+
+	DB.Migrator().DropTable(&TestNullableSizedString{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableSizedString{}); err != nil {
+		t.Fatalf("failed to migrate sized nullable string, got error: %v", err)
+	}
+
+	columnTypes, err := DB.Migrator().ColumnTypes(&TestNullableSizedString{})
+	if err != nil {
+		t.Fatalf("failed to read column types, got error: %v", err)
+	}
+	for _, columnType := range columnTypes {
+		switch columnType.Name() {
+		case "code":
+			length, ok := columnType.Length()
+			tests.AssertEqual(t, ok, true)
+			tests.AssertEqual(t, length, int64(32))
+		case "notes":
+			length, _ := columnType.Length()
+			tests.AssertEqual(t, length != 32, true)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package nullable
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// emptyForNullString lets StringEmptyForNull embed String under an
+// unexported name, so the embedded field does not clash with the promoted
+// String method
+type emptyForNullString = String
+
+// StringEmptyForNull SQL type that can retrieve NULL value, written to JSON
+// as "" instead of null for consumers that cannot handle null strings.
+// Everything else behaves like String, UnmarshalJSON included, so null reads
+// back as NULL but "" reads back as a valid empty string: a NULL value comes
+// back from its own JSON as "", not as NULL.
+type StringEmptyForNull struct {
+	emptyForNullString
+}
+
+// NewStringEmptyForNull creates a new nullable string written to JSON as ""
+// when NULL
+func NewStringEmptyForNull(value *string) StringEmptyForNull {
+	return StringEmptyForNull{NewString(value)}
+}
+
+// StringEmptyForNullFrom creates a new valid nullable string from value
+func StringEmptyForNullFrom(value string) StringEmptyForNull {
+	return NewStringEmptyForNull(&value)
+}
+
+// NullStringEmptyForNull creates a new NULL string written to JSON as ""
+func NullStringEmptyForNull() StringEmptyForNull {
+	return NewStringEmptyForNull(nil)
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n StringEmptyForNull) Clone() StringEmptyForNull {
+	return n
+}
+
+// DebugString returns StringEmptyForNull("value") or StringEmptyForNull(NULL)
+func (n StringEmptyForNull) DebugString() string {
+	return debugString("StringEmptyForNull", n.isValid, strconv.Quote(n.realValue))
+}
+
+// Equal reports whether both values are NULL or both hold the same string
+func (n StringEmptyForNull) Equal(other StringEmptyForNull) bool {
+	return n.emptyForNullString.Equal(other.emptyForNullString)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n StringEmptyForNull) Changed(old StringEmptyForNull) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any string.
+func (n StringEmptyForNull) Compare(other StringEmptyForNull) int {
+	return n.emptyForNullString.Compare(other.emptyForNullString)
+}
+
+// MarshalJSON converts current value to JSON, NULL is "" rather than null
+func (n StringEmptyForNull) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.realValue)
+}
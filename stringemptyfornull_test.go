@@ -0,0 +1,86 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestNewStringEmptyForNull(t *testing.T) {
+	basic := "abc"
+	tests.AssertEqual(t, nullable.NewStringEmptyForNull(&basic).Get(), "abc")
+	tests.AssertEqual(t, nullable.StringEmptyForNullFrom("abc").Get(), "abc")
+	tests.AssertEqual(t, nullable.NullStringEmptyForNull().IsNull(), true)
+}
+
+func TestMarshalJSONStringEmptyForNull(t *testing.T) {
+	type payload struct {
+		Name  nullable.StringEmptyForNull `json:"name"`
+		Plain nullable.String             `json:"plain"`
+	}
+
+	serialized, err := json.Marshal(payload{})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `{"name":"","plain":null}`)
+
+	serialized, err = json.Marshal(payload{
+		Name:  nullable.StringEmptyForNullFrom(`say "hi"`),
+		Plain: nullable.StringFrom("abc"),
+	})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `{"name":"say \"hi\"","plain":"abc"}`)
+
+	// A valid empty string is written the same way as NULL
+	serialized, err = json.Marshal(nullable.StringEmptyForNullFrom(""))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `""`)
+}
+
+func TestUnmarshalJSONStringEmptyForNull(t *testing.T) {
+	var unserialized nullable.StringEmptyForNull
+	tests.AssertEqual(t, json.Unmarshal([]byte(`"abc"`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized, nullable.StringEmptyForNullFrom("abc"))
+
+	tests.AssertEqual(t, json.Unmarshal([]byte(`null`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.IsNull(), true)
+
+	// "" is a valid empty string, so NULL does not survive a round trip
+	serialized, err := json.Marshal(nullable.NullStringEmptyForNull())
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+	tests.AssertEqual(t, unserialized.IsValid(), true)
+	tests.AssertEqual(t, unserialized.Get(), "")
+}
+
+func TestEqualStringEmptyForNull(t *testing.T) {
+	basic := nullable.StringEmptyForNullFrom("")
+	tests.AssertEqual(t, basic.Equal(nullable.StringEmptyForNullFrom("")), true)
+	tests.AssertEqual(t, basic.Equal(nullable.NullStringEmptyForNull()), false)
+	tests.AssertEqual(t, basic.Changed(nullable.NullStringEmptyForNull()), true)
+	tests.AssertEqual(t, basic.Compare(nullable.NullStringEmptyForNull()), 1)
+	tests.AssertEqual(t, nullable.NullStringEmptyForNull().String(), "<null>")
+}
+
+func TestStringEmptyForNull(t *testing.T) {
+	type TestNullableStringEmptyForNull struct {
+		ID   uint
+		Name nullable.StringEmptyForNull
+	}
+
+	DB.Migrator().DropTable(&TestNullableStringEmptyForNull{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableStringEmptyForNull{}); err != nil {
+		t.Errorf("failed to migrate nullable empty for null string, got error: %v", err)
+	}
+
+	// The column still holds NULL, only JSON writes it as ""
+	unknown := TestNullableStringEmptyForNull{}
+	DB.Create(&unknown)
+
+	var result TestNullableStringEmptyForNull
+	if err := DB.First(&result, unknown.ID).Error; err != nil {
+		t.Fatalf("Cannot read empty for null string test record")
+	}
+	tests.AssertEqual(t, result.Name.IsNull(), true)
+}
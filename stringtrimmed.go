@@ -0,0 +1,84 @@
+package nullable
+
+import (
+	"strconv"
+	"strings"
+)
+
+// trimmedString lets StringTrimmed embed String under an unexported name,
+// so the embedded field does not clash with the promoted String method
+type trimmedString = String
+
+// StringTrimmed SQL type that can retrieve NULL value, dropping the trailing
+// spaces CHAR(n) columns are padded with. Only Scan trims, everything else
+// behaves like String.
+type StringTrimmed struct {
+	trimmedString
+}
+
+// NewStringTrimmed creates a new nullable trimmed string
+func NewStringTrimmed(value *string) StringTrimmed {
+	return StringTrimmed{NewString(value)}
+}
+
+// StringTrimmedFrom creates a new valid nullable trimmed string from value
+func StringTrimmedFrom(value string) StringTrimmed {
+	return NewStringTrimmed(&value)
+}
+
+// NullStringTrimmed creates a new NULL trimmed string
+func NullStringTrimmed() StringTrimmed {
+	return NewStringTrimmed(nil)
+}
+
+// SetFromInterface is String.SetFromInterface that trims like Scan does
+func (n *StringTrimmed) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n StringTrimmed) Clone() StringTrimmed {
+	return n
+}
+
+// DebugString returns StringTrimmed("value") or StringTrimmed(NULL)
+func (n StringTrimmed) DebugString() string {
+	return debugString("StringTrimmed", n.isValid, strconv.Quote(n.realValue))
+}
+
+// Equal reports whether both values are NULL or both hold the same string
+func (n StringTrimmed) Equal(other StringTrimmed) bool {
+	return n.trimmedString.Equal(other.trimmedString)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n StringTrimmed) Changed(old StringTrimmed) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any string.
+func (n StringTrimmed) Compare(other StringTrimmed) int {
+	return n.trimmedString.Compare(other.trimmedString)
+}
+
+// Scan implements scanner interface, trimming trailing spaces
+func (n *StringTrimmed) Scan(value interface{}) error {
+	if err := n.trimmedString.Scan(value); err != nil {
+		return scanError(err)
+	}
+	if n.isValid {
+		n.realValue = strings.TrimRight(n.realValue, " ")
+	}
+	return nil
+}
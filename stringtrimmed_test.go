@@ -0,0 +1,96 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanStringTrimmed(t *testing.T) {
+	nullableString := nullable.NewString(nil)
+	tests.AssertEqual(t, nullableString.Scan("abc   "), nil)
+	tests.AssertEqual(t, nullableString.Get(), "abc   ")
+
+	nullableStringTrimmed := nullable.NewStringTrimmed(nil)
+	tests.AssertEqual(t, nullableStringTrimmed.Scan("abc   "), nil)
+	tests.AssertEqual(t, nullableStringTrimmed.Get(), "abc")
+
+	tests.AssertEqual(t, nullableStringTrimmed.Scan([]byte("  abc \t ")), nil)
+	tests.AssertEqual(t, nullableStringTrimmed.Get(), "  abc \t")
+
+	tests.AssertEqual(t, nullableStringTrimmed.Scan("   "), nil)
+	tests.AssertEqual(t, nullableStringTrimmed.IsValid(), true)
+	tests.AssertEqual(t, nullableStringTrimmed.Get(), "")
+
+	tests.AssertEqual(t, nullableStringTrimmed.Scan(nil), nil)
+	tests.AssertEqual(t, nullableStringTrimmed.Get(), nil)
+}
+
+func TestNewStringTrimmed(t *testing.T) {
+	basic := "abc   "
+	tests.AssertEqual(t, nullable.NewStringTrimmed(&basic).Get(), "abc   ")
+	tests.AssertEqual(t, nullable.StringTrimmedFrom("abc").Get(), "abc")
+	tests.AssertEqual(t, nullable.NullStringTrimmed().IsNull(), true)
+}
+
+func TestSetFromInterfaceStringTrimmed(t *testing.T) {
+	nullableString := nullable.NullStringTrimmed()
+	tests.AssertEqual(t, nullableString.SetFromInterface("abc   "), nil)
+	tests.AssertEqual(t, nullableString, nullable.StringTrimmedFrom("abc"))
+}
+
+func TestEqualStringTrimmed(t *testing.T) {
+	basic := nullable.StringTrimmedFrom("abc")
+	tests.AssertEqual(t, basic.Equal(nullable.StringTrimmedFrom("abc")), true)
+	tests.AssertEqual(t, basic.Equal(nullable.NullStringTrimmed()), false)
+	tests.AssertEqual(t, basic.Compare(nullable.StringTrimmedFrom("abd")), -1)
+	tests.AssertEqual(t, basic.Clone(), basic)
+	tests.AssertEqual(t, basic.String(), "abc")
+}
+
+func TestJSONStringTrimmed(t *testing.T) {
+	serialized, err := json.Marshal(nullable.StringTrimmedFrom("abc"))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `"abc"`)
+
+	var unserialized nullable.StringTrimmed
+	tests.AssertEqual(t, json.Unmarshal([]byte(`null`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.IsNull(), true)
+}
+
+func TestStringTrimmed(t *testing.T) {
+	type TestNullableStringTrimmed struct {
+		ID   uint
+		Code nullable.StringTrimmed `gorm:"size:8"`
+		Raw  nullable.String        `gorm:"size:8"`
+	}
+
+	DB.Migrator().DropTable(&TestNullableStringTrimmed{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableStringTrimmed{}); err != nil {
+		t.Errorf("failed to migrate nullable trimmed string, got error: %v", err)
+	}
+
+	padded := TestNullableStringTrimmed{
+		Code: nullable.StringTrimmedFrom("abc   "),
+		Raw:  nullable.StringFrom("abc   "),
+	}
+	DB.Create(&padded)
+
+	unknown := TestNullableStringTrimmed{}
+	DB.Create(&unknown)
+
+	var result TestNullableStringTrimmed
+	if err := DB.First(&result, padded.ID).Error; err != nil {
+		t.Fatalf("Cannot read trimmed string test record")
+	}
+	tests.AssertEqual(t, result.Code.Get(), "abc")
+	tests.AssertEqual(t, result.Raw.Get(), "abc   ")
+
+	var nullResult TestNullableStringTrimmed
+	if err := DB.First(&nullResult, unknown.ID).Error; err != nil {
+		t.Fatalf("Cannot read trimmed string test record")
+	}
+	tests.AssertEqual(t, nullResult.Code.IsNull(), true)
+}
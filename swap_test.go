@@ -0,0 +1,79 @@
+package nullable_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestSwap(t *testing.T) {
+	var count nullable.Uint64
+	first, second := uint64(1), uint64(2)
+
+	// NULL to value
+	previous := count.Swap(&first)
+	tests.AssertEqual(t, previous == nil, true)
+	tests.AssertEqual(t, count, nullable.Uint64From(1))
+
+	// Value to value
+	previous = count.Swap(&second)
+	tests.AssertEqual(t, *previous, uint64(1))
+	tests.AssertEqual(t, count, nullable.Uint64From(2))
+
+	// Value to NULL
+	previous = count.Swap(nil)
+	tests.AssertEqual(t, *previous, uint64(2))
+	tests.AssertEqual(t, count.IsNull(), true)
+
+	// NULL to NULL
+	tests.AssertEqual(t, count.Swap(nil) == nil, true)
+
+	// The previous value is a copy, later changes don't reach it
+	count.SetValue(3)
+	previous = count.Swap(&first)
+	count.SetValue(4)
+	tests.AssertEqual(t, *previous, uint64(3))
+
+	name := nullable.StringFrom("old")
+	replacement := "new"
+	tests.AssertEqual(t, *name.Swap(&replacement), "old")
+	tests.AssertEqual(t, name, nullable.StringFrom("new"))
+
+	instant := time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	var updated nullable.Time
+	tests.AssertEqual(t, updated.Swap(&instant) == nil, true)
+	tests.AssertEqual(t, *updated.Swap(nil), instant)
+
+	big1 := nullable.BigIntFromInt64(1)
+	previousBig := big1.Swap(big.NewInt(2))
+	previousBig.SetInt64(100)
+	tests.AssertEqual(t, big1.Get().Int64(), int64(2))
+
+	tags := nullable.SliceFrom([]string{"a"})
+	tests.AssertEqual(t, *tags.Swap(nil), []string{"a"})
+	tests.AssertEqual(t, tags.IsNull(), true)
+}
+
+func TestSwapEnum(t *testing.T) {
+	pending, paid, refunded := "pending", "paid", "refunded"
+	status := nullable.NewEnum([]string{"pending", "paid"}, &pending)
+
+	previous, err := status.Swap(&paid)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, *previous, "pending")
+	tests.AssertEqual(t, status.Get(), "paid")
+
+	// A value that is not allowed leaves the enum unchanged
+	previous, err = status.Swap(&refunded)
+	tests.AssertEqual(t, err != nil, true)
+	tests.AssertEqual(t, previous == nil, true)
+	tests.AssertEqual(t, status.Get(), "paid")
+
+	previous, err = status.Swap(nil)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, *previous, "paid")
+	tests.AssertEqual(t, status.IsNull(), true)
+}
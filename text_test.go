@@ -0,0 +1,65 @@
+package nullable_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func marshalUnmarshalText[T encoding.TextMarshaler, P interface {
+	*T
+	encoding.TextUnmarshaler
+}](t *testing.T, target T) {
+	serialized, err := target.MarshalText()
+	if err != nil {
+		t.Fatalf("Failed to marshal %T because: %s", target, err)
+		return
+	}
+
+	var unserialized T
+	if err := P(&unserialized).UnmarshalText(serialized); err != nil {
+		t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+		return
+	}
+	tests.AssertEqual(t, unserialized, target)
+}
+
+func TestMarshalTextNull(t *testing.T) {
+	serialized, err := nullable.NewInt64(nil).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, len(serialized), 0)
+
+	basicTime := time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	serialized, err = nullable.NewTime(&basicTime).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "2021-03-04T05:06:07Z")
+}
+
+func TestUnmarshalTextNull(t *testing.T) {
+	nullableInt := nullable.NewInt64(new(int64))
+	tests.AssertEqual(t, nullableInt.UnmarshalText([]byte{}), nil)
+	tests.AssertEqual(t, nullableInt.IsNull(), true)
+
+	if err := nullableInt.UnmarshalText([]byte("forty two")); err == nil {
+		t.Error("unmarshalling malformed number must fail")
+	}
+}
+
+func TestTextAsMapKey(t *testing.T) {
+	basicString := "cat"
+	counts := map[nullable.String]int{
+		nullable.NewString(&basicString): 9,
+	}
+
+	serialized, err := json.Marshal(counts)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `{"cat":9}`)
+
+	var unserialized map[nullable.String]int
+	tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+	tests.AssertEqual(t, unserialized, counts)
+}
@@ -1,93 +1,541 @@
 package nullable
 
 import (
+	"database/sql"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
 )
 
+// timeScanLayouts are tried in order when a driver returns time as text
+var timeScanLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999",
+	time.DateOnly,
+}
+
 // Time SQL type that can retrieve NULL value
 type Time struct {
-	realValue time.Time
-	isValid   bool
+	core[time.Time]
+	// location Scan converts into, the local zone when nil
+	location *time.Location
+	// epoch is the unit of the integer JSON holds, RFC 3339 text when 0
+	epoch time.Duration
 }
 
 // NewTime creates a new nullable 64-bit integer
 func NewTime(value *time.Time) Time {
-	if value == nil {
-		return Time{
-			realValue: time.Time{},
-			isValid:   false,
+	return Time{core: newCore(value)}
+}
+
+// TimeFrom creates a new valid nullable time from value
+func TimeFrom(value time.Time) Time {
+	return NewTime(&value)
+}
+
+// NullTime creates a new NULL time
+func NullTime() Time {
+	return NewTime(nil)
+}
+
+// TimeZeroAsNull creates a new nullable time that is NULL when value is the zero value
+func TimeZeroAsNull(value time.Time) Time {
+	if value.IsZero() {
+		return NullTime()
+	}
+	return TimeFrom(value)
+}
+
+// TimeFromSQL creates a new nullable time from sql.NullTime
+func TimeFromSQL(value sql.NullTime) Time {
+	if !value.Valid {
+		return NullTime()
+	}
+	return TimeFrom(value.Time)
+}
+
+// NewTimeInLocation creates a new NULL time whose Scan converts into loc,
+// such as time.UTC for drivers that return times in mixed zones
+func NewTimeInLocation(loc *time.Location) Time {
+	return Time{location: loc}
+}
+
+// TimeUnix creates a new NULL time whose JSON is an integer count of seconds
+// since the Unix epoch, such as 1614834367, rather than RFC 3339 text
+func TimeUnix() Time {
+	return Time{epoch: time.Second}
+}
+
+// TimeUnixMilli creates a new NULL time whose JSON is an integer count of
+// milliseconds since the Unix epoch, such as 1614834367123
+func TimeUnixMilli() Time {
+	return Time{epoch: time.Millisecond}
+}
+
+// CoalesceTime returns the first valid value, or NULL when all of them are NULL
+func CoalesceTime(values ...Time) Time {
+	for _, value := range values {
+		if value.isValid {
+			return value
 		}
 	}
-	return Time{
-		realValue: *value,
-		isValid:   true,
+	return Time{}
+}
+
+// MinTime returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinTime(values ...Time) Time {
+	var result Time
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// MaxTime returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxTime(values ...Time) Time {
+	var result Time
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// TimePtr returns n.Get(), handy where a function value is needed
+func TimePtr(n Time) *time.Time {
+	return n.Get()
+}
+
+// TimeValue returns n.GetOrZero(), zero value when NULL
+func TimeValue(n Time) time.Time {
+	return n.GetOrZero()
+}
+
+// ParseTime parses text like UnmarshalText does, empty text is NULL
+func ParseTime(text string) (Time, error) {
+	var n Time
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Time{}, err
+	}
+	return n, nil
+}
+
+// MustParseTime is ParseTime that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseTime(text string) Time {
+	n, err := ParseTime(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseTime(%q): %v", text, err))
+	}
+	return n
+}
+
+// SetLocation makes Scan convert into loc, nil is the local zone. The
+// value held already is left as is.
+func (n *Time) SetLocation(loc *time.Location) {
+	n.location = loc
+}
+
+// SetEpochUnit makes JSON an integer count of unit since the Unix epoch,
+// such as time.Second or time.Millisecond, 0 going back to RFC 3339 text.
+// The value held already is left as is.
+func (n *Time) SetEpochUnit(unit time.Duration) {
+	n.epoch = unit
+}
+
+// Reset sets the value back to the zero NULL Time, the one a declared
+// Time starts with, so pooled values can be reused. Unlike SetNull it also
+// drops the location and epoch unit.
+func (n *Time) Reset() {
+	*n = Time{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Time) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Time) NullIfZero() {
+	if n.isValid && n.realValue.IsZero() {
+		n.SetNull()
+	}
+}
+
+// MustGet either time or panic when NULL
+func (n Time) MustGet() time.Time {
+	return n.mustGet("Time")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Time) Clone() Time {
+	return n
+}
+
+// String returns time in its natural text form, or "<null>" when NULL
+func (n Time) String() string {
+	if !n.isValid {
+		return nullString
 	}
+	return n.realValue.Format(time.RFC3339Nano)
 }
 
-// Get either nil or 64-bit integer
-func (n Time) Get() *time.Time {
+// DebugString returns Time(value) or Time(NULL), telling NULL apart from a
+// zero value in logs
+func (n Time) DebugString() string {
+	return debugString("Time", n.isValid, n.String())
+}
+
+// Format returns time formatted with layout like time.Time.Format does, or
+// empty string when NULL so a report cell stays blank
+func (n Time) Format(layout string) string {
 	if !n.isValid {
-		return nil
+		return ""
 	}
-	return &n.realValue
+	return n.realValue.Format(layout)
 }
 
-// Set either nil or 64-bit integer
-func (n *Time) Set(value *time.Time) {
-	n.isValid = (value != nil)
-	if n.isValid {
-		n.realValue = *value
-	} else {
-		n.realValue = time.Time{}
+// Equal reports whether both values are NULL or both hold the same time
+func (n Time) Equal(other Time) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue.Equal(other.realValue)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Time) Changed(old Time) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any time.
+func (n Time) Compare(other Time) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
 	}
+	return n.realValue.Compare(other.realValue)
+}
+
+// ToSQL converts current value to sql.NullTime
+func (n Time) ToSQL() sql.NullTime {
+	return sql.NullTime{Time: n.realValue, Valid: n.isValid}
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL the instant, without the location like Equal, telling NULL apart from
+// zero in cache keys
+func (n Time) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagTime, n.isValid, hashTime(n.realValue))
 }
 
-// MarshalJSON converts current value to JSON
+// MarshalJSON converts current value to JSON, RFC 3339 text unless an epoch
+// unit is set, then an integer count of that unit truncating finer digits
 func (n Time) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	if !n.isValid || n.epoch == 0 {
+		return json.Marshal(n.Get())
+	}
+	return strconv.AppendInt(nil, epochCount(n.realValue, n.epoch), 10), nil
 }
 
 // UnmarshalJSON writes JSON to this type
 func (n *Time) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
+
+	var parsed time.Time
+	if count, isInteger := jsonInteger(data); n.epoch != 0 && isInteger {
+		parsed = epochTime(count, n.epoch)
+	} else if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Time) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return n.realValue.AppendFormat(nil, time.RFC3339Nano), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Time) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, string(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Time) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Time) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Time) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return n.realValue.UTC().Format(time.RFC3339Nano), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Time) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Time) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
+
+	var parsed time.Time
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n Time) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue, start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Time) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
+
+	parsed, err := time.Parse(time.RFC3339, strings.TrimSpace(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Time) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Time) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
+
+	var parsed time.Time
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Time) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Time) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
 		n.isValid = false
 		n.realValue = time.Time{}
 		return nil
 	}
 
 	var parsed time.Time
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Time) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Time) GobDecode(data []byte) error {
+	var parsed time.Time
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
 		return err
 	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = time.Time{}
+		return nil
+	}
 
 	n.isValid = true
 	n.realValue = parsed
 	return nil
 }
 
-// Scan implements scanner interface
+// Scan implements scanner interface, converting into the location given to
+// NewTimeInLocation or SetLocation, the local zone by default
 func (n *Time) Scan(value interface{}) error {
+	value = scanValue(value)
 	if value == nil {
 		n.realValue, n.isValid = time.Time{}, false
 		return nil
 	}
 
-	var utcTime time.Time
-	if err := convertAssign(&utcTime, value); err != nil {
-		return err
+	var scanned time.Time
+	switch value := plainValue(value).(type) {
+	case time.Time:
+		scanned = value
+	case int64:
+		// Unix timestamp in seconds
+		scanned = time.Unix(value, 0)
+	case []byte:
+		parsed, err := parseScannedTime(string(value))
+		if err != nil {
+			return scanError(err)
+		}
+		scanned = parsed
+	case string:
+		parsed, err := parseScannedTime(value)
+		if err != nil {
+			return scanError(err)
+		}
+		scanned = parsed
+	default:
+		if err := convertAssign(&scanned, value); err != nil {
+			return scanError(err)
+		}
+	}
+	if n.location != nil {
+		n.realValue = scanned.In(n.location)
+	} else {
+		n.realValue = scanned.Local()
 	}
-	n.realValue = utcTime.Local()
 
 	n.isValid = true
 	return nil
 }
 
+// parseScannedTime parses text with the first of timeScanLayouts that fits,
+// which are RFC 3339, "2006-01-02 15:04:05" with optional fraction and zone,
+// the same with T separator but without zone, and "2006-01-02". Text
+// without zone is read as UTC.
+func parseScannedTime(text string) (time.Time, error) {
+	for _, layout := range timeScanLayouts {
+		if parsed, err := time.Parse(layout, text); err == nil {
+			return parsed, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("nullable: cannot scan %q into Time, expected RFC 3339, \"2006-01-02 15:04:05\" or \"2006-01-02\"", text)
+}
+
 // Value implements the driver Valuer interface.
 func (n Time) Value() (driver.Value, error) {
 	if !n.isValid {
@@ -102,14 +550,82 @@ func (Time) GormDataType() string {
 }
 
 // GormDBDataType gorm db data type
-func (Time) GormDBDataType(db *gorm.DB, field *schema.Field) string {
-	switch db.Dialector.Name() {
+func (n Time) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Time) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Time) columnType(dialect string, field *schema.Field) string {
+	// Fractional seconds digits, every dialect takes at most 6 or 7
+	precision := fieldPrecision(field)
+	switch dialect {
 	case "sqlite":
 		return "DATETIME"
 	case "mysql":
-		return "TIMESTAMP NULL DEFAULT NULL"
-	case "postgres":
+		// TIMESTAMP may default to CURRENT_TIMESTAMP and be NOT NULL otherwise
+		if precision > 0 {
+			return fmt.Sprintf("TIMESTAMP(%d)", precision) + nullDefault(field)
+		}
+		return "TIMESTAMP" + nullDefault(field)
+	case "postgres", "cockroachdb":
+		if precision > 0 {
+			return fmt.Sprintf("timestamp(%d)", precision)
+		}
 		return "timestamp"
+	case "sqlserver":
+		if precision > 0 {
+			return fmt.Sprintf("DATETIME2(%d)", precision)
+		}
+		return "DATETIME2"
+	case "clickhouse":
+		if precision > 0 {
+			return fmt.Sprintf("Nullable(DateTime64(%d))", precision)
+		}
+		return "Nullable(DateTime64(6))"
 	}
 	return ""
 }
+
+// epochCount returns how many unit have passed between the Unix epoch and
+// value, rounding down
+func epochCount(value time.Time, unit time.Duration) int64 {
+	switch unit {
+	case time.Second:
+		return value.Unix()
+	case time.Millisecond:
+		return value.UnixMilli()
+	case time.Microsecond:
+		return value.UnixMicro()
+	}
+	count := value.UnixNano() / int64(unit)
+	if value.UnixNano()%int64(unit) < 0 {
+		count--
+	}
+	return count
+}
+
+// epochTime returns the UTC time count unit after the Unix epoch
+func epochTime(count int64, unit time.Duration) time.Time {
+	switch unit {
+	case time.Second:
+		return time.Unix(count, 0).UTC()
+	case time.Millisecond:
+		return time.UnixMilli(count).UTC()
+	case time.Microsecond:
+		return time.UnixMicro(count).UTC()
+	}
+	return time.Unix(0, count*int64(unit)).UTC()
+}
+
+// jsonInteger returns the integer data holds, false when data is a string
+// or a number with a fraction or exponent
+func jsonInteger(data []byte) (int64, bool) {
+	count, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	return count, err == nil
+}
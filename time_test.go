@@ -1,10 +1,15 @@
 package nullable_test
 
 import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/tee8z/nullable"
+	"gorm.io/gorm/schema"
 	"gorm.io/gorm/utils/tests"
 )
 
@@ -23,6 +28,153 @@ func TestScanTime(t *testing.T) {
 	tests.AssertEqual(t, nullableTime.Get(), nil)
 }
 
+func TestScanInLocationTime(t *testing.T) {
+	plusTwo := time.FixedZone("", 2*60*60)
+	expected := time.Date(2021, time.March, 4, 3, 6, 7, 0, time.UTC)
+
+	nullableTime := nullable.NewTimeInLocation(time.UTC)
+	tests.AssertEqual(t, nullableTime.Scan("2021-03-04T05:06:07+02:00"), nil)
+	tests.AssertEqual(t, nullableTime.Get().Location(), time.UTC)
+	tests.AssertEqual(t, nullableTime.Get().Equal(expected), true)
+
+	tests.AssertEqual(t, nullableTime.Scan(time.Date(2021, time.March, 4, 5, 6, 7, 0, plusTwo)), nil)
+	tests.AssertEqual(t, nullableTime.Get().Location(), time.UTC)
+	tests.AssertEqual(t, nullableTime.Get().Hour(), 3)
+
+	// NULL keeps the location for the next row
+	tests.AssertEqual(t, nullableTime.Scan(nil), nil)
+	tests.AssertEqual(t, nullableTime.Scan([]byte("2021-03-04 05:06:07+02:00")), nil)
+	tests.AssertEqual(t, nullableTime.Get().Location(), time.UTC)
+
+	nullableTime.SetLocation(plusTwo)
+	tests.AssertEqual(t, nullableTime.Scan(expected), nil)
+	tests.AssertEqual(t, nullableTime.Get().Location(), plusTwo)
+	tests.AssertEqual(t, nullableTime.Get().Hour(), 5)
+
+	// default stays the local zone
+	var local nullable.Time
+	tests.AssertEqual(t, local.Scan(expected), nil)
+	tests.AssertEqual(t, local.Get().Location(), time.Local)
+}
+
+func TestScanLayoutsTime(t *testing.T) {
+	expected := time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	inputs := []interface{}{
+		"2021-03-04T05:06:07Z",
+		[]byte("2021-03-04T12:06:07+07:00"),
+		"2021-03-04 05:06:07",
+		[]byte("2021-03-04 05:06:07"),
+		"2021-03-04 12:06:07+07:00",
+		"2021-03-04T05:06:07",
+		int64(1614834367),
+	}
+	for _, input := range inputs {
+		var nullableTime nullable.Time
+		tests.AssertEqual(t, nullableTime.Scan(input), nil)
+		tests.AssertEqual(t, nullableTime.Get().Equal(expected), true)
+	}
+
+	var nullableTime nullable.Time
+	tests.AssertEqual(t, nullableTime.Scan("2021-03-04 05:06:07.123456"), nil)
+	tests.AssertEqual(t, nullableTime.Get().Equal(expected.Add(123456*time.Microsecond)), true)
+
+	tests.AssertEqual(t, nullableTime.Scan("2021-03-04"), nil)
+	tests.AssertEqual(t, nullableTime.Get().Equal(time.Date(2021, time.March, 4, 0, 0, 0, 0, time.UTC)), true)
+
+	if err := nullableTime.Scan("yesterday"); err == nil {
+		t.Error("scanning unknown layout into Time must fail")
+	}
+	if err := nullableTime.Scan(true); err == nil {
+		t.Error("scanning bool into Time must fail")
+	}
+}
+
+func TestJSONLayoutTime(t *testing.T) {
+	basicTime := time.Date(2021, time.March, 4, 5, 6, 7, 123456789, time.UTC)
+	serialized, err := json.Marshal(nullable.NewTime(&basicTime))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `"2021-03-04T05:06:07.123456789Z"`)
+
+	var unserialized nullable.Time
+	tests.AssertEqual(t, json.Unmarshal([]byte(`"2021-03-04T12:06:07+07:00"`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.Get().Equal(basicTime.Truncate(time.Second)), true)
+
+	tests.AssertEqual(t, unserialized.UnmarshalJSON([]byte("null")), nil)
+	tests.AssertEqual(t, unserialized.IsNull(), true)
+	if err := unserialized.UnmarshalJSON([]byte{}); err == nil {
+		t.Error("unmarshalling empty JSON into time must fail")
+	}
+}
+
+func TestJSONEpochTime(t *testing.T) {
+	recent := time.Date(2024, time.May, 6, 7, 8, 9, 123456789, time.UTC)
+	cases := []struct {
+		prepared   nullable.Time
+		value      time.Time
+		serialized string
+		expected   time.Time
+	}{
+		{nullable.TimeUnix(), time.Unix(0, 0), `0`, time.Unix(0, 0).UTC()},
+		{nullable.TimeUnixMilli(), time.Unix(0, 0), `0`, time.Unix(0, 0).UTC()},
+		{nullable.TimeUnix(), recent, `1714979289`, recent.Truncate(time.Second)},
+		{nullable.TimeUnixMilli(), recent, `1714979289123`, recent.Truncate(time.Millisecond)},
+		{nullable.TimeUnixMilli(), time.Date(1969, time.December, 31, 23, 59, 59, 999500000, time.UTC), `-1`, time.UnixMilli(-1).UTC()},
+	}
+	for _, c := range cases {
+		value := c.prepared
+		value.SetValue(c.value)
+		serialized, err := json.Marshal(value)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(serialized), c.serialized)
+
+		unserialized := c.prepared
+		tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+		tests.AssertEqual(t, unserialized.MustGet(), c.expected)
+	}
+
+	// null stays NULL and keeps the unit for the next value
+	unserialized := nullable.TimeUnixMilli()
+	tests.AssertEqual(t, json.Unmarshal([]byte(`null`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.IsNull(), true)
+	serialized, err := json.Marshal(unserialized)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `null`)
+	tests.AssertEqual(t, json.Unmarshal([]byte(`1714979289123`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.MustGet(), recent.Truncate(time.Millisecond))
+
+	// RFC 3339 text is still read, and stays the default without a unit
+	tests.AssertEqual(t, json.Unmarshal([]byte(`"2024-05-06T07:08:09Z"`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.MustGet(), recent.Truncate(time.Second))
+	for _, data := range []string{`1.5`, `1e3`, `true`} {
+		if err := json.Unmarshal([]byte(data), &unserialized); err == nil {
+			t.Errorf("unmarshalling %s as epoch time must fail", data)
+		}
+	}
+	var plain nullable.Time
+	tests.AssertEqual(t, json.Unmarshal([]byte(`1714979289`), &plain) != nil, true)
+
+	// A field prepared with the unit keeps it through json.Unmarshal
+	type event struct {
+		At nullable.Time `json:"at"`
+	}
+	received := event{At: nullable.TimeUnix()}
+	tests.AssertEqual(t, json.Unmarshal([]byte(`{"at":1714979289}`), &received), nil)
+	tests.AssertEqual(t, received.At.MustGet(), recent.Truncate(time.Second))
+	serialized, err = json.Marshal(received)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `{"at":1714979289}`)
+
+	micro := nullable.TimeFrom(recent)
+	micro.SetEpochUnit(time.Microsecond)
+	serialized, err = json.Marshal(micro)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `1714979289123456`)
+	micro.SetEpochUnit(0)
+	serialized, err = json.Marshal(micro)
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `"2024-05-06T07:08:09.123456789Z"`)
+}
+
 func TestNewTime(t *testing.T) {
 	basicTime1 := time.Now()
 	nullableTime1 := nullable.NewTime(&basicTime1)
@@ -44,6 +196,307 @@ func TestSetTime(t *testing.T) {
 	tests.AssertEqual(t, nullableTime.Get(), nil)
 }
 
+func TestTimeFrom(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	tests.AssertEqual(t, nullable.TimeFrom(basic), nullable.NewTime(&basic))
+	tests.AssertEqual(t, nullable.TimeFrom(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullTime(), nullable.NewTime(nil))
+	tests.AssertEqual(t, nullable.NullTime().IsNull(), true)
+}
+
+func TestCoalesceTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	var zero time.Time = time.Time{}
+	tests.AssertEqual(t, nullable.CoalesceTime(), nullable.Time{})
+	tests.AssertEqual(t, nullable.CoalesceTime(nullable.NullTime(), nullable.NullTime()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceTime(nullable.NullTime(), nullable.TimeFrom(basic), nullable.TimeFrom(zero)), nullable.TimeFrom(basic))
+	tests.AssertEqual(t, nullable.CoalesceTime(nullable.TimeFrom(zero), nullable.TimeFrom(basic)), nullable.TimeFrom(zero))
+}
+
+func TestIsZeroTime(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullTime().IsZero(), true)
+	tests.AssertEqual(t, nullable.TimeFrom(time.Time{}).IsZero(), false)
+	tests.AssertEqual(t, nullable.TimeFrom(time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)).IsZero(), false)
+}
+
+func TestCloneTime(t *testing.T) {
+	tests.AssertEqual(t, nullable.TimeFrom(time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)).Clone(), nullable.TimeFrom(time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)))
+	tests.AssertEqual(t, nullable.NullTime().Clone(), nullable.NullTime())
+}
+
+func TestUnwrapTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	value, ok := nullable.TimeFrom(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullTime().Unwrap()
+	tests.AssertEqual(t, value, time.Time{})
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Time
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, time.Time{})
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseTime(t *testing.T) {
+	parsed, err := nullable.ParseTime("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullTime())
+
+	text, err := nullable.TimeFrom(time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseTime(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.TimeFrom(time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC))), true)
+
+	for _, text := range []string{"abc"} {
+		if _, err := nullable.ParseTime(text); err == nil {
+			t.Errorf("parsing %q as time must fail", text)
+		}
+	}
+}
+
+func TestMustParseTime(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseTime(""), nullable.NullTime())
+
+	text, err := nullable.TimeFrom(time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseTime(string(text)).Equal(nullable.TimeFrom(time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC))), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseTime of malformed text must panic")
+		}
+	}()
+	nullable.MustParseTime("abc")
+}
+
+func TestPtrTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	tests.AssertEqual(t, nullable.TimePtr(nullable.NewTime(&basic)), basic)
+	tests.AssertEqual(t, nullable.TimePtr(nullable.NewTime(nil)), nil)
+	tests.AssertEqual(t, nullable.TimeValue(nullable.NewTime(&basic)), basic)
+	tests.AssertEqual(t, nullable.TimeValue(nullable.NewTime(nil)), time.Time{})
+}
+
+func TestSetFromInterfaceTime(t *testing.T) {
+	expected := time.Date(2021, time.March, 4, 3, 6, 7, 0, time.UTC)
+	nullableTime := nullable.NewTimeInLocation(time.UTC)
+	tests.AssertEqual(t, nullableTime.SetFromInterface("2021-03-04T05:06:07+02:00"), nil)
+	tests.AssertEqual(t, nullableTime.Get().Equal(expected), true)
+	tests.AssertEqual(t, nullableTime.Get().Location(), time.UTC)
+
+	if err := nullableTime.SetFromInterface("yesterday"); err == nil {
+		t.Error("setting yesterday as Time must fail")
+	}
+	tests.AssertEqual(t, nullableTime.Get().Equal(expected), true)
+
+	tests.AssertEqual(t, nullableTime.SetFromInterface(nil), nil)
+	tests.AssertEqual(t, nullableTime.IsNull(), true)
+}
+
+func TestSetValueTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	var nullableTime nullable.Time
+	nullableTime.SetValue(basic)
+	tests.AssertEqual(t, nullableTime, nullable.TimeFrom(basic))
+
+	nullableTime.SetNull()
+	tests.AssertEqual(t, nullableTime, nullable.NullTime())
+}
+
+func TestZeroAsNullTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	var zero time.Time = time.Time{}
+	tests.AssertEqual(t, nullable.TimeZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.TimeZeroAsNull(basic), nullable.TimeFrom(basic))
+
+	nullableTime := nullable.TimeFrom(zero)
+	nullableTime.NullIfZero()
+	tests.AssertEqual(t, nullableTime, nullable.NullTime())
+
+	nullableTime = nullable.TimeFrom(basic)
+	nullableTime.NullIfZero()
+	tests.AssertEqual(t, nullableTime, nullable.TimeFrom(basic))
+}
+
+func TestTimeFromSQL(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	tests.AssertEqual(t, nullable.TimeFromSQL(sql.NullTime{Time: basic, Valid: true}), nullable.TimeFrom(basic))
+	tests.AssertEqual(t, nullable.TimeFromSQL(sql.NullTime{Time: basic}), nullable.NullTime())
+
+	tests.AssertEqual(t, nullable.TimeFrom(basic).ToSQL(), sql.NullTime{Time: basic, Valid: true})
+	tests.AssertEqual(t, nullable.NullTime().ToSQL(), sql.NullTime{})
+}
+
+func TestIsNullTime(t *testing.T) {
+	var zero nullable.Time
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	nullableTime := nullable.NewTime(&basic)
+	tests.AssertEqual(t, nullableTime.IsNull(), false)
+	tests.AssertEqual(t, nullableTime.IsValid(), true)
+
+	nullableTime.Set(nil)
+	tests.AssertEqual(t, nullableTime.IsNull(), true)
+	tests.AssertEqual(t, nullableTime.IsValid(), false)
+}
+
+func TestGetOrTime(t *testing.T) {
+	var zero nullable.Time
+	var fallback time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), time.Time{})
+
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	nullableTime := nullable.NewTime(&basic)
+	tests.AssertEqual(t, nullableTime.GetOr(time.Time{}), basic)
+	tests.AssertEqual(t, nullableTime.GetOrZero(), basic)
+}
+
+func TestMustGetTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	nullableTime := nullable.NewTime(&basic)
+	tests.AssertEqual(t, nullableTime.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Time")
+	}()
+	nullableTime.Set(nil)
+	nullableTime.MustGet()
+	t.Error("MustGet on NULL Time must panic")
+}
+
+func TestTextTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	marshalUnmarshalText(t, nullable.NewTime(&basic))
+
+	marshalUnmarshalText(t, nullable.NewTime(nil))
+}
+
+func TestCSVTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	marshalUnmarshalCSV(t, nullable.NewTime(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewTime(nil))
+}
+
+func TestYAMLTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	marshalUnmarshalYAML(t, nullable.NewTime(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewTime(nil))
+}
+
+func TestMsgpackTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	marshalUnmarshalMsgpack(t, nullable.NewTime(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewTime(nil))
+}
+
+func TestBSONTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	marshalUnmarshalBSON(t, nullable.NewTime(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewTime(nil))
+}
+
+func TestGobTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	marshalUnmarshalGob(t, nullable.NewTime(&basic))
+
+	var zero time.Time = time.Time{}
+	marshalUnmarshalGob(t, nullable.NewTime(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewTime(nil))
+}
+
+func TestStringerTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	nullableTime := nullable.NewTime(&basic)
+	tests.AssertEqual(t, nullableTime.String(), "2021-03-04T05:06:07Z")
+	tests.AssertEqual(t, fmt.Sprint(nullableTime), "2021-03-04T05:06:07Z")
+
+	nullableTime.Set(nil)
+	tests.AssertEqual(t, nullableTime.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableTime), "<null>")
+}
+
+func TestFormatTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	nullableTime := nullable.NewTime(&basic)
+	tests.AssertEqual(t, nullableTime.Format("02 Jan 2006 15:04"), "04 Mar 2021 05:06")
+	tests.AssertEqual(t, nullableTime.Format(time.Kitchen), "5:06AM")
+	tests.AssertEqual(t, nullableTime.String(), "2021-03-04T05:06:07Z")
+
+	nullableTime.SetNull()
+	tests.AssertEqual(t, nullableTime.Format("02 Jan 2006 15:04"), "")
+	tests.AssertEqual(t, nullableTime.String(), "<null>")
+}
+
+func TestChangedTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	value, null := nullable.NewTime(&basic), nullable.NewTime(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.TimeFrom(time.Time{})), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewTime(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewTime(nil)), false)
+}
+
+func TestEqualTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	var zero time.Time = time.Time{}
+	tests.AssertEqual(t, nullable.NewTime(nil).Equal(nullable.NewTime(nil)), true)
+	tests.AssertEqual(t, nullable.NewTime(nil).Equal(nullable.NewTime(&basic)), false)
+	tests.AssertEqual(t, nullable.NewTime(&basic).Equal(nullable.NewTime(nil)), false)
+	tests.AssertEqual(t, nullable.NewTime(&basic).Equal(nullable.NewTime(&basic)), true)
+	tests.AssertEqual(t, nullable.NewTime(&basic).Equal(nullable.NewTime(&zero)), false)
+	tests.AssertEqual(t, nullable.NewTime(&zero).Equal(nullable.NewTime(nil)), false)
+}
+
+func TestEqualTimeLocation(t *testing.T) {
+	basicTime := time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	sameInstant := basicTime.In(time.FixedZone("UTC+2", 2*60*60))
+	tests.AssertEqual(t, nullable.NewTime(&basicTime).Equal(nullable.NewTime(&sameInstant)), true)
+
+	// Monotonic clock reading must not matter
+	now := time.Now()
+	withoutMonotonic := now.Round(0)
+	tests.AssertEqual(t, nullable.NewTime(&now).Equal(nullable.NewTime(&withoutMonotonic)), true)
+}
+
+func TestCompareTime(t *testing.T) {
+	var lesser time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	var greater time.Time = time.Date(2022, time.March, 4, 5, 6, 7, 0, time.UTC)
+	null := nullable.NewTime(nil)
+	small := nullable.NewTime(&lesser)
+	big := nullable.NewTime(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewTime(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewTime(&greater)), 0)
+
+	values := []nullable.Time{big, null, small}
+	slices.SortFunc(values, nullable.Time.Compare)
+	tests.AssertEqual(t, values, []nullable.Time{null, small, big})
+}
+
+func TestXMLTime(t *testing.T) {
+	var basic time.Time = time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	marshalUnmarshalXML(t, nullable.NewTime(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewTime(nil))
+}
+
 func TestJSONTime(t *testing.T) {
 	basicTime := time.Now()
 	marshalUnmarshalJSON(t, nullable.NewTime(&basicTime))
@@ -93,3 +546,27 @@ func TestTime(t *testing.T) {
 	}
 	tests.AssertEqual(t, result2, user2)
 }
+
+func TestTimeDBDataType(t *testing.T) {
+	precise := &schema.Field{Precision: 3}
+	cases := []struct {
+		dialect  string
+		field    *schema.Field
+		expected string
+	}{
+		{"mysql", &schema.Field{}, "TIMESTAMP NULL DEFAULT NULL"},
+		{"mysql", precise, "TIMESTAMP(3) NULL DEFAULT NULL"},
+		{"mysql", &schema.Field{HasDefaultValue: true, DefaultValue: "CURRENT_TIMESTAMP"}, "TIMESTAMP NULL"},
+		{"mysql", &schema.Field{NotNull: true}, "TIMESTAMP"},
+		{"mysql", nil, "TIMESTAMP NULL DEFAULT NULL"},
+		{"sqlite", precise, "DATETIME"},
+		{"postgres", &schema.Field{}, "timestamp"},
+		{"postgres", precise, "timestamp(3)"},
+		{"sqlserver", precise, "DATETIME2(3)"},
+		{"clickhouse", &schema.Field{}, "Nullable(DateTime64(6))"},
+		{"clickhouse", precise, "Nullable(DateTime64(3))"},
+	}
+	for _, c := range cases {
+		tests.AssertEqual(t, nullable.Time{}.GormDBDataType(DialectDB(c.dialect), c.field), c.expected)
+	}
+}
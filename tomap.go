@@ -0,0 +1,113 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var (
+	valuerType = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	nullerType = reflect.TypeOf((*interface{ IsNull() bool })(nil)).Elem()
+)
+
+// ToMap turns the struct v, or the struct v points to, into a map from field
+// key to value, the shape GORM's Updates and structured loggers take. NULL
+// nullables become nil and valid ones their underlying value, uint64 for
+// Uint64 and string for String. Other fields are kept as they are.
+//
+// A field is keyed by the name of its `db` tag, else of its `json` tag, else
+// by its Go name. Fields tagged "-" and unexported fields are left out, and
+// the fields of an untagged embedded struct are added as if they were v's.
+func ToMap(v interface{}) (map[string]any, error) {
+	value := reflect.ValueOf(v)
+	if value.Kind() == reflect.Ptr && !value.IsNil() {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("nullable: ToMap needs a struct or a non-nil pointer to struct, got %T", v)
+	}
+
+	result := make(map[string]any, value.NumField())
+	toMapFields(value, result)
+	return result, nil
+}
+
+// toMapFields adds the fields of the struct value to result
+func toMapFields(value reflect.Value, result map[string]any) {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+		key, skip := toMapKey(field)
+		if skip {
+			continue
+		}
+		fieldValue := value.Field(i)
+		if field.Anonymous && key == field.Name && !isNullable(field.Type) {
+			embedded := fieldValue
+			if embedded.Kind() == reflect.Ptr {
+				if embedded.IsNil() {
+					continue
+				}
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				toMapFields(embedded, result)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		result[key] = toMapValue(fieldValue)
+	}
+}
+
+// toMapKey returns the key of field, and true when it is to be left out
+func toMapKey(field reflect.StructField) (string, bool) {
+	for _, tag := range []string{"db", "json"} {
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+		if name == "-" {
+			return "", true
+		}
+		if name != "" {
+			return name, false
+		}
+	}
+	return field.Name, false
+}
+
+// isNullable reports whether values of t tell NULL apart, as every type of
+// this package does
+func isNullable(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Implements(nullerType) && t.Implements(valuerType)
+}
+
+// toMapValue returns nil for NULL, the underlying value of other nullables,
+// and value itself for anything else
+func toMapValue(value reflect.Value) any {
+	if value.Kind() == reflect.Ptr && isNullable(value.Type()) {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if !isNullable(value.Type()) {
+		return value.Interface()
+	}
+	if value.Interface().(interface{ IsNull() bool }).IsNull() {
+		return nil
+	}
+	// Unwrap gives the value with its own type, which a generic interface
+	// cannot name, so it is called through reflection
+	if unwrap := value.MethodByName("Unwrap"); unwrap.IsValid() && unwrap.Type().NumIn() == 0 && unwrap.Type().NumOut() == 2 {
+		return unwrap.Call(nil)[0].Interface()
+	}
+	if valued, err := value.Interface().(driver.Valuer).Value(); err == nil {
+		return valued
+	}
+	return value.Interface()
+}
@@ -0,0 +1,101 @@
+package nullable_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestToMap(t *testing.T) {
+	type audit struct {
+		UpdatedBy nullable.String `db:"updated_by"`
+	}
+	type account struct {
+		audit
+		ID       uint
+		Name     nullable.String   `json:"name"`
+		Nickname nullable.String   `json:"nickname,omitempty"`
+		Balance  nullable.Uint64   `db:"balance" json:"money"`
+		Ratio    *nullable.Float64 `json:"ratio"`
+		Limit    *nullable.Int32   `json:"limit"`
+		Tags     nullable.Slice[string]
+		Created  time.Time `json:"created"`
+		Password string    `json:"-"`
+		secret   string
+	}
+
+	ratio := nullable.Float64From(0.5)
+	created := time.Date(2021, time.March, 4, 5, 6, 7, 0, time.UTC)
+	record := account{
+		audit:    audit{UpdatedBy: nullable.StringFrom("admin")},
+		ID:       7,
+		Name:     nullable.StringFrom("cat"),
+		Nickname: nullable.NullString(),
+		Balance:  nullable.Uint64From(18446744073709551615),
+		Ratio:    &ratio,
+		Tags:     nullable.SliceFrom([]string{"a"}),
+		Created:  created,
+		Password: "hunter2",
+		secret:   "hidden",
+	}
+	expected := map[string]any{
+		"updated_by": "admin",
+		"ID":         uint(7),
+		"name":       "cat",
+		"nickname":   nil,
+		"balance":    uint64(18446744073709551615),
+		"ratio":      0.5,
+		"limit":      nil,
+		"Tags":       []string{"a"},
+		"created":    created,
+	}
+
+	for _, v := range []interface{}{record, &record} {
+		result, err := nullable.ToMap(v)
+		tests.AssertEqual(t, err, nil)
+		if !reflect.DeepEqual(result, expected) {
+			t.Errorf("expected %#v, got %#v", expected, result)
+		}
+	}
+
+	for _, v := range []interface{}{nil, 7, (*account)(nil), []account{record}} {
+		if _, err := nullable.ToMap(v); err == nil {
+			t.Errorf("ToMap(%#v) must fail", v)
+		}
+	}
+}
+
+func TestToMapUpdates(t *testing.T) {
+	type TestNullableToMap struct {
+		ID    uint
+		Name  nullable.String
+		Count nullable.Uint64
+	}
+
+	DB.Migrator().DropTable(&TestNullableToMap{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableToMap{}); err != nil {
+		t.Errorf("failed to migrate nullable to map, got error: %v", err)
+	}
+
+	record := TestNullableToMap{Name: nullable.StringFrom("cat"), Count: nullable.Uint64From(9)}
+	DB.Create(&record)
+
+	changes, err := nullable.ToMap(struct {
+		Name  nullable.String `db:"name"`
+		Count nullable.Uint64 `db:"count"`
+	}{nullable.NullString(), nullable.Uint64From(10)})
+	tests.AssertEqual(t, err, nil)
+	if err := DB.Model(&record).Updates(changes).Error; err != nil {
+		t.Fatalf("Cannot update with map: %v", err)
+	}
+
+	var result TestNullableToMap
+	if err := DB.First(&result, record.ID).Error; err != nil {
+		t.Fatalf("Cannot read to map test record")
+	}
+	tests.AssertEqual(t, result.Name, nullable.NullString())
+	tests.AssertEqual(t, result.Count, nullable.Uint64From(10))
+}
@@ -1,11 +1,22 @@
 package nullable
 
 import (
+	"cmp"
 	"context"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"math"
 	"strconv"
+	"strings"
 
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"gopkg.in/yaml.v3"
 	"gorm.io/gorm/clause"
 
 	"gorm.io/gorm"
@@ -14,40 +25,181 @@ import (
 
 // Uint SQL type that can retrieve NULL value
 type Uint struct {
-	realValue uint
-	isValid   bool
+	core[uint]
 }
 
 // NewUint creates a new nullable unsigned integer
 func NewUint(value *uint) Uint {
-	if value == nil {
-		return Uint{
-			realValue: 0,
-			isValid:   false,
+	return Uint{core: newCore(value)}
+}
+
+// UintFrom creates a new valid nullable unsigned integer from value
+func UintFrom(value uint) Uint {
+	return NewUint(&value)
+}
+
+// NullUint creates a new NULL unsigned integer
+func NullUint() Uint {
+	return NewUint(nil)
+}
+
+// UintZeroAsNull creates a new nullable unsigned integer that is NULL when value is the zero value
+func UintZeroAsNull(value uint) Uint {
+	if value == 0 {
+		return NullUint()
+	}
+	return UintFrom(value)
+}
+
+// CoalesceUint returns the first valid value, or NULL when all of them are NULL
+func CoalesceUint(values ...Uint) Uint {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return Uint{}
+}
+
+// MinUint returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinUint(values ...Uint) Uint {
+	var result Uint
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
 		}
 	}
-	return Uint{
-		realValue: *value,
-		isValid:   true,
+	return result
+}
+
+// MaxUint returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxUint(values ...Uint) Uint {
+	var result Uint
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
+		}
 	}
+	return result
 }
 
-// Get either nil or unsigned integer
-func (n Uint) Get() *uint {
+// UintPtr returns n.Get(), handy where a function value is needed
+func UintPtr(n Uint) *uint {
+	return n.Get()
+}
+
+// UintValue returns n.GetOrZero(), zero value when NULL
+func UintValue(n Uint) uint {
+	return n.GetOrZero()
+}
+
+// ParseUint parses text like UnmarshalText does, empty text is NULL
+func ParseUint(text string) (Uint, error) {
+	var n Uint
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Uint{}, err
+	}
+	return n, nil
+}
+
+// MustParseUint is ParseUint that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseUint(text string) Uint {
+	n, err := ParseUint(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseUint(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL Uint, the one a declared
+// Uint starts with, so pooled values can be reused
+func (n *Uint) Reset() {
+	*n = Uint{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Uint) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Uint) NullIfZero() {
+	if n.isValid && n.realValue == 0 {
+		n.SetNull()
+	}
+}
+
+// MustGet either unsigned integer or panic when NULL
+func (n Uint) MustGet() uint {
+	return n.mustGet("Uint")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Uint) Clone() Uint {
+	return n
+}
+
+// String returns unsigned integer in its natural text form, or "<null>" when NULL
+func (n Uint) String() string {
 	if !n.isValid {
-		return nil
+		return nullString
 	}
-	return &n.realValue
+	return strconv.FormatUint(uint64(n.realValue), 10)
 }
 
-// Set either nil or unsigned integer
-func (n *Uint) Set(value *uint) {
-	n.isValid = (value != nil)
-	if n.isValid {
-		n.realValue = *value
-	} else {
-		n.realValue = 0
+// DebugString returns Uint(value) or Uint(NULL), telling NULL apart from a
+// zero value in logs
+func (n Uint) DebugString() string {
+	return debugString("Uint", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same unsigned integer
+func (n Uint) Equal(other Uint) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Uint) Changed(old Uint) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any unsigned integer.
+func (n Uint) Compare(other Uint) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
 	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL 8 big-endian bytes, telling NULL apart from zero in cache keys
+func (n Uint) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagUint, n.isValid, hashUint64(uint64(n.realValue)))
 }
 
 // MarshalJSON converts current value to JSON
@@ -57,8 +209,11 @@ func (n Uint) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON writes JSON to this type
 func (n *Uint) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
 		n.isValid = false
 		n.realValue = 0
 		return nil
@@ -66,6 +221,78 @@ func (n *Uint) UnmarshalJSON(data []byte) error {
 
 	var parsed uint
 	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Uint) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendUint(nil, uint64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Uint) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseUint(string(text), 10, 0)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = uint(parsed)
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Uint) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Uint) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Uint) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return strconv.FormatUint(uint64(n.realValue), 10), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Uint) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Uint) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed uint
+	if err := value.Decode(&parsed); err != nil {
 		return err
 	}
 
@@ -74,16 +301,149 @@ func (n *Uint) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalXML converts current value to XML
+func (n Uint) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue, start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Uint) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseUint(strings.TrimSpace(text), 10, 0)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = uint(parsed)
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Uint) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Uint) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed uint
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Uint) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	if uint64(n.realValue) > math.MaxInt64 {
+		// BSON has no unsigned integers, keep exact digits as string
+		return bson.MarshalValue(strconv.FormatUint(uint64(n.realValue), 10))
+	}
+	return bson.MarshalValue(int64(n.realValue))
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Uint) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed uint
+	if t == bson.TypeString {
+		text, _, ok := bsoncore.ReadString(data)
+		if !ok {
+			return bsonTypeError(t, "Uint")
+		}
+		value, err := strconv.ParseUint(text, 10, strconv.IntSize)
+		if err != nil {
+			return err
+		}
+		parsed = uint(value)
+	} else if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Uint) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Uint) GobDecode(data []byte) error {
+	var parsed uint
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
 // Scan implements scanner interface
 func (n *Uint) Scan(value interface{}) error {
+	value = scanValue(value)
 	if value == nil {
 		n.realValue, n.isValid = 0, false
 		return nil
 	}
 
+	if floatValue, ok := value.(float64); ok {
+		parsed, err := scanFloatUint(floatValue, strconv.IntSize, "uint")
+		if err != nil {
+			return scanError(err)
+		}
+		n.realValue, n.isValid = uint(parsed), true
+		return nil
+	}
+
 	var scanned string
 	if err := convertAssign(&scanned, value); err != nil {
-		return err
+		return scanError(err)
 	}
 
 	radix := 10
@@ -93,7 +453,7 @@ func (n *Uint) Scan(value interface{}) error {
 
 	parsed, err := strconv.ParseUint(scanned, radix, 64)
 	if err != nil {
-		return err
+		return scanError(err)
 	}
 	n.realValue = uint(parsed)
 
@@ -102,25 +462,31 @@ func (n *Uint) Scan(value interface{}) error {
 }
 
 // Value implements the driver Valuer interface.
+// Values up to math.MaxInt64 are passed as int64, which every driver
+// understands. Larger values don't fit any driver.Value number, so they
+// are passed as decimal string for the database to convert.
 func (n Uint) Value() (driver.Value, error) {
 	if !n.isValid {
 		return nil, nil
 	}
-	return strconv.FormatUint(uint64(n.realValue), 10), nil
+	if uint64(n.realValue) > math.MaxInt64 {
+		return strconv.FormatUint(uint64(n.realValue), 10), nil
+	}
+	return int64(n.realValue), nil
 }
 
 // GormValue implements the driver Valuer interface via GORM.
 func (n Uint) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
-	switch db.Dialector.Name() {
-	case "sqlite", "mysql":
-		// MySQL and SQLite are using Value() instead of GormValue()
+	switch dialectName(db) {
+	case "sqlite", "mysql", "sqlserver":
+		// MySQL, SQLite and SQL Server are using Value() instead of GormValue()
 		value, err := n.Value()
 		if err != nil {
 			db.AddError(err)
 			return clause.Expr{}
 		}
 		return clause.Expr{SQL: "?", Vars: []interface{}{value}}
-	case "postgres":
+	case "postgres", "cockroachdb":
 		if !n.isValid {
 			return clause.Expr{SQL: "?", Vars: []interface{}{nil}}
 		}
@@ -131,8 +497,21 @@ func (n Uint) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
 			leadingZero--
 		}
 		return clause.Expr{SQL: "?", Vars: []interface{}{value}}
+	case "clickhouse":
+		if !n.isValid {
+			return clause.Expr{SQL: "?", Vars: []interface{}{nil}}
+		}
+		// ClickHouse has native unsigned integers
+		return clause.Expr{SQL: "?", Vars: []interface{}{uint64(n.realValue)}}
+	default:
+		// Unknown dialects get the same bound parameter as database/sql would
+		value, err := n.Value()
+		if err != nil {
+			db.AddError(fmt.Errorf("nullable: cannot bind Uint for %q dialect: %w", db.Dialector.Name(), err))
+			return clause.Expr{}
+		}
+		return clause.Expr{SQL: "?", Vars: []interface{}{value}}
 	}
-	return clause.Expr{}
 }
 
 // GormDataType gorm common data type
@@ -141,12 +520,28 @@ func (Uint) GormDataType() string {
 }
 
 // GormDBDataType gorm db data type
-func (Uint) GormDBDataType(db *gorm.DB, field *schema.Field) string {
-	switch db.Dialector.Name() {
+func (n Uint) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Uint) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Uint) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
 	case "sqlite", "mysql":
 		return "BIGINT UNSIGNED"
-	case "postgres":
+	case "postgres", "cockroachdb":
 		return "bit(64)"
+	case "sqlserver":
+		// SQL Server has no unsigned BIGINT, DECIMAL(20,0) holds math.MaxUint64
+		return "DECIMAL(20,0)"
+	case "clickhouse":
+		return "Nullable(UInt64)"
 	}
 	return ""
 }
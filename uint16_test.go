@@ -1,6 +1,8 @@
 package nullable_test
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/tee8z/nullable"
@@ -30,6 +32,39 @@ func TestScanUint16(t *testing.T) {
 	tests.AssertEqual(t, nullableInt.Get(), nil)
 }
 
+func TestScanUint16Overflow(t *testing.T) {
+	nullableInt := nullable.NewUint16(nil)
+
+	tests.AssertEqual(t, nullableInt.Scan("65535"), nil)
+	tests.AssertEqual(t, nullableInt.Get(), 65535)
+
+	if err := nullableInt.Scan("65536"); err == nil {
+		t.Errorf("scanning \"65536\" into Uint16 must fail, got %v", nullableInt.Get())
+	}
+	if err := nullableInt.Scan(65536); err == nil {
+		t.Errorf("scanning 65536 into Uint16 must fail, got %v", nullableInt.Get())
+	}
+	if err := nullableInt.Scan(-1); err == nil {
+		t.Errorf("scanning -1 into Uint16 must fail, got %v", nullableInt.Get())
+	}
+
+	// previous valid value must be preserved
+	tests.AssertEqual(t, nullableInt.Get(), 65535)
+}
+
+func TestScanFloatUint16(t *testing.T) {
+	var scanned nullable.Uint16
+	tests.AssertEqual(t, scanned.Scan(float64(42)), nil)
+	tests.AssertEqual(t, scanned, nullable.Uint16From(42))
+
+	if err := scanned.Scan(float64(42.5)); err == nil {
+		t.Error("scanning float64 with fractional part into Uint16 must fail")
+	}
+	if err := scanned.Scan(float64(65536)); err == nil {
+		t.Error("scanning overflowing float64 into Uint16 must fail")
+	}
+}
+
 func TestNewUint16(t *testing.T) {
 	// uint8
 	var basicUint1 uint16 = 37
@@ -62,6 +97,259 @@ func TestSetUint16(t *testing.T) {
 	tests.AssertEqual(t, nullableUint.Get(), nil)
 }
 
+func TestUint16From(t *testing.T) {
+	var basic uint16 = 60000
+	tests.AssertEqual(t, nullable.Uint16From(basic), nullable.NewUint16(&basic))
+	tests.AssertEqual(t, nullable.Uint16From(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullUint16(), nullable.NewUint16(nil))
+	tests.AssertEqual(t, nullable.NullUint16().IsNull(), true)
+}
+
+func TestCoalesceUint16(t *testing.T) {
+	var basic uint16 = 60000
+	var zero uint16 = 0
+	tests.AssertEqual(t, nullable.CoalesceUint16(), nullable.Uint16{})
+	tests.AssertEqual(t, nullable.CoalesceUint16(nullable.NullUint16(), nullable.NullUint16()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceUint16(nullable.NullUint16(), nullable.Uint16From(basic), nullable.Uint16From(zero)), nullable.Uint16From(basic))
+	tests.AssertEqual(t, nullable.CoalesceUint16(nullable.Uint16From(zero), nullable.Uint16From(basic)), nullable.Uint16From(zero))
+}
+
+func TestIsZeroUint16(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullUint16().IsZero(), true)
+	tests.AssertEqual(t, nullable.Uint16From(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.Uint16From(60000).IsZero(), false)
+}
+
+func TestCloneUint16(t *testing.T) {
+	tests.AssertEqual(t, nullable.Uint16From(60000).Clone(), nullable.Uint16From(60000))
+	tests.AssertEqual(t, nullable.NullUint16().Clone(), nullable.NullUint16())
+}
+
+func TestUnwrapUint16(t *testing.T) {
+	var basic uint16 = 60000
+	value, ok := nullable.Uint16From(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullUint16().Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Uint16
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseUint16(t *testing.T) {
+	parsed, err := nullable.ParseUint16("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullUint16())
+
+	text, err := nullable.Uint16From(60000).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseUint16(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.Uint16From(60000)), true)
+
+	for _, text := range []string{"abc", "-1", "1.5"} {
+		if _, err := nullable.ParseUint16(text); err == nil {
+			t.Errorf("parsing %q as 16-bit unsigned integer must fail", text)
+		}
+	}
+}
+
+func TestMustParseUint16(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseUint16(""), nullable.NullUint16())
+
+	text, err := nullable.Uint16From(60000).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseUint16(string(text)).Equal(nullable.Uint16From(60000)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseUint16 of malformed text must panic")
+		}
+	}()
+	nullable.MustParseUint16("abc")
+}
+
+func TestPtrUint16(t *testing.T) {
+	var basic uint16 = 60000
+	tests.AssertEqual(t, nullable.Uint16Ptr(nullable.NewUint16(&basic)), basic)
+	tests.AssertEqual(t, nullable.Uint16Ptr(nullable.NewUint16(nil)), nil)
+	tests.AssertEqual(t, nullable.Uint16Value(nullable.NewUint16(&basic)), basic)
+	tests.AssertEqual(t, nullable.Uint16Value(nullable.NewUint16(nil)), 0)
+}
+
+func TestSetValueUint16(t *testing.T) {
+	var basic uint16 = 60000
+	var nullableUint16 nullable.Uint16
+	nullableUint16.SetValue(basic)
+	tests.AssertEqual(t, nullableUint16, nullable.Uint16From(basic))
+
+	nullableUint16.SetNull()
+	tests.AssertEqual(t, nullableUint16, nullable.NullUint16())
+}
+
+func TestZeroAsNullUint16(t *testing.T) {
+	var basic uint16 = 60000
+	var zero uint16 = 0
+	tests.AssertEqual(t, nullable.Uint16ZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.Uint16ZeroAsNull(basic), nullable.Uint16From(basic))
+
+	nullableUint16 := nullable.Uint16From(zero)
+	nullableUint16.NullIfZero()
+	tests.AssertEqual(t, nullableUint16, nullable.NullUint16())
+
+	nullableUint16 = nullable.Uint16From(basic)
+	nullableUint16.NullIfZero()
+	tests.AssertEqual(t, nullableUint16, nullable.Uint16From(basic))
+}
+
+func TestIsNullUint16(t *testing.T) {
+	var zero nullable.Uint16
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic uint16 = 60000
+	nullableUint16 := nullable.NewUint16(&basic)
+	tests.AssertEqual(t, nullableUint16.IsNull(), false)
+	tests.AssertEqual(t, nullableUint16.IsValid(), true)
+
+	nullableUint16.Set(nil)
+	tests.AssertEqual(t, nullableUint16.IsNull(), true)
+	tests.AssertEqual(t, nullableUint16.IsValid(), false)
+}
+
+func TestGetOrUint16(t *testing.T) {
+	var zero nullable.Uint16
+	var fallback uint16 = 60000
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), 0)
+
+	var basic uint16 = 60000
+	nullableUint16 := nullable.NewUint16(&basic)
+	tests.AssertEqual(t, nullableUint16.GetOr(0), basic)
+	tests.AssertEqual(t, nullableUint16.GetOrZero(), basic)
+}
+
+func TestMustGetUint16(t *testing.T) {
+	var basic uint16 = 60000
+	nullableUint16 := nullable.NewUint16(&basic)
+	tests.AssertEqual(t, nullableUint16.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Uint16")
+	}()
+	nullableUint16.Set(nil)
+	nullableUint16.MustGet()
+	t.Error("MustGet on NULL Uint16 must panic")
+}
+
+func TestTextUint16(t *testing.T) {
+	var basic uint16 = 60000
+	marshalUnmarshalText(t, nullable.NewUint16(&basic))
+
+	marshalUnmarshalText(t, nullable.NewUint16(nil))
+}
+
+func TestCSVUint16(t *testing.T) {
+	var basic uint16 = 60000
+	marshalUnmarshalCSV(t, nullable.NewUint16(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewUint16(nil))
+}
+
+func TestYAMLUint16(t *testing.T) {
+	var basic uint16 = 60000
+	marshalUnmarshalYAML(t, nullable.NewUint16(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewUint16(nil))
+}
+
+func TestMsgpackUint16(t *testing.T) {
+	var basic uint16 = 60000
+	marshalUnmarshalMsgpack(t, nullable.NewUint16(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewUint16(nil))
+}
+
+func TestBSONUint16(t *testing.T) {
+	var basic uint16 = 60000
+	marshalUnmarshalBSON(t, nullable.NewUint16(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewUint16(nil))
+}
+
+func TestGobUint16(t *testing.T) {
+	var basic uint16 = 60000
+	marshalUnmarshalGob(t, nullable.NewUint16(&basic))
+
+	var zero uint16 = 0
+	marshalUnmarshalGob(t, nullable.NewUint16(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewUint16(nil))
+}
+
+func TestStringerUint16(t *testing.T) {
+	var basic uint16 = 60000
+	nullableUint16 := nullable.NewUint16(&basic)
+	tests.AssertEqual(t, nullableUint16.String(), "60000")
+	tests.AssertEqual(t, fmt.Sprint(nullableUint16), "60000")
+
+	nullableUint16.Set(nil)
+	tests.AssertEqual(t, nullableUint16.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableUint16), "<null>")
+}
+
+func TestChangedUint16(t *testing.T) {
+	var basic uint16 = 60000
+	value, null := nullable.NewUint16(&basic), nullable.NewUint16(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.Uint16From(0)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewUint16(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewUint16(nil)), false)
+}
+
+func TestEqualUint16(t *testing.T) {
+	var basic uint16 = 60000
+	var zero uint16 = 0
+	tests.AssertEqual(t, nullable.NewUint16(nil).Equal(nullable.NewUint16(nil)), true)
+	tests.AssertEqual(t, nullable.NewUint16(nil).Equal(nullable.NewUint16(&basic)), false)
+	tests.AssertEqual(t, nullable.NewUint16(&basic).Equal(nullable.NewUint16(nil)), false)
+	tests.AssertEqual(t, nullable.NewUint16(&basic).Equal(nullable.NewUint16(&basic)), true)
+	tests.AssertEqual(t, nullable.NewUint16(&basic).Equal(nullable.NewUint16(&zero)), false)
+	tests.AssertEqual(t, nullable.NewUint16(&zero).Equal(nullable.NewUint16(nil)), false)
+}
+
+func TestCompareUint16(t *testing.T) {
+	var lesser uint16 = 1
+	var greater uint16 = 60000
+	null := nullable.NewUint16(nil)
+	small := nullable.NewUint16(&lesser)
+	big := nullable.NewUint16(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewUint16(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewUint16(&greater)), 0)
+
+	values := []nullable.Uint16{big, null, small}
+	slices.SortFunc(values, nullable.Uint16.Compare)
+	tests.AssertEqual(t, values, []nullable.Uint16{null, small, big})
+}
+
+func TestXMLUint16(t *testing.T) {
+	var basic uint16 = 60000
+	marshalUnmarshalXML(t, nullable.NewUint16(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewUint16(nil))
+}
+
 func TestJSONUint16(t *testing.T) {
 	var basicInt1 uint16 = 37
 	marshalUnmarshalJSON(t, nullable.NewUint16(&basicInt1))
@@ -72,6 +360,16 @@ func TestJSONUint16(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewUint16(nil))
 }
 
+func TestValueUint16(t *testing.T) {
+	value, err := nullable.Uint16From(60000).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, int64(60000))
+
+	value, err = nullable.NullUint16().Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
 func TestUint16(t *testing.T) {
 	type TestNullableUint16 struct {
 		ID    uint16
@@ -1,11 +1,20 @@
 package nullable
 
 import (
+	"cmp"
 	"context"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
 	"strconv"
+	"strings"
 
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"gorm.io/gorm/schema"
@@ -13,40 +22,181 @@ import (
 
 // Uint32 SQL type that can retrieve NULL value
 type Uint32 struct {
-	realValue uint32
-	isValid   bool
+	core[uint32]
 }
 
 // NewUint32 creates a new nullable 32-bit unsigned integer
 func NewUint32(value *uint32) Uint32 {
-	if value == nil {
-		return Uint32{
-			realValue: 0,
-			isValid:   false,
+	return Uint32{core: newCore(value)}
+}
+
+// Uint32From creates a new valid nullable 32-bit unsigned integer from value
+func Uint32From(value uint32) Uint32 {
+	return NewUint32(&value)
+}
+
+// NullUint32 creates a new NULL 32-bit unsigned integer
+func NullUint32() Uint32 {
+	return NewUint32(nil)
+}
+
+// Uint32ZeroAsNull creates a new nullable 32-bit unsigned integer that is NULL when value is the zero value
+func Uint32ZeroAsNull(value uint32) Uint32 {
+	if value == 0 {
+		return NullUint32()
+	}
+	return Uint32From(value)
+}
+
+// CoalesceUint32 returns the first valid value, or NULL when all of them are NULL
+func CoalesceUint32(values ...Uint32) Uint32 {
+	for _, value := range values {
+		if value.isValid {
+			return value
 		}
 	}
-	return Uint32{
-		realValue: *value,
-		isValid:   true,
+	return Uint32{}
+}
+
+// MinUint32 returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinUint32(values ...Uint32) Uint32 {
+	var result Uint32
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// MaxUint32 returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxUint32(values ...Uint32) Uint32 {
+	var result Uint32
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// Uint32Ptr returns n.Get(), handy where a function value is needed
+func Uint32Ptr(n Uint32) *uint32 {
+	return n.Get()
+}
+
+// Uint32Value returns n.GetOrZero(), zero value when NULL
+func Uint32Value(n Uint32) uint32 {
+	return n.GetOrZero()
+}
+
+// ParseUint32 parses text like UnmarshalText does, empty text is NULL
+func ParseUint32(text string) (Uint32, error) {
+	var n Uint32
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Uint32{}, err
+	}
+	return n, nil
+}
+
+// MustParseUint32 is ParseUint32 that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseUint32(text string) Uint32 {
+	n, err := ParseUint32(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseUint32(%q): %v", text, err))
 	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL Uint32, the one a declared
+// Uint32 starts with, so pooled values can be reused
+func (n *Uint32) Reset() {
+	*n = Uint32{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Uint32) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
 }
 
-// Get either nil or 32-bit unsigned integer
-func (n Uint32) Get() *uint32 {
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Uint32) NullIfZero() {
+	if n.isValid && n.realValue == 0 {
+		n.SetNull()
+	}
+}
+
+// MustGet either 32-bit unsigned integer or panic when NULL
+func (n Uint32) MustGet() uint32 {
+	return n.mustGet("Uint32")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Uint32) Clone() Uint32 {
+	return n
+}
+
+// String returns 32-bit unsigned integer in its natural text form, or "<null>" when NULL
+func (n Uint32) String() string {
 	if !n.isValid {
-		return nil
+		return nullString
 	}
-	return &n.realValue
+	return strconv.FormatUint(uint64(n.realValue), 10)
 }
 
-// Set either nil or 32-bit unsigned integer
-func (n *Uint32) Set(value *uint32) {
-	n.isValid = (value != nil)
-	if n.isValid {
-		n.realValue = *value
-	} else {
-		n.realValue = 0
+// DebugString returns Uint32(value) or Uint32(NULL), telling NULL apart from a
+// zero value in logs
+func (n Uint32) DebugString() string {
+	return debugString("Uint32", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same 32-bit unsigned integer
+func (n Uint32) Equal(other Uint32) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Uint32) Changed(old Uint32) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any 32-bit unsigned integer.
+func (n Uint32) Compare(other Uint32) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
 	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL 8 big-endian bytes, telling NULL apart from zero in cache keys
+func (n Uint32) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagUint32, n.isValid, hashUint64(uint64(n.realValue)))
 }
 
 // MarshalJSON converts current value to JSON
@@ -56,8 +206,11 @@ func (n Uint32) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON writes JSON to this type
 func (n *Uint32) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
 		n.isValid = false
 		n.realValue = 0
 		return nil
@@ -65,6 +218,138 @@ func (n *Uint32) UnmarshalJSON(data []byte) error {
 
 	var parsed uint32
 	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Uint32) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendUint(nil, uint64(n.realValue), 10), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Uint32) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseUint(string(text), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = uint32(parsed)
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Uint32) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Uint32) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Uint32) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return strconv.FormatUint(uint64(n.realValue), 10), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Uint32) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Uint32) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed uint32
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n Uint32) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue, start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Uint32) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseUint(strings.TrimSpace(text), 10, 32)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = uint32(parsed)
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Uint32) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Uint32) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed uint32
+	if err := dec.Decode(&parsed); err != nil {
 		return err
 	}
 
@@ -73,16 +358,75 @@ func (n *Uint32) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Uint32) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue)
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Uint32) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed uint32
+	if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Uint32) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Uint32) GobDecode(data []byte) error {
+	var parsed uint32
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
 // Scan implements scanner interface
 func (n *Uint32) Scan(value interface{}) error {
+	value = scanValue(value)
 	if value == nil {
 		n.realValue, n.isValid = 0, false
 		return nil
 	}
 
+	if floatValue, ok := value.(float64); ok {
+		parsed, err := scanFloatUint(floatValue, 32, "uint32")
+		if err != nil {
+			return scanError(err)
+		}
+		n.realValue, n.isValid = uint32(parsed), true
+		return nil
+	}
+
 	var scanned string
 	if err := convertAssign(&scanned, value); err != nil {
-		return err
+		return scanError(err)
 	}
 
 	radix := 10
@@ -92,7 +436,7 @@ func (n *Uint32) Scan(value interface{}) error {
 
 	parsed, err := strconv.ParseUint(scanned, radix, 32)
 	if err != nil {
-		return err
+		return scanError(err)
 	}
 	n.realValue = uint32(parsed)
 
@@ -101,31 +445,46 @@ func (n *Uint32) Scan(value interface{}) error {
 }
 
 // Value implements the driver Valuer interface.
+// The value always fits int64, which every driver understands without
+// formatting a string per row.
 func (n Uint32) Value() (driver.Value, error) {
 	if !n.isValid {
 		return nil, nil
 	}
-	return strconv.FormatUint(uint64(n.realValue), 10), nil
+	return int64(n.realValue), nil
 }
 
 // GormValue implements the driver Valuer interface via GORM.
 func (n Uint32) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
-	switch db.Dialector.Name() {
-	case "sqlite", "mysql":
-		// MySQL and SQLite are using Value() instead of GormValue()
+	switch dialectName(db) {
+	case "sqlite", "mysql", "sqlserver":
+		// MySQL, SQLite and SQL Server are using Value() instead of GormValue()
 		value, err := n.Value()
 		if err != nil {
 			db.AddError(err)
 			return clause.Expr{}
 		}
 		return clause.Expr{SQL: "?", Vars: []interface{}{value}}
-	case "postgres":
+	case "postgres", "cockroachdb":
 		if !n.isValid {
 			return clause.Expr{SQL: "?", Vars: []interface{}{nil}}
 		}
+		return clause.Expr{SQL: "?", Vars: []interface{}{int64(n.realValue)}}
+	case "clickhouse":
+		if !n.isValid {
+			return clause.Expr{SQL: "?", Vars: []interface{}{nil}}
+		}
+		// ClickHouse has native unsigned integers
 		return clause.Expr{SQL: "?", Vars: []interface{}{n.realValue}}
+	default:
+		// Unknown dialects get the same bound parameter as database/sql would
+		value, err := n.Value()
+		if err != nil {
+			db.AddError(fmt.Errorf("nullable: cannot bind Uint32 for %q dialect: %w", db.Dialector.Name(), err))
+			return clause.Expr{}
+		}
+		return clause.Expr{SQL: "?", Vars: []interface{}{value}}
 	}
-	return clause.Expr{}
 }
 
 // GormDataType gorm common data type
@@ -134,12 +493,30 @@ func (Uint32) GormDataType() string {
 }
 
 // GormDBDataType gorm db data type
-func (Uint32) GormDBDataType(db *gorm.DB, field *schema.Field) string {
-	switch db.Dialector.Name() {
+func (n Uint32) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Uint32) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Uint32) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
 	case "sqlite", "mysql":
 		return "INT UNSIGNED"
 	case "postgres":
-		return "numeric"
+		// PostgreSQL has no unsigned integers, use the next wider signed one
+		return "bigint"
+	case "sqlserver":
+		return "BIGINT"
+	case "clickhouse":
+		return "Nullable(UInt32)"
+	case "cockroachdb":
+		return "INT8"
 	}
 	return ""
 }
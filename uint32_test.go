@@ -1,6 +1,8 @@
 package nullable_test
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/tee8z/nullable"
@@ -26,6 +28,39 @@ func TestScanUint32(t *testing.T) {
 	tests.AssertEqual(t, nullableInt.Get(), nil)
 }
 
+func TestScanUint32Overflow(t *testing.T) {
+	nullableInt := nullable.NewUint32(nil)
+
+	tests.AssertEqual(t, nullableInt.Scan("4294967295"), nil)
+	tests.AssertEqual(t, nullableInt.Get(), 4294967295)
+
+	if err := nullableInt.Scan("4294967296"); err == nil {
+		t.Errorf("scanning \"4294967296\" into Uint32 must fail, got %v", nullableInt.Get())
+	}
+	if err := nullableInt.Scan(4294967296); err == nil {
+		t.Errorf("scanning 4294967296 into Uint32 must fail, got %v", nullableInt.Get())
+	}
+	if err := nullableInt.Scan(-1); err == nil {
+		t.Errorf("scanning -1 into Uint32 must fail, got %v", nullableInt.Get())
+	}
+
+	// previous valid value must be preserved
+	tests.AssertEqual(t, nullableInt.Get(), 4294967295)
+}
+
+func TestScanFloatUint32(t *testing.T) {
+	var scanned nullable.Uint32
+	tests.AssertEqual(t, scanned.Scan(float64(42)), nil)
+	tests.AssertEqual(t, scanned, nullable.Uint32From(42))
+
+	if err := scanned.Scan(float64(42.5)); err == nil {
+		t.Error("scanning float64 with fractional part into Uint32 must fail")
+	}
+	if err := scanned.Scan(float64(4294967296)); err == nil {
+		t.Error("scanning overflowing float64 into Uint32 must fail")
+	}
+}
+
 func TestNewUint32(t *testing.T) {
 	// uint8
 	var basicUint1 uint32 = 37
@@ -68,6 +103,259 @@ func TestSetUint32(t *testing.T) {
 	tests.AssertEqual(t, nullableUint.Get(), nil)
 }
 
+func TestUint32From(t *testing.T) {
+	var basic uint32 = 4000000000
+	tests.AssertEqual(t, nullable.Uint32From(basic), nullable.NewUint32(&basic))
+	tests.AssertEqual(t, nullable.Uint32From(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullUint32(), nullable.NewUint32(nil))
+	tests.AssertEqual(t, nullable.NullUint32().IsNull(), true)
+}
+
+func TestCoalesceUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	var zero uint32 = 0
+	tests.AssertEqual(t, nullable.CoalesceUint32(), nullable.Uint32{})
+	tests.AssertEqual(t, nullable.CoalesceUint32(nullable.NullUint32(), nullable.NullUint32()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceUint32(nullable.NullUint32(), nullable.Uint32From(basic), nullable.Uint32From(zero)), nullable.Uint32From(basic))
+	tests.AssertEqual(t, nullable.CoalesceUint32(nullable.Uint32From(zero), nullable.Uint32From(basic)), nullable.Uint32From(zero))
+}
+
+func TestIsZeroUint32(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullUint32().IsZero(), true)
+	tests.AssertEqual(t, nullable.Uint32From(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.Uint32From(4000000000).IsZero(), false)
+}
+
+func TestCloneUint32(t *testing.T) {
+	tests.AssertEqual(t, nullable.Uint32From(4000000000).Clone(), nullable.Uint32From(4000000000))
+	tests.AssertEqual(t, nullable.NullUint32().Clone(), nullable.NullUint32())
+}
+
+func TestUnwrapUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	value, ok := nullable.Uint32From(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullUint32().Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Uint32
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseUint32(t *testing.T) {
+	parsed, err := nullable.ParseUint32("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullUint32())
+
+	text, err := nullable.Uint32From(4000000000).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseUint32(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.Uint32From(4000000000)), true)
+
+	for _, text := range []string{"abc", "-1", "1.5"} {
+		if _, err := nullable.ParseUint32(text); err == nil {
+			t.Errorf("parsing %q as 32-bit unsigned integer must fail", text)
+		}
+	}
+}
+
+func TestMustParseUint32(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseUint32(""), nullable.NullUint32())
+
+	text, err := nullable.Uint32From(4000000000).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseUint32(string(text)).Equal(nullable.Uint32From(4000000000)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseUint32 of malformed text must panic")
+		}
+	}()
+	nullable.MustParseUint32("abc")
+}
+
+func TestPtrUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	tests.AssertEqual(t, nullable.Uint32Ptr(nullable.NewUint32(&basic)), basic)
+	tests.AssertEqual(t, nullable.Uint32Ptr(nullable.NewUint32(nil)), nil)
+	tests.AssertEqual(t, nullable.Uint32Value(nullable.NewUint32(&basic)), basic)
+	tests.AssertEqual(t, nullable.Uint32Value(nullable.NewUint32(nil)), 0)
+}
+
+func TestSetValueUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	var nullableUint32 nullable.Uint32
+	nullableUint32.SetValue(basic)
+	tests.AssertEqual(t, nullableUint32, nullable.Uint32From(basic))
+
+	nullableUint32.SetNull()
+	tests.AssertEqual(t, nullableUint32, nullable.NullUint32())
+}
+
+func TestZeroAsNullUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	var zero uint32 = 0
+	tests.AssertEqual(t, nullable.Uint32ZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.Uint32ZeroAsNull(basic), nullable.Uint32From(basic))
+
+	nullableUint32 := nullable.Uint32From(zero)
+	nullableUint32.NullIfZero()
+	tests.AssertEqual(t, nullableUint32, nullable.NullUint32())
+
+	nullableUint32 = nullable.Uint32From(basic)
+	nullableUint32.NullIfZero()
+	tests.AssertEqual(t, nullableUint32, nullable.Uint32From(basic))
+}
+
+func TestIsNullUint32(t *testing.T) {
+	var zero nullable.Uint32
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic uint32 = 4000000000
+	nullableUint32 := nullable.NewUint32(&basic)
+	tests.AssertEqual(t, nullableUint32.IsNull(), false)
+	tests.AssertEqual(t, nullableUint32.IsValid(), true)
+
+	nullableUint32.Set(nil)
+	tests.AssertEqual(t, nullableUint32.IsNull(), true)
+	tests.AssertEqual(t, nullableUint32.IsValid(), false)
+}
+
+func TestGetOrUint32(t *testing.T) {
+	var zero nullable.Uint32
+	var fallback uint32 = 4000000000
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), 0)
+
+	var basic uint32 = 4000000000
+	nullableUint32 := nullable.NewUint32(&basic)
+	tests.AssertEqual(t, nullableUint32.GetOr(0), basic)
+	tests.AssertEqual(t, nullableUint32.GetOrZero(), basic)
+}
+
+func TestMustGetUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	nullableUint32 := nullable.NewUint32(&basic)
+	tests.AssertEqual(t, nullableUint32.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Uint32")
+	}()
+	nullableUint32.Set(nil)
+	nullableUint32.MustGet()
+	t.Error("MustGet on NULL Uint32 must panic")
+}
+
+func TestTextUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	marshalUnmarshalText(t, nullable.NewUint32(&basic))
+
+	marshalUnmarshalText(t, nullable.NewUint32(nil))
+}
+
+func TestCSVUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	marshalUnmarshalCSV(t, nullable.NewUint32(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewUint32(nil))
+}
+
+func TestYAMLUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	marshalUnmarshalYAML(t, nullable.NewUint32(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewUint32(nil))
+}
+
+func TestMsgpackUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	marshalUnmarshalMsgpack(t, nullable.NewUint32(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewUint32(nil))
+}
+
+func TestBSONUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	marshalUnmarshalBSON(t, nullable.NewUint32(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewUint32(nil))
+}
+
+func TestGobUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	marshalUnmarshalGob(t, nullable.NewUint32(&basic))
+
+	var zero uint32 = 0
+	marshalUnmarshalGob(t, nullable.NewUint32(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewUint32(nil))
+}
+
+func TestStringerUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	nullableUint32 := nullable.NewUint32(&basic)
+	tests.AssertEqual(t, nullableUint32.String(), "4000000000")
+	tests.AssertEqual(t, fmt.Sprint(nullableUint32), "4000000000")
+
+	nullableUint32.Set(nil)
+	tests.AssertEqual(t, nullableUint32.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableUint32), "<null>")
+}
+
+func TestChangedUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	value, null := nullable.NewUint32(&basic), nullable.NewUint32(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.Uint32From(0)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewUint32(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewUint32(nil)), false)
+}
+
+func TestEqualUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	var zero uint32 = 0
+	tests.AssertEqual(t, nullable.NewUint32(nil).Equal(nullable.NewUint32(nil)), true)
+	tests.AssertEqual(t, nullable.NewUint32(nil).Equal(nullable.NewUint32(&basic)), false)
+	tests.AssertEqual(t, nullable.NewUint32(&basic).Equal(nullable.NewUint32(nil)), false)
+	tests.AssertEqual(t, nullable.NewUint32(&basic).Equal(nullable.NewUint32(&basic)), true)
+	tests.AssertEqual(t, nullable.NewUint32(&basic).Equal(nullable.NewUint32(&zero)), false)
+	tests.AssertEqual(t, nullable.NewUint32(&zero).Equal(nullable.NewUint32(nil)), false)
+}
+
+func TestCompareUint32(t *testing.T) {
+	var lesser uint32 = 1
+	var greater uint32 = 4000000000
+	null := nullable.NewUint32(nil)
+	small := nullable.NewUint32(&lesser)
+	big := nullable.NewUint32(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewUint32(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewUint32(&greater)), 0)
+
+	values := []nullable.Uint32{big, null, small}
+	slices.SortFunc(values, nullable.Uint32.Compare)
+	tests.AssertEqual(t, values, []nullable.Uint32{null, small, big})
+}
+
+func TestXMLUint32(t *testing.T) {
+	var basic uint32 = 4000000000
+	marshalUnmarshalXML(t, nullable.NewUint32(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewUint32(nil))
+}
+
 func TestJSONUint32(t *testing.T) {
 	var basicInt1 uint32 = 37
 	marshalUnmarshalJSON(t, nullable.NewUint32(&basicInt1))
@@ -81,6 +369,16 @@ func TestJSONUint32(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewUint32(nil))
 }
 
+func TestValueUint32(t *testing.T) {
+	value, err := nullable.Uint32From(4000000000).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, int64(4000000000))
+
+	value, err = nullable.NullUint32().Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
 func TestUint32(t *testing.T) {
 	type TestNullableUint32 struct {
 		ID    uint32
@@ -1,11 +1,23 @@
 package nullable
 
 import (
+	"bytes"
+	"cmp"
 	"context"
 	"database/sql/driver"
 	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"math"
 	"strconv"
+	"strings"
 
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"gopkg.in/yaml.v3"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"gorm.io/gorm/schema"
@@ -13,34 +25,110 @@ import (
 
 // Uint64 SQL type that can retrieve NULL value
 type Uint64 struct {
-	realValue uint64
-	isValid   bool
+	core[uint64]
+	// expr is the SQL expression GormValue writes instead of a bound
+	// parameter, set only by Uint64Expr
+	expr *clause.Expr
 }
 
 // NewUint64 creates a new nullable 64-bit integer
 func NewUint64(value *uint64) Uint64 {
-	if value == nil {
-		return Uint64{
-			realValue: 0,
-			isValid:   false,
+	return Uint64{core: newCore(value)}
+}
+
+// Uint64From creates a new valid nullable 64-bit unsigned integer from value
+func Uint64From(value uint64) Uint64 {
+	return NewUint64(&value)
+}
+
+// NullUint64 creates a new NULL 64-bit unsigned integer
+func NullUint64() Uint64 {
+	return NewUint64(nil)
+}
+
+// Uint64Expr creates a NULL 64-bit unsigned integer whose GormValue writes
+// the SQL expression sql verbatim, with vars bound to its ? placeholders,
+// instead of a parameter, such as Uint64Expr("nextval(?)", "orders_seq").
+// Only GORM can write it, Value fails. Set, SetValue, SetNull, and Scan drop
+// the expression, so the value read back after an insert is bound as usual.
+func Uint64Expr(sql string, vars ...interface{}) Uint64 {
+	return Uint64{expr: &clause.Expr{SQL: sql, Vars: vars}}
+}
+
+// Uint64ZeroAsNull creates a new nullable 64-bit unsigned integer that is NULL when value is the zero value
+func Uint64ZeroAsNull(value uint64) Uint64 {
+	if value == 0 {
+		return NullUint64()
+	}
+	return Uint64From(value)
+}
+
+// CoalesceUint64 returns the first valid value, or NULL when all of them are NULL
+func CoalesceUint64(values ...Uint64) Uint64 {
+	for _, value := range values {
+		if value.isValid {
+			return value
 		}
 	}
-	return Uint64{
-		realValue: *value,
-		isValid:   true,
+	return Uint64{}
+}
+
+// MinUint64 returns the smallest valid value, skipping NULL the way SQL MIN
+// does, or NULL when all of them are NULL
+func MinUint64(values ...Uint64) Uint64 {
+	var result Uint64
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) < 0) {
+			result = value
+		}
 	}
+	return result
 }
 
-// Get either nil or 64-bit integer
-func (n Uint64) Get() *uint64 {
-	if !n.isValid {
-		return nil
+// MaxUint64 returns the largest valid value, skipping NULL the way SQL MAX
+// does, or NULL when all of them are NULL
+func MaxUint64(values ...Uint64) Uint64 {
+	var result Uint64
+	for _, value := range values {
+		if value.isValid && (!result.isValid || value.Compare(result) > 0) {
+			result = value
+		}
+	}
+	return result
+}
+
+// Uint64Ptr returns n.Get(), handy where a function value is needed
+func Uint64Ptr(n Uint64) *uint64 {
+	return n.Get()
+}
+
+// Uint64Value returns n.GetOrZero(), zero value when NULL
+func Uint64Value(n Uint64) uint64 {
+	return n.GetOrZero()
+}
+
+// ParseUint64 parses text like UnmarshalText does, empty text is NULL
+func ParseUint64(text string) (Uint64, error) {
+	var n Uint64
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return Uint64{}, err
+	}
+	return n, nil
+}
+
+// MustParseUint64 is ParseUint64 that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseUint64(text string) Uint64 {
+	n, err := ParseUint64(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseUint64(%q): %v", text, err))
 	}
-	return &n.realValue
+	return n
 }
 
 // Set either nil or 64-bit integer
 func (n *Uint64) Set(value *uint64) {
+	n.expr = nil
 	n.isValid = (value != nil)
 	if n.isValid {
 		n.realValue = *value
@@ -49,22 +137,290 @@ func (n *Uint64) Set(value *uint64) {
 	}
 }
 
+// Swap sets either nil or 64-bit unsigned integer like Set does and returns
+// the previous value, nil when it was NULL
+func (n *Uint64) Swap(value *uint64) *uint64 {
+	previous := n.Get()
+	n.Set(value)
+	return previous
+}
+
+// SetValue sets 64-bit unsigned integer and marks it as not NULL
+func (n *Uint64) SetValue(value uint64) {
+	n.realValue = value
+	n.isValid = true
+	n.expr = nil
+}
+
+// SetNull marks the value as NULL
+func (n *Uint64) SetNull() {
+	n.realValue = 0
+	n.isValid = false
+	n.expr = nil
+}
+
+// Reset sets the value back to the zero NULL Uint64, the one a declared
+// Uint64 starts with, so pooled values can be reused
+func (n *Uint64) Reset() {
+	*n = Uint64{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Uint64) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *Uint64) NullIfZero() {
+	if n.isValid && n.realValue == 0 {
+		n.SetNull()
+	}
+}
+
+// IsExpr reports whether the value was made by Uint64Expr, so GormValue
+// writes an SQL expression for it
+func (n Uint64) IsExpr() bool {
+	return n.expr != nil
+}
+
+// MustGet either 64-bit unsigned integer or panic when NULL
+func (n Uint64) MustGet() uint64 {
+	return n.mustGet("Uint64")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n Uint64) Clone() Uint64 {
+	return n
+}
+
+// String returns 64-bit unsigned integer in its natural text form, or "<null>" when NULL
+func (n Uint64) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return strconv.FormatUint(n.realValue, 10)
+}
+
+// DebugString returns Uint64(value) or Uint64(NULL), telling NULL apart from a
+// zero value in logs
+func (n Uint64) DebugString() string {
+	if n.expr != nil {
+		return debugString("Uint64", true, "SQL "+n.expr.SQL)
+	}
+	return debugString("Uint64", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same 64-bit unsigned integer
+func (n Uint64) Equal(other Uint64) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Uint64) Changed(old Uint64) bool {
+	return !n.Equal(old)
+}
+
+// Compare returns -1, 0, or +1 depending on whether n is less than,
+// equal to, or greater than other. NULL sorts before any 64-bit unsigned integer.
+func (n Uint64) Compare(other Uint64) int {
+	switch {
+	case !n.isValid && !other.isValid:
+		return 0
+	case !n.isValid:
+		return -1
+	case !other.isValid:
+		return 1
+	}
+	return cmp.Compare(n.realValue, other.realValue)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL 8 big-endian bytes, telling NULL apart from zero in cache keys
+func (n Uint64) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagUint64, n.isValid, hashUint64(n.realValue))
+}
+
 // MarshalJSON converts current value to JSON
 func (n Uint64) MarshalJSON() ([]byte, error) {
-	return json.Marshal(n.Get())
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return strconv.AppendUint(make([]byte, 0, 20), n.realValue, 10), nil
 }
 
 // UnmarshalJSON writes JSON to this type
 func (n *Uint64) UnmarshalJSON(data []byte) error {
-	dataString := string(data)
-	if len(dataString) == 0 || dataString == "null" {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed uint64
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte(`"`)) {
+		// Large integers are often quoted to survive JavaScript numbers
+		var quoted string
+		if err := json.Unmarshal(data, &quoted); err != nil {
+			return jsonError(err)
+		}
+		value, err := strconv.ParseUint(quoted, 10, 64)
+		if err != nil {
+			return jsonError(err)
+		}
+		parsed = value
+	} else if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n Uint64) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return strconv.AppendUint(nil, n.realValue, 10), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *Uint64) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseUint(string(text), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Uint64) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Uint64) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Uint64) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return strconv.FormatUint(n.realValue, 10), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n Uint64) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Uint64) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed uint64
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n Uint64) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue, start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Uint64) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	parsed, err := strconv.ParseUint(strings.TrimSpace(text), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Uint64) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Uint64) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
 		n.isValid = false
 		n.realValue = 0
 		return nil
 	}
 
 	var parsed uint64
-	if err := json.Unmarshal(data, &parsed); err != nil {
+	if err := dec.Decode(&parsed); err != nil {
 		return err
 	}
 
@@ -73,26 +429,95 @@ func (n *Uint64) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n Uint64) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	if n.realValue > math.MaxInt64 {
+		// BSON has no unsigned integers, keep exact digits as string
+		return bson.MarshalValue(strconv.FormatUint(n.realValue, 10))
+	}
+	return bson.MarshalValue(int64(n.realValue))
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *Uint64) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	var parsed uint64
+	if t == bson.TypeString {
+		text, _, ok := bsoncore.ReadString(data)
+		if !ok {
+			return bsonTypeError(t, "Uint64")
+		}
+		value, err := strconv.ParseUint(text, 10, 64)
+		if err != nil {
+			return err
+		}
+		parsed = value
+	} else if err := unmarshalBSONValue(t, data, &parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n Uint64) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Uint64) GobDecode(data []byte) error {
+	var parsed uint64
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = 0
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
 // Scan implements scanner interface
 func (n *Uint64) Scan(value interface{}) error {
+	n.expr = nil
+	value = scanValue(value)
 	if value == nil {
 		n.realValue, n.isValid = 0, false
 		return nil
 	}
 
-	var scanned string
-	if err := convertAssign(&scanned, value); err != nil {
-		return err
+	if floatValue, ok := value.(float64); ok {
+		parsed, err := scanFloatUint(floatValue, 64, "uint64")
+		if err != nil {
+			return scanError(err)
+		}
+		n.realValue, n.isValid = parsed, true
+		return nil
 	}
 
-	radix := 10
-	if len(scanned) == 64 {
-		radix = 2
+	var scanned string
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
 	}
 
-	parsed, err := strconv.ParseUint(scanned, radix, 64)
+	parsed, err := strconv.ParseUint(scanned, 10, 64)
 	if err != nil {
-		return err
+		return scanError(err)
 	}
 	n.realValue = parsed
 
@@ -101,32 +526,60 @@ func (n *Uint64) Scan(value interface{}) error {
 }
 
 // Value implements the driver Valuer interface.
+// Values up to math.MaxInt64 are passed as int64, which every driver
+// understands. Larger values don't fit any driver.Value number, so they
+// are passed as decimal string for the database to convert. A value made by
+// Uint64Expr fails, since database/sql has no way to write an expression.
 func (n Uint64) Value() (driver.Value, error) {
+	if n.expr != nil {
+		return nil, fmt.Errorf("nullable: Uint64 holding SQL expression %q can only be written through GORM", n.expr.SQL)
+	}
 	if !n.isValid {
 		return nil, nil
 	}
-	return strconv.FormatUint(n.realValue, 10), nil
+	if n.realValue > math.MaxInt64 {
+		return strconv.FormatUint(n.realValue, 10), nil
+	}
+	return int64(n.realValue), nil
 }
 
-// GormValue implements the driver Valuer interface via GORM.
-func (n Uint64) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
-	switch db.Dialector.Name() {
-	case "sqlite", "mysql":
-		// MySQL and SQLite are using Value() instead of GormValue()
-		value, err := n.Value()
-		if err != nil {
-			db.AddError(err)
-			return clause.Expr{}
-		}
-		return clause.Expr{SQL: "?", Vars: []interface{}{value}}
-	case "postgres":
+// ValueFor returns the value GormValue binds for dialect, such as "mysql"
+// or "postgres", without a connection, for tools that move rows between
+// databases. PostgreSQL, CockroachDB, and ClickHouse get the uint64 itself,
+// which their drivers bind as is, the other dialects get what Value returns.
+// A value made by Uint64Expr fails like Value does.
+func (n Uint64) ValueFor(dialect string) (driver.Value, error) {
+	if n.expr != nil {
+		return n.Value()
+	}
+	switch dialect {
+	case "postgres", "clickhouse", "cockroachdb":
 		if !n.isValid {
-			return clause.Expr{SQL: "?", Vars: []interface{}{nil}}
+			return nil, nil
 		}
+		return n.realValue, nil
+	default:
+		// MySQL, SQLite and SQL Server get the same value as database/sql
+		return n.Value()
+	}
+}
 
-		return clause.Expr{SQL: "?", Vars: []interface{}{n.realValue}}
+// GormValue implements the driver Valuer interface via GORM. A value made by
+// Uint64Expr is written as its SQL expression on every dialect.
+func (n Uint64) GormValue(ctx context.Context, db *gorm.DB) clause.Expr {
+	if n.expr != nil {
+		return *n.expr
 	}
-	return clause.Expr{}
+	dialect := dialectName(db)
+	value, err := n.ValueFor(dialect)
+	if err != nil {
+		if n.ColumnType(dialect) == "" {
+			err = fmt.Errorf("nullable: cannot bind Uint64 for %q dialect: %w", db.Dialector.Name(), err)
+		}
+		db.AddError(err)
+		return clause.Expr{}
+	}
+	return clause.Expr{SQL: "?", Vars: []interface{}{value}}
 }
 
 // GormDataType gorm common data type
@@ -135,12 +588,31 @@ func (Uint64) GormDataType() string {
 }
 
 // GormDBDataType gorm db data type
-func (Uint64) GormDBDataType(db *gorm.DB, field *schema.Field) string {
-	switch db.Dialector.Name() {
+func (n Uint64) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Uint64) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Uint64) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
 	case "sqlite", "mysql":
 		return "BIGINT UNSIGNED"
 	case "postgres":
 		return "numeric"
+	case "sqlserver":
+		// SQL Server has no unsigned BIGINT, DECIMAL(20,0) holds math.MaxUint64
+		return "DECIMAL(20,0)"
+	case "clickhouse":
+		return "Nullable(UInt64)"
+	case "cockroachdb":
+		// CockroachDB INT8 is signed, DECIMAL(20,0) covers 0 up to math.MaxUint64
+		return "DECIMAL(20,0)"
 	}
 	return ""
 }
@@ -1,9 +1,20 @@
 package nullable_test
 
 import (
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/tee8z/nullable"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 	"gorm.io/gorm/utils/tests"
 )
 
@@ -30,6 +41,51 @@ func TestScanUint64(t *testing.T) {
 	tests.AssertEqual(t, nullableInt.Get(), nil)
 }
 
+func TestScanUint64Decimal64Chars(t *testing.T) {
+	nullableUint := nullable.NewUint64(nil)
+
+	// 64 characters long but still decimal
+	padded := strings.Repeat("0", 44) + "18446744073709551615"
+	tests.AssertEqual(t, len(padded), 64)
+	tests.AssertEqual(t, nullableUint.Scan(padded), nil)
+	tests.AssertEqual(t, nullableUint.Get(), uint64(18446744073709551615))
+
+	// looks like binary, but must be parsed as decimal
+	binaryLooking := strings.Repeat("0", 61) + "101"
+	tests.AssertEqual(t, len(binaryLooking), 64)
+	tests.AssertEqual(t, nullableUint.Scan(binaryLooking), nil)
+	tests.AssertEqual(t, nullableUint.Get(), uint64(101))
+}
+
+func TestScanFloatUint64(t *testing.T) {
+	var scanned nullable.Uint64
+	tests.AssertEqual(t, scanned.Scan(float64(42)), nil)
+	tests.AssertEqual(t, scanned, nullable.Uint64From(42))
+
+	if err := scanned.Scan(float64(42.5)); err == nil {
+		t.Error("scanning float64 with fractional part into Uint64 must fail")
+	}
+	if err := scanned.Scan(float64(1 << 64)); err == nil {
+		t.Error("scanning overflowing float64 into Uint64 must fail")
+	}
+}
+
+func TestScanJSONNumberUint64(t *testing.T) {
+	nullableUint64 := nullable.NewUint64(nil)
+
+	tests.AssertEqual(t, nullableUint64.Scan(json.Number("18446744073709551615")), nil)
+	tests.AssertEqual(t, nullableUint64.Get(), uint64(18446744073709551615))
+
+	tests.AssertEqual(t, nullableUint64.Scan(json.Number("0")), nil)
+	tests.AssertEqual(t, nullableUint64.Get(), uint64(0))
+
+	for _, number := range []json.Number{"18446744073709551616", "-1", "1.5", "1e3"} {
+		if err := nullableUint64.Scan(number); err == nil {
+			t.Errorf("scanning json.Number %q into Uint64 must fail", number)
+		}
+	}
+}
+
 func TestNewUint64(t *testing.T) {
 	// uint8
 	var basicUint1 uint64 = 37
@@ -82,6 +138,449 @@ func TestSetUint64(t *testing.T) {
 	tests.AssertEqual(t, nullableUint.Get(), nil)
 }
 
+func TestUint64From(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	tests.AssertEqual(t, nullable.Uint64From(basic), nullable.NewUint64(&basic))
+	tests.AssertEqual(t, nullable.Uint64From(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullUint64(), nullable.NewUint64(nil))
+	tests.AssertEqual(t, nullable.NullUint64().IsNull(), true)
+}
+
+func TestCoalesceUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	var zero uint64 = 0
+	tests.AssertEqual(t, nullable.CoalesceUint64(), nullable.Uint64{})
+	tests.AssertEqual(t, nullable.CoalesceUint64(nullable.NullUint64(), nullable.NullUint64()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceUint64(nullable.NullUint64(), nullable.Uint64From(basic), nullable.Uint64From(zero)), nullable.Uint64From(basic))
+	tests.AssertEqual(t, nullable.CoalesceUint64(nullable.Uint64From(zero), nullable.Uint64From(basic)), nullable.Uint64From(zero))
+}
+
+func TestIsZeroUint64(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullUint64().IsZero(), true)
+	tests.AssertEqual(t, nullable.Uint64From(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.Uint64From(18446744073709551615).IsZero(), false)
+}
+
+func TestCloneUint64(t *testing.T) {
+	tests.AssertEqual(t, nullable.Uint64From(18446744073709551615).Clone(), nullable.Uint64From(18446744073709551615))
+	tests.AssertEqual(t, nullable.NullUint64().Clone(), nullable.NullUint64())
+}
+
+func TestUnwrapUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	value, ok := nullable.Uint64From(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullUint64().Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Uint64
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestUnwrapUint64Allocs(t *testing.T) {
+	nullableUint64 := nullable.Uint64From(18446744073709551615)
+	allocs := testing.AllocsPerRun(100, func() {
+		value, ok := nullableUint64.Unwrap()
+		if !ok || value != 18446744073709551615 {
+			t.Fatal("unexpected unwrapped value")
+		}
+	})
+	tests.AssertEqual(t, allocs, float64(0))
+}
+
+func TestParseUint64(t *testing.T) {
+	parsed, err := nullable.ParseUint64("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullUint64())
+
+	text, err := nullable.Uint64From(18446744073709551615).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseUint64(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.Uint64From(18446744073709551615)), true)
+
+	for _, text := range []string{"abc", "-1", "1.5"} {
+		if _, err := nullable.ParseUint64(text); err == nil {
+			t.Errorf("parsing %q as 64-bit unsigned integer must fail", text)
+		}
+	}
+}
+
+func TestMustParseUint64(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseUint64(""), nullable.NullUint64())
+
+	text, err := nullable.Uint64From(18446744073709551615).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseUint64(string(text)).Equal(nullable.Uint64From(18446744073709551615)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseUint64 of malformed text must panic")
+		}
+	}()
+	nullable.MustParseUint64("abc")
+}
+
+func TestPtrUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	tests.AssertEqual(t, nullable.Uint64Ptr(nullable.NewUint64(&basic)), basic)
+	tests.AssertEqual(t, nullable.Uint64Ptr(nullable.NewUint64(nil)), nil)
+	tests.AssertEqual(t, nullable.Uint64Value(nullable.NewUint64(&basic)), basic)
+	tests.AssertEqual(t, nullable.Uint64Value(nullable.NewUint64(nil)), 0)
+}
+
+func TestValueUint64Allocs(t *testing.T) {
+	present, missing := nullable.Uint64From(42), nullable.NullUint64()
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = nullable.Uint64Value(present)
+		_ = nullable.Uint64Value(missing)
+		_ = nullable.Uint64Ptr(missing)
+	})
+	tests.AssertEqual(t, allocs, float64(0))
+}
+
+func TestUnmarshalJSONNullUint64(t *testing.T) {
+	cases := []struct {
+		data    string
+		isNull  bool
+		isError bool
+	}{
+		{"null", true, false},
+		{" null ", true, false},
+		{"\tnull\n", true, false},
+		{"", false, true},
+		{"   ", false, true},
+		{"nul", false, true},
+		{"nulls", false, true},
+		{"Null", false, true},
+	}
+	for _, c := range cases {
+		nullableUint64 := nullable.Uint64From(42)
+		err := nullableUint64.UnmarshalJSON([]byte(c.data))
+		if c.isError {
+			var syntaxError *json.SyntaxError
+			if !errors.As(err, &syntaxError) {
+				t.Errorf("unmarshalling %q into Uint64 must fail with JSON syntax error, got %v", c.data, err)
+			}
+			continue
+		}
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, nullableUint64.IsNull(), c.isNull)
+	}
+}
+
+func TestScanWrappedUint64(t *testing.T) {
+	var wrapped interface{} = uint64(math.MaxUint64)
+	nullableUint64 := nullable.NullUint64()
+	tests.AssertEqual(t, nullableUint64.Scan(&wrapped), nil)
+	tests.AssertEqual(t, nullableUint64, nullable.Uint64From(math.MaxUint64))
+
+	// Wrapping nests, up to eight levels
+	value := interface{}(int64(42))
+	for depth := 0; depth < 8; depth++ {
+		outer := value
+		value = &outer
+	}
+	tests.AssertEqual(t, nullableUint64.Scan(value), nil)
+	tests.AssertEqual(t, nullableUint64, nullable.Uint64From(42))
+
+	tooDeep := value
+	if err := nullableUint64.Scan(&tooDeep); err == nil {
+		t.Error("scanning nine levels of *interface{} must fail")
+	}
+	tests.AssertEqual(t, nullableUint64, nullable.Uint64From(42))
+
+	var null interface{}
+	tests.AssertEqual(t, nullableUint64.Scan(&null), nil)
+	tests.AssertEqual(t, nullableUint64.IsNull(), true)
+
+	nullableUint64.SetValue(1)
+	tests.AssertEqual(t, nullableUint64.Scan((*interface{})(nil)), nil)
+	tests.AssertEqual(t, nullableUint64.IsNull(), true)
+
+	var text interface{} = []byte("7")
+	nullableString := nullable.NullString()
+	tests.AssertEqual(t, nullableString.Scan(&text), nil)
+	tests.AssertEqual(t, nullableString, nullable.StringFrom("7"))
+}
+
+func TestValidateUint64(t *testing.T) {
+	errZero := errors.New("must not be zero")
+	errOdd := errors.New("must be even")
+	nonZero := func(value uint64) error {
+		if value == 0 {
+			return errZero
+		}
+		return nil
+	}
+	even := func(value uint64) error {
+		if value%2 != 0 {
+			return errOdd
+		}
+		return nil
+	}
+
+	tests.AssertEqual(t, nullable.Uint64From(42).Validate(nonZero, even), nil)
+	tests.AssertEqual(t, nullable.Uint64From(42).Validate(), nil)
+
+	err := nullable.Uint64From(0).Validate(nonZero, even)
+	tests.AssertEqual(t, errors.Is(err, errZero), true)
+	tests.AssertEqual(t, errors.Is(err, errOdd), false)
+
+	err = nullable.Uint64From(math.MaxUint64).Validate(nonZero, even)
+	tests.AssertEqual(t, errors.Is(err, errOdd), true)
+
+	// Every failing predicate is reported, not only the first one
+	err = nullable.Uint64From(0).Validate(nonZero, func(uint64) error { return errOdd })
+	tests.AssertEqual(t, errors.Is(err, errZero), true)
+	tests.AssertEqual(t, errors.Is(err, errOdd), true)
+
+	called := false
+	err = nullable.NullUint64().Validate(func(uint64) error {
+		called = true
+		return errZero
+	})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, called, false)
+}
+
+func TestSetFromInterfaceUint64(t *testing.T) {
+	var large uint64 = math.MaxUint64
+	var nilPointer *uint64
+	accepted := []struct {
+		value    interface{}
+		expected nullable.Uint64
+	}{
+		{uint64(math.MaxUint64), nullable.Uint64From(math.MaxUint64)},
+		{int(42), nullable.Uint64From(42)},
+		{int64(7), nullable.Uint64From(7)},
+		{uint8(200), nullable.Uint64From(200)},
+		{float64(3), nullable.Uint64From(3)},
+		{"18446744073709551615", nullable.Uint64From(math.MaxUint64)},
+		{[]byte("12"), nullable.Uint64From(12)},
+		{json.Number("9007199254740993"), nullable.Uint64From(9007199254740993)},
+		{&large, nullable.Uint64From(math.MaxUint64)},
+		{nullable.Uint64From(math.MaxUint64), nullable.Uint64From(math.MaxUint64)},
+		{nullable.NullUint64(), nullable.NullUint64()},
+		{nilPointer, nullable.NullUint64()},
+		{nil, nullable.NullUint64()},
+	}
+	for _, c := range accepted {
+		nullableUint64 := nullable.Uint64From(1)
+		tests.AssertEqual(t, nullableUint64.SetFromInterface(c.value), nil)
+		tests.AssertEqual(t, nullableUint64, c.expected)
+	}
+
+	for _, value := range []interface{}{-1, float64(1.5), float64(-3), "abc", "1e3", true, []int{1}, struct{}{}} {
+		nullableUint64 := nullable.Uint64From(1)
+		if err := nullableUint64.SetFromInterface(value); err == nil {
+			t.Errorf("setting %#v must fail", value)
+		}
+		tests.AssertEqual(t, nullableUint64, nullable.Uint64From(1))
+	}
+}
+
+func TestSetValueUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	var nullableUint64 nullable.Uint64
+	nullableUint64.SetValue(basic)
+	tests.AssertEqual(t, nullableUint64, nullable.Uint64From(basic))
+
+	nullableUint64.SetNull()
+	tests.AssertEqual(t, nullableUint64, nullable.NullUint64())
+}
+
+func TestZeroAsNullUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	var zero uint64 = 0
+	tests.AssertEqual(t, nullable.Uint64ZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.Uint64ZeroAsNull(basic), nullable.Uint64From(basic))
+
+	nullableUint64 := nullable.Uint64From(zero)
+	nullableUint64.NullIfZero()
+	tests.AssertEqual(t, nullableUint64, nullable.NullUint64())
+
+	nullableUint64 = nullable.Uint64From(basic)
+	nullableUint64.NullIfZero()
+	tests.AssertEqual(t, nullableUint64, nullable.Uint64From(basic))
+}
+
+func TestIsNullUint64(t *testing.T) {
+	var zero nullable.Uint64
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic uint64 = 18446744073709551615
+	nullableUint64 := nullable.NewUint64(&basic)
+	tests.AssertEqual(t, nullableUint64.IsNull(), false)
+	tests.AssertEqual(t, nullableUint64.IsValid(), true)
+
+	nullableUint64.Set(nil)
+	tests.AssertEqual(t, nullableUint64.IsNull(), true)
+	tests.AssertEqual(t, nullableUint64.IsValid(), false)
+}
+
+func TestGetOrUint64(t *testing.T) {
+	var zero nullable.Uint64
+	var fallback uint64 = 18446744073709551615
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), 0)
+
+	var basic uint64 = 18446744073709551615
+	nullableUint64 := nullable.NewUint64(&basic)
+	tests.AssertEqual(t, nullableUint64.GetOr(0), basic)
+	tests.AssertEqual(t, nullableUint64.GetOrZero(), basic)
+}
+
+func TestMustGetUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	nullableUint64 := nullable.NewUint64(&basic)
+	tests.AssertEqual(t, nullableUint64.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Uint64")
+	}()
+	nullableUint64.Set(nil)
+	nullableUint64.MustGet()
+	t.Error("MustGet on NULL Uint64 must panic")
+}
+
+func TestTextUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	marshalUnmarshalText(t, nullable.NewUint64(&basic))
+
+	marshalUnmarshalText(t, nullable.NewUint64(nil))
+}
+
+func TestCSVUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	marshalUnmarshalCSV(t, nullable.NewUint64(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewUint64(nil))
+}
+
+func TestYAMLUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	marshalUnmarshalYAML(t, nullable.NewUint64(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewUint64(nil))
+}
+
+func TestMsgpackUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	marshalUnmarshalMsgpack(t, nullable.NewUint64(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewUint64(nil))
+}
+
+func TestBSONUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	marshalUnmarshalBSON(t, nullable.NewUint64(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewUint64(nil))
+}
+
+func TestGobUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	marshalUnmarshalGob(t, nullable.NewUint64(&basic))
+
+	var zero uint64 = 0
+	marshalUnmarshalGob(t, nullable.NewUint64(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewUint64(nil))
+}
+
+func TestStringerUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	nullableUint64 := nullable.NewUint64(&basic)
+	tests.AssertEqual(t, nullableUint64.String(), "18446744073709551615")
+	tests.AssertEqual(t, fmt.Sprint(nullableUint64), "18446744073709551615")
+
+	nullableUint64.Set(nil)
+	tests.AssertEqual(t, nullableUint64.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableUint64), "<null>")
+}
+
+func TestChangedUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	value, null := nullable.NewUint64(&basic), nullable.NewUint64(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.Uint64From(0)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewUint64(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewUint64(nil)), false)
+}
+
+func TestEqualUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	var zero uint64 = 0
+	tests.AssertEqual(t, nullable.NewUint64(nil).Equal(nullable.NewUint64(nil)), true)
+	tests.AssertEqual(t, nullable.NewUint64(nil).Equal(nullable.NewUint64(&basic)), false)
+	tests.AssertEqual(t, nullable.NewUint64(&basic).Equal(nullable.NewUint64(nil)), false)
+	tests.AssertEqual(t, nullable.NewUint64(&basic).Equal(nullable.NewUint64(&basic)), true)
+	tests.AssertEqual(t, nullable.NewUint64(&basic).Equal(nullable.NewUint64(&zero)), false)
+	tests.AssertEqual(t, nullable.NewUint64(&zero).Equal(nullable.NewUint64(nil)), false)
+}
+
+func TestCompareUint64(t *testing.T) {
+	var lesser uint64 = 1
+	var greater uint64 = 18446744073709551615
+	null := nullable.NewUint64(nil)
+	small := nullable.NewUint64(&lesser)
+	big := nullable.NewUint64(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewUint64(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewUint64(&greater)), 0)
+
+	values := []nullable.Uint64{big, null, small}
+	slices.SortFunc(values, nullable.Uint64.Compare)
+	tests.AssertEqual(t, values, []nullable.Uint64{null, small, big})
+}
+
+func TestXMLUint64(t *testing.T) {
+	var basic uint64 = 18446744073709551615
+	marshalUnmarshalXML(t, nullable.NewUint64(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewUint64(nil))
+}
+
+func TestValueUint64(t *testing.T) {
+	var basicUint1 uint64 = math.MaxInt64
+	value, err := nullable.NewUint64(&basicUint1).Value()
+	tests.AssertEqual(t, err, nil)
+	// AssertEqual compares printed values, check the dynamic type as well
+	if number, ok := value.(int64); !ok || number != math.MaxInt64 {
+		t.Errorf("expected int64 %d, got %T %v", int64(math.MaxInt64), value, value)
+	}
+
+	var basicUint2 uint64 = math.MaxInt64 + 1
+	value, err = nullable.NewUint64(&basicUint2).Value()
+	tests.AssertEqual(t, err, nil)
+	if text, ok := value.(string); !ok || text != "9223372036854775808" {
+		t.Errorf("expected string %q, got %T %v", "9223372036854775808", value, value)
+	}
+
+	var basicUint3 uint64 = math.MaxUint64
+	value, err = nullable.NewUint64(&basicUint3).Value()
+	tests.AssertEqual(t, err, nil)
+	if text, ok := value.(string); !ok || text != "18446744073709551615" {
+		t.Errorf("expected string %q, got %T %v", "18446744073709551615", value, value)
+	}
+
+	value, err = nullable.NewUint64(nil).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
 func TestJSONUint64(t *testing.T) {
 	var basicInt1 uint64 = 37
 	marshalUnmarshalJSON(t, nullable.NewUint64(&basicInt1))
@@ -98,6 +597,151 @@ func TestJSONUint64(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewUint64(nil))
 }
 
+func TestJSONQuotedUint64(t *testing.T) {
+	var maxValue uint64 = math.MaxUint64
+	marshalUnmarshalJSON(t, nullable.NewUint64(&maxValue))
+
+	var unserialized nullable.Uint64
+	tests.AssertEqual(t, json.Unmarshal([]byte(`"18446744073709551615"`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized, nullable.NewUint64(&maxValue))
+
+	// Called directly, UnmarshalJSON may get the token with whitespace around it
+	tests.AssertEqual(t, unserialized.UnmarshalJSON([]byte(" \n\"18446744073709551615\" ")), nil)
+	tests.AssertEqual(t, unserialized, nullable.NewUint64(&maxValue))
+
+	tests.AssertEqual(t, json.Unmarshal([]byte(`null`), &unserialized), nil)
+	tests.AssertEqual(t, unserialized.IsNull(), true)
+
+	for _, invalid := range []string{`"abc"`, `""`, `"18446744073709551616"`, `"-1"`} {
+		if err := json.Unmarshal([]byte(invalid), &unserialized); err == nil {
+			t.Errorf("unmarshaling %s into Uint64 must fail", invalid)
+		}
+	}
+}
+
+func TestMarshalJSONCompatibleUint64(t *testing.T) {
+	for _, basic := range []uint64{0, 50000000000, math.MaxUint64} {
+		expected, err := json.Marshal(&basic)
+		tests.AssertEqual(t, err, nil)
+		serialized, err := json.Marshal(nullable.NewUint64(&basic))
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(serialized), string(expected))
+	}
+
+	serialized, err := json.Marshal(nullable.NewUint64(nil))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "null")
+}
+
+func BenchmarkMarshalJSONUint64(b *testing.B) {
+	var basic uint64 = 18446744073709551615
+	nullableUint64 := nullable.NewUint64(&basic)
+	b.Run("nullable", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			jsonSink, _ = nullableUint64.MarshalJSON()
+		}
+	})
+	b.Run("json.Marshal of Get", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			jsonSink, _ = json.Marshal(nullableUint64.Get())
+		}
+	})
+}
+
+// valueSink keeps benchmarks from optimizing Value away
+var valueSink driver.Value
+
+func BenchmarkValueUint64(b *testing.B) {
+	fits, large := nullable.Uint64From(1234567890), nullable.Uint64From(math.MaxUint64)
+	b.Run("int64", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			valueSink, _ = fits.Value()
+		}
+	})
+	b.Run("string fallback", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			valueSink, _ = large.Value()
+		}
+	})
+	b.Run("strconv.FormatUint of Get", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			valueSink = strconv.FormatUint(*fits.Get(), 10)
+		}
+	})
+}
+
+func BenchmarkCreateInBatchesUint64(b *testing.B) {
+	type BenchNullableUint64 struct {
+		ID    uint
+		Count nullable.Uint64
+	}
+
+	DB.Migrator().DropTable(&BenchNullableUint64{})
+	if err := DB.Migrator().AutoMigrate(&BenchNullableUint64{}); err != nil {
+		b.Fatalf("failed to migrate nullable uint64, got error: %v", err)
+	}
+	quiet := DB.Session(&gorm.Session{Logger: logger.Discard})
+
+	for _, start := range []uint64{0, math.MaxInt64 + 1} {
+		b.Run(fmt.Sprintf("from %d", start), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				rows := make([]BenchNullableUint64, 1000)
+				for j := range rows {
+					rows[j].Count = nullable.Uint64From(start + uint64(j))
+				}
+				if err := quiet.CreateInBatches(rows, 500).Error; err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestChangedUpdatesUint64(t *testing.T) {
+	type TestChangedUint64 struct {
+		ID     uint
+		Likes  nullable.Uint64
+		Shares nullable.Uint64
+		Views  nullable.Uint64
+	}
+
+	DB.Migrator().DropTable(&TestChangedUint64{})
+	if err := DB.Migrator().AutoMigrate(&TestChangedUint64{}); err != nil {
+		t.Fatalf("failed to migrate changed uint64, got error: %v", err)
+	}
+
+	old := TestChangedUint64{Likes: nullable.Uint64From(1), Shares: nullable.NullUint64(), Views: nullable.Uint64From(7)}
+	DB.Create(&old)
+
+	patched := old
+	patched.Likes = nullable.NullUint64()
+	patched.Shares = nullable.Uint64From(2)
+
+	changes := map[string]interface{}{}
+	if patched.Likes.Changed(old.Likes) {
+		changes["likes"] = patched.Likes
+	}
+	if patched.Shares.Changed(old.Shares) {
+		changes["shares"] = patched.Shares
+	}
+	if patched.Views.Changed(old.Views) {
+		changes["views"] = patched.Views
+	}
+	tests.AssertEqual(t, len(changes), 2)
+	tests.AssertEqual(t, DB.Model(&old).Updates(changes).Error, nil)
+
+	var result TestChangedUint64
+	if err := DB.First(&result, old.ID).Error; err != nil {
+		t.Fatal("Cannot read changed uint64 test record")
+	}
+	tests.AssertEqual(t, result, patched)
+}
+
 func TestUint64(t *testing.T) {
 	type TestNullableUint64 struct {
 		ID    uint64
@@ -138,3 +782,138 @@ func TestUint64(t *testing.T) {
 	}
 	tests.AssertEqual(t, result2, neutron)
 }
+
+func TestUint64AboveMaxInt64(t *testing.T) {
+	if !SupportedDriver("mysql", "postgres") {
+		t.Skip("SQLite converts integers above math.MaxInt64 into REAL")
+	}
+
+	type TestNullableUint64Max struct {
+		ID    uint64
+		Name  string
+		Value nullable.Uint64
+	}
+
+	DB.Migrator().DropTable(&TestNullableUint64Max{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableUint64Max{}); err != nil {
+		t.Errorf("failed to migrate nullable uint64, got error: %v", err)
+	}
+
+	var maxValue uint64 = math.MaxUint64
+	biggest := TestNullableUint64Max{
+		Name:  "biggest",
+		Value: nullable.NewUint64(&maxValue),
+	}
+	DB.Create(&biggest)
+
+	var result TestNullableUint64Max
+	if err := DB.First(&result, "name = ?", "biggest").Error; err != nil {
+		t.Fatal("Cannot read uint64 test record of \"biggest\"")
+	}
+	tests.AssertEqual(t, result, biggest)
+}
+
+func TestExprUint64(t *testing.T) {
+	next := nullable.Uint64Expr("nextval(?)", "orders_seq")
+	tests.AssertEqual(t, next.IsExpr(), true)
+	tests.AssertEqual(t, next.IsNull(), true)
+	tests.AssertEqual(t, next.DebugString(), "Uint64(SQL nextval(?))")
+	for _, dialect := range []string{"mysql", "sqlite", "postgres", "cockroachdb", "sqlserver", "clickhouse", "oracle"} {
+		expr := next.GormValue(context.Background(), DialectDB(dialect))
+		tests.AssertEqual(t, expr.SQL, "nextval(?)")
+		tests.AssertEqual(t, expr.Vars, []interface{}{"orders_seq"})
+	}
+
+	// Normal values keep parameter binding
+	expr := nullable.Uint64From(7).GormValue(context.Background(), DialectDB("postgres"))
+	tests.AssertEqual(t, expr.SQL, "?")
+	tests.AssertEqual(t, expr.Vars, []interface{}{uint64(7)})
+
+	_, err := next.Value()
+	tests.AssertEqual(t, err != nil, true)
+
+	next.SetValue(5)
+	tests.AssertEqual(t, next.IsExpr(), false)
+	tests.AssertEqual(t, next, nullable.Uint64From(5))
+
+	next = nullable.Uint64Expr("DEFAULT")
+	tests.AssertEqual(t, next.Scan(int64(9)), nil)
+	tests.AssertEqual(t, next.IsExpr(), false)
+	tests.AssertEqual(t, next, nullable.Uint64From(9))
+}
+
+func TestUint64Expr(t *testing.T) {
+	type TestNullableUint64Expr struct {
+		ID    uint64
+		Value nullable.Uint64
+	}
+
+	DB.Migrator().DropTable(&TestNullableUint64Expr{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableUint64Expr{}); err != nil {
+		t.Errorf("failed to migrate nullable uint64 expression, got error: %v", err)
+	}
+
+	dryRun := DB.Session(&gorm.Session{DryRun: true}).Create(&TestNullableUint64Expr{Value: nullable.Uint64Expr("40 + ?", 2)})
+	tests.AssertEqual(t, strings.Contains(dryRun.Statement.SQL.String(), "40 + "), true)
+	tests.AssertEqual(t, dryRun.Statement.Vars, []interface{}{2})
+
+	computed := TestNullableUint64Expr{Value: nullable.Uint64Expr("40 + 2")}
+	if err := DB.Create(&computed).Error; err != nil {
+		t.Fatalf("failed to insert uint64 expression, got error: %v", err)
+	}
+
+	var result TestNullableUint64Expr
+	if err := DB.First(&result, computed.ID).Error; err != nil {
+		t.Fatal("Cannot read uint64 expression test record")
+	}
+	tests.AssertEqual(t, result.Value, nullable.Uint64From(42))
+}
+
+func TestValueForUint64(t *testing.T) {
+	cases := []struct {
+		dialect  string
+		value    nullable.Uint64
+		expected driver.Value
+	}{
+		{"mysql", nullable.Uint64From(7), int64(7)},
+		{"mysql", nullable.Uint64From(math.MaxUint64), "18446744073709551615"},
+		{"mysql", nullable.NullUint64(), nil},
+		{"sqlite", nullable.Uint64From(7), int64(7)},
+		{"sqlite", nullable.Uint64From(math.MaxUint64), "18446744073709551615"},
+		{"sqlite", nullable.NullUint64(), nil},
+		{"sqlserver", nullable.Uint64From(7), int64(7)},
+		{"sqlserver", nullable.Uint64From(math.MaxUint64), "18446744073709551615"},
+		{"sqlserver", nullable.NullUint64(), nil},
+		{"postgres", nullable.Uint64From(7), uint64(7)},
+		{"postgres", nullable.Uint64From(math.MaxUint64), uint64(math.MaxUint64)},
+		{"postgres", nullable.NullUint64(), nil},
+		{"cockroachdb", nullable.Uint64From(7), uint64(7)},
+		{"cockroachdb", nullable.Uint64From(math.MaxUint64), uint64(math.MaxUint64)},
+		{"cockroachdb", nullable.NullUint64(), nil},
+		{"clickhouse", nullable.Uint64From(7), uint64(7)},
+		{"clickhouse", nullable.Uint64From(math.MaxUint64), uint64(math.MaxUint64)},
+		{"clickhouse", nullable.NullUint64(), nil},
+		{"unknown", nullable.Uint64From(math.MaxUint64), "18446744073709551615"},
+		{"unknown", nullable.NullUint64(), nil},
+	}
+	for _, c := range cases {
+		value, err := c.value.ValueFor(c.dialect)
+		tests.AssertEqual(t, err, nil)
+		if value != c.expected {
+			t.Errorf("ValueFor(%q) of %v: expected %#v, got %#v", c.dialect, c.value, c.expected, value)
+		}
+
+		// GormValue binds the very same value
+		expr := c.value.GormValue(context.Background(), DialectDB(c.dialect))
+		tests.AssertEqual(t, expr.Vars, []interface{}{value})
+	}
+
+	// Text read from a MySQL dump, written to PostgreSQL
+	dumped := nullable.MustParseUint64("18446744073709551615")
+	value, err := dumped.ValueFor("postgres")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, uint64(math.MaxUint64))
+
+	_, err = nullable.Uint64Expr("nextval(?)", "orders_seq").ValueFor("postgres")
+	tests.AssertEqual(t, err != nil, true)
+}
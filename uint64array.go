@@ -0,0 +1,433 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// Uint64Array SQL type that can retrieve NULL value of a PostgreSQL numeric[]
+// column, read and written in array text form such as {1,2,3}.
+// NULL and an empty array are told apart: a valid array is never nil.
+type Uint64Array struct {
+	core[[]uint64]
+}
+
+// NewUint64Array creates a new nullable array of 64-bit unsigned integers
+func NewUint64Array(value *[]uint64) Uint64Array {
+	if value == nil {
+		return Uint64Array{}
+	}
+	return Uint64ArrayFrom(*value)
+}
+
+// Uint64ArrayFrom creates a new valid nullable array of 64-bit unsigned integers from
+// value, nil is an empty array
+func Uint64ArrayFrom(value []uint64) Uint64Array {
+	if value == nil {
+		value = []uint64{}
+	}
+	return Uint64Array{core: newCore(&value)}
+}
+
+// NullUint64Array creates a new NULL array of 64-bit unsigned integers
+func NullUint64Array() Uint64Array {
+	return NewUint64Array(nil)
+}
+
+// Set either nil or array of 64-bit unsigned integers
+func (n *Uint64Array) Set(value *[]uint64) {
+	*n = NewUint64Array(value)
+}
+
+// Swap sets either nil or array of 64-bit unsigned integers like Set does
+// and returns the previous value, nil when it was NULL
+func (n *Uint64Array) Swap(value *[]uint64) *[]uint64 {
+	previous := n.Get()
+	n.Set(value)
+	return previous
+}
+
+// SetValue sets array of 64-bit unsigned integers and marks it as not NULL
+func (n *Uint64Array) SetValue(value []uint64) {
+	*n = Uint64ArrayFrom(value)
+}
+
+// Reset sets the value back to the zero NULL Uint64Array, the one a declared
+// Uint64Array starts with, letting go of the held slice so pooled values can be
+// reused
+func (n *Uint64Array) Reset() {
+	*n = Uint64Array{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *Uint64Array) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// MustGet either array of 64-bit unsigned integers or panic when NULL
+func (n Uint64Array) MustGet() []uint64 {
+	return n.mustGet("Uint64Array")
+}
+
+// Clone returns a copy of the value that shares no memory with n
+func (n Uint64Array) Clone() Uint64Array {
+	n.realValue = slices.Clone(n.realValue)
+	return n
+}
+
+// String returns array of 64-bit unsigned integers in PostgreSQL array text form, or
+// "<null>" when NULL
+func (n Uint64Array) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	elements := make([]string, len(n.realValue))
+	for i, value := range n.realValue {
+		elements[i] = strconv.FormatUint(value, 10)
+	}
+	return formatPGArray(elements)
+}
+
+// DebugString returns Uint64Array(value) or Uint64Array(NULL), telling NULL apart from a
+// zero value in logs
+func (n Uint64Array) DebugString() string {
+	return debugString("Uint64Array", n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same array of 64-bit unsigned integers
+func (n Uint64Array) Equal(other Uint64Array) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return slices.Equal(n.realValue, other.realValue)
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n Uint64Array) Changed(old Uint64Array) bool {
+	return !n.Equal(old)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL 8 big-endian bytes per element, telling NULL apart from zero in cache
+// keys
+func (n Uint64Array) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagUint64Array, n.isValid, hashUint64s(n.realValue))
+}
+
+// MarshalJSON converts current value to JSON
+func (n Uint64Array) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.realValue)
+}
+
+// UnmarshalJSON writes JSON to this type
+func (n *Uint64Array) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed []uint64
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalText converts current value to PostgreSQL array text form, NULL is
+// empty text
+func (n Uint64Array) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(n.String()), nil
+}
+
+// UnmarshalText writes array text form such as {1,2,3} to this type, empty
+// text is NULL
+func (n *Uint64Array) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.SetNull()
+		return nil
+	}
+
+	parsed, err := parseUint64Array(string(text))
+	if err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n Uint64Array) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *Uint64Array) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n Uint64Array) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return n.String(), false
+}
+
+// MarshalYAML converts current value to YAML, a sequence like JSON
+func (n Uint64Array) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *Uint64Array) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed []uint64
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalXML converts current value to XML, the array text form
+func (n Uint64Array) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.String(), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *Uint64Array) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	parsed, err := parseUint64Array(strings.TrimSpace(text))
+	if err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n Uint64Array) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *Uint64Array) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.SetNull()
+		return nil
+	}
+
+	var parsed []uint64
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, each element written the
+// way Uint64 writes it since BSON has no unsigned integers
+func (n Uint64Array) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	elements := make([]Uint64, len(n.realValue))
+	for i, value := range n.realValue {
+		elements[i] = Uint64From(value)
+	}
+	return bson.MarshalValue(elements)
+}
+
+// UnmarshalBSONValue writes BSON to this type, reading each element the way
+// Uint64 reads it
+func (n *Uint64Array) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.SetNull()
+		return nil
+	}
+
+	var elements []Uint64
+	if err := unmarshalBSONValue(t, data, &elements); err != nil {
+		return err
+	}
+	parsed := make([]uint64, len(elements))
+	for i, element := range elements {
+		if !element.IsValid() {
+			return fmt.Errorf("nullable: null element %d of Uint64Array", i)
+		}
+		parsed[i] = element.GetOrZero()
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from empty array
+func (n Uint64Array) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *Uint64Array) GobDecode(data []byte) error {
+	var parsed []uint64
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.SetNull()
+		return nil
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// Scan implements scanner interface, reading PostgreSQL array text form
+func (n *Uint64Array) Scan(value interface{}) error {
+	value = scanValue(value)
+	if value == nil {
+		n.SetNull()
+		return nil
+	}
+
+	var scanned string
+	if err := convertAssign(&scanned, value); err != nil {
+		return scanError(err)
+	}
+	parsed, err := parseUint64Array(scanned)
+	if err != nil {
+		return scanError(err)
+	}
+
+	n.SetValue(parsed)
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n Uint64Array) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.String(), nil
+}
+
+// GormDataType gorm common data type
+func (Uint64Array) GormDataType() string {
+	return "uint64_array_null"
+}
+
+// GormDBDataType gorm db data type
+func (n Uint64Array) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n Uint64Array) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (Uint64Array) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
+	case "postgres":
+		return "numeric[]"
+	case "cockroachdb":
+		// CockroachDB INT8 is signed, DECIMAL(20,0) covers 0 up to math.MaxUint64
+		return "DECIMAL(20,0)[]"
+	case "sqlite", "mysql":
+		// No array type, the text form is stored as is
+		return "TEXT"
+	case "sqlserver":
+		return "NVARCHAR(MAX)"
+	case "clickhouse":
+		// ClickHouse arrays cannot be NULL
+		return "Nullable(String)"
+	}
+	return ""
+}
+
+// parseUint64Array parses array text form such as {1,2,3} into 64-bit unsigned integers
+func parseUint64Array(text string) ([]uint64, error) {
+	elements, err := parsePGArray(text)
+	if err != nil {
+		return nil, err
+	}
+	parsed := make([]uint64, len(elements))
+	for i, element := range elements {
+		if parsed[i], err = strconv.ParseUint(element, 10, 64); err != nil {
+			return nil, fmt.Errorf("nullable: invalid element %q of Uint64Array: %w", element, err)
+		}
+	}
+	return parsed, nil
+}
@@ -0,0 +1,189 @@
+package nullable_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"math"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanUint64Array(t *testing.T) {
+	nullableArray := nullable.NullUint64Array()
+
+	tests.AssertEqual(t, nullableArray.Scan("{0,18446744073709551615}"), nil)
+	tests.AssertEqual(t, nullableArray.Get(), []uint64{0, math.MaxUint64})
+
+	tests.AssertEqual(t, nullableArray.Scan([]byte("{}")), nil)
+	tests.AssertEqual(t, nullableArray.IsValid(), true)
+	tests.AssertEqual(t, nullableArray.Get(), []uint64{})
+
+	for _, malformed := range []string{"1,2", "{1,-2}", "{1,NULL}", "{18446744073709551616}"} {
+		if err := nullableArray.Scan(malformed); err == nil {
+			t.Errorf("scanning %q must fail", malformed)
+		}
+	}
+
+	tests.AssertEqual(t, nullableArray.Scan(nil), nil)
+	tests.AssertEqual(t, nullableArray.Get(), nil)
+}
+
+func TestValueUint64Array(t *testing.T) {
+	value, err := nullable.Uint64ArrayFrom([]uint64{1, math.MaxUint64}).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, "{1,18446744073709551615}")
+
+	value, err = nullable.Uint64ArrayFrom(nil).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, "{}")
+
+	value, err = nullable.NullUint64Array().Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
+func TestSetUint64Array(t *testing.T) {
+	nullableArray := nullable.NewUint64Array(nil)
+	tests.AssertEqual(t, nullableArray.Get(), nil)
+
+	basic := []uint64{1, 2, 3}
+	nullableArray.Set(&basic)
+	tests.AssertEqual(t, nullableArray.Get(), basic)
+
+	nullableArray.SetValue(nil)
+	tests.AssertEqual(t, nullableArray, nullable.Uint64ArrayFrom([]uint64{}))
+
+	nullableArray.SetNull()
+	tests.AssertEqual(t, nullableArray, nullable.NullUint64Array())
+}
+
+func TestCloneUint64Array(t *testing.T) {
+	original := nullable.Uint64ArrayFrom([]uint64{1, 2})
+	clone := original.Clone()
+	clone.MustGet()[0] = 3
+	tests.AssertEqual(t, original, nullable.Uint64ArrayFrom([]uint64{1, 2}))
+	tests.AssertEqual(t, clone.Changed(original), true)
+}
+
+func TestJSONUint64Array(t *testing.T) {
+	cases := []struct {
+		value      nullable.Uint64Array
+		serialized string
+	}{
+		{nullable.Uint64ArrayFrom([]uint64{1, math.MaxUint64}), `[1,18446744073709551615]`},
+		{nullable.Uint64ArrayFrom([]uint64{}), `[]`},
+		{nullable.NullUint64Array(), `null`},
+	}
+	for _, c := range cases {
+		serialized, err := json.Marshal(c.value)
+		tests.AssertEqual(t, err, nil)
+		tests.AssertEqual(t, string(serialized), c.serialized)
+
+		var unserialized nullable.Uint64Array
+		tests.AssertEqual(t, json.Unmarshal(serialized, &unserialized), nil)
+		tests.AssertEqual(t, unserialized, c.value)
+	}
+}
+
+func TestTextUint64Array(t *testing.T) {
+	marshalUnmarshalText(t, nullable.Uint64ArrayFrom([]uint64{0, 1, math.MaxUint64}))
+	marshalUnmarshalText(t, nullable.Uint64ArrayFrom([]uint64{}))
+	marshalUnmarshalText(t, nullable.NullUint64Array())
+	marshalUnmarshalCSV(t, nullable.Uint64ArrayFrom([]uint64{0, 1, math.MaxUint64}))
+	marshalUnmarshalCSV(t, nullable.NullUint64Array())
+
+	cell, err := nullable.Uint64ArrayFrom([]uint64{}).MarshalCSV()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, cell, "{}")
+
+	var unserialized nullable.Uint64Array
+	tests.AssertEqual(t, unserialized.UnmarshalCSV("{}"), nil)
+	tests.AssertEqual(t, unserialized.IsValid(), true)
+	tests.AssertEqual(t, unserialized.UnmarshalCSV(""), nil)
+	tests.AssertEqual(t, unserialized.IsNull(), true)
+	if err := unserialized.UnmarshalText([]byte("{1,x}")); err == nil {
+		t.Error("unmarshalling a malformed array text must fail")
+	}
+}
+
+func TestYAMLUint64Array(t *testing.T) {
+	marshalUnmarshalYAML(t, nullable.Uint64ArrayFrom([]uint64{0, 1, math.MaxUint64}))
+	marshalUnmarshalYAML(t, nullable.Uint64ArrayFrom([]uint64{}))
+	marshalUnmarshalYAML(t, nullable.NullUint64Array())
+
+	var unserialized yamlEnvelope[nullable.Uint64Array]
+	if err := yaml.Unmarshal([]byte("value: [a]"), &unserialized); err == nil {
+		t.Error("unmarshalling a sequence of strings into Uint64Array must fail")
+	}
+}
+
+func TestXMLUint64Array(t *testing.T) {
+	marshalUnmarshalXML(t, nullable.Uint64ArrayFrom([]uint64{0, 1, math.MaxUint64}))
+	marshalUnmarshalXML(t, nullable.Uint64ArrayFrom([]uint64{}))
+	marshalUnmarshalXML(t, nullable.NullUint64Array())
+
+	var unserialized nullable.Uint64Array
+	if err := xml.Unmarshal([]byte("<Uint64Array>1,2</Uint64Array>"), &unserialized); err == nil {
+		t.Error("unmarshalling an array without braces must fail")
+	}
+}
+
+func TestMsgpackUint64Array(t *testing.T) {
+	marshalUnmarshalMsgpack(t, nullable.Uint64ArrayFrom([]uint64{0, 1, math.MaxUint64}))
+	marshalUnmarshalMsgpack(t, nullable.Uint64ArrayFrom([]uint64{}))
+	marshalUnmarshalMsgpack(t, nullable.NullUint64Array())
+}
+
+func TestBSONUint64Array(t *testing.T) {
+	marshalUnmarshalBSON(t, nullable.Uint64ArrayFrom([]uint64{0, 1, math.MaxUint64}))
+	marshalUnmarshalBSON(t, nullable.Uint64ArrayFrom([]uint64{}))
+	marshalUnmarshalBSON(t, nullable.NullUint64Array())
+}
+
+func TestGobUint64Array(t *testing.T) {
+	marshalUnmarshalGob(t, nullable.Uint64ArrayFrom([]uint64{0, 1, math.MaxUint64}))
+	marshalUnmarshalGob(t, nullable.Uint64ArrayFrom([]uint64{}))
+	marshalUnmarshalGob(t, nullable.NullUint64Array())
+}
+
+func TestUint64Array(t *testing.T) {
+	type TestNullableUint64Array struct {
+		ID     uint
+		Name   string
+		Counts nullable.Uint64Array
+	}
+
+	DB.Migrator().DropTable(&TestNullableUint64Array{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableUint64Array{}); err != nil {
+		t.Errorf("failed to migrate nullable uint64 array, got error: %v", err)
+	}
+
+	filled := TestNullableUint64Array{
+		Name:   "filled",
+		Counts: nullable.Uint64ArrayFrom([]uint64{0, math.MaxUint64}),
+	}
+	DB.Create(&filled)
+
+	empty := TestNullableUint64Array{
+		Name:   "empty",
+		Counts: nullable.Uint64ArrayFrom([]uint64{}),
+	}
+	DB.Create(&empty)
+
+	unknown := TestNullableUint64Array{
+		Name:   "unknown",
+		Counts: nullable.NullUint64Array(),
+	}
+	DB.Create(&unknown)
+
+	for _, expected := range []TestNullableUint64Array{filled, empty, unknown} {
+		var result TestNullableUint64Array
+		if err := DB.First(&result, "name = ?", expected.Name).Error; err != nil {
+			t.Fatalf("Cannot read uint64 array test record of %q", expected.Name)
+		}
+		tests.AssertEqual(t, result, expected)
+	}
+}
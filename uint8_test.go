@@ -1,6 +1,8 @@
 package nullable_test
 
 import (
+	"fmt"
+	"slices"
 	"testing"
 
 	"github.com/tee8z/nullable"
@@ -22,6 +24,39 @@ func TestScanUint8(t *testing.T) {
 	tests.AssertEqual(t, nullableInt.Get(), nil)
 }
 
+func TestScanUint8Overflow(t *testing.T) {
+	nullableInt := nullable.NewUint8(nil)
+
+	tests.AssertEqual(t, nullableInt.Scan("255"), nil)
+	tests.AssertEqual(t, nullableInt.Get(), 255)
+
+	if err := nullableInt.Scan("256"); err == nil {
+		t.Errorf("scanning \"256\" into Uint8 must fail, got %v", nullableInt.Get())
+	}
+	if err := nullableInt.Scan(256); err == nil {
+		t.Errorf("scanning 256 into Uint8 must fail, got %v", nullableInt.Get())
+	}
+	if err := nullableInt.Scan(-1); err == nil {
+		t.Errorf("scanning -1 into Uint8 must fail, got %v", nullableInt.Get())
+	}
+
+	// previous valid value must be preserved
+	tests.AssertEqual(t, nullableInt.Get(), 255)
+}
+
+func TestScanFloatUint8(t *testing.T) {
+	var scanned nullable.Uint8
+	tests.AssertEqual(t, scanned.Scan(float64(42)), nil)
+	tests.AssertEqual(t, scanned, nullable.Uint8From(42))
+
+	if err := scanned.Scan(float64(42.5)); err == nil {
+		t.Error("scanning float64 with fractional part into Uint8 must fail")
+	}
+	if err := scanned.Scan(float64(256)); err == nil {
+		t.Error("scanning overflowing float64 into Uint8 must fail")
+	}
+}
+
 func TestNewUint8(t *testing.T) {
 	var basicUint1 uint8 = 37
 	nullableUint1 := nullable.NewUint8(&basicUint1)
@@ -43,6 +78,259 @@ func TestSetUint8(t *testing.T) {
 	tests.AssertEqual(t, nullableUint.Get(), nil)
 }
 
+func TestUint8From(t *testing.T) {
+	var basic uint8 = 200
+	tests.AssertEqual(t, nullable.Uint8From(basic), nullable.NewUint8(&basic))
+	tests.AssertEqual(t, nullable.Uint8From(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullUint8(), nullable.NewUint8(nil))
+	tests.AssertEqual(t, nullable.NullUint8().IsNull(), true)
+}
+
+func TestCoalesceUint8(t *testing.T) {
+	var basic uint8 = 200
+	var zero uint8 = 0
+	tests.AssertEqual(t, nullable.CoalesceUint8(), nullable.Uint8{})
+	tests.AssertEqual(t, nullable.CoalesceUint8(nullable.NullUint8(), nullable.NullUint8()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceUint8(nullable.NullUint8(), nullable.Uint8From(basic), nullable.Uint8From(zero)), nullable.Uint8From(basic))
+	tests.AssertEqual(t, nullable.CoalesceUint8(nullable.Uint8From(zero), nullable.Uint8From(basic)), nullable.Uint8From(zero))
+}
+
+func TestIsZeroUint8(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullUint8().IsZero(), true)
+	tests.AssertEqual(t, nullable.Uint8From(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.Uint8From(200).IsZero(), false)
+}
+
+func TestCloneUint8(t *testing.T) {
+	tests.AssertEqual(t, nullable.Uint8From(200).Clone(), nullable.Uint8From(200))
+	tests.AssertEqual(t, nullable.NullUint8().Clone(), nullable.NullUint8())
+}
+
+func TestUnwrapUint8(t *testing.T) {
+	var basic uint8 = 200
+	value, ok := nullable.Uint8From(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullUint8().Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Uint8
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseUint8(t *testing.T) {
+	parsed, err := nullable.ParseUint8("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullUint8())
+
+	text, err := nullable.Uint8From(200).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseUint8(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.Uint8From(200)), true)
+
+	for _, text := range []string{"abc", "-1", "1.5"} {
+		if _, err := nullable.ParseUint8(text); err == nil {
+			t.Errorf("parsing %q as 8-bit unsigned integer must fail", text)
+		}
+	}
+}
+
+func TestMustParseUint8(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseUint8(""), nullable.NullUint8())
+
+	text, err := nullable.Uint8From(200).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseUint8(string(text)).Equal(nullable.Uint8From(200)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseUint8 of malformed text must panic")
+		}
+	}()
+	nullable.MustParseUint8("abc")
+}
+
+func TestPtrUint8(t *testing.T) {
+	var basic uint8 = 200
+	tests.AssertEqual(t, nullable.Uint8Ptr(nullable.NewUint8(&basic)), basic)
+	tests.AssertEqual(t, nullable.Uint8Ptr(nullable.NewUint8(nil)), nil)
+	tests.AssertEqual(t, nullable.Uint8Value(nullable.NewUint8(&basic)), basic)
+	tests.AssertEqual(t, nullable.Uint8Value(nullable.NewUint8(nil)), 0)
+}
+
+func TestSetValueUint8(t *testing.T) {
+	var basic uint8 = 200
+	var nullableUint8 nullable.Uint8
+	nullableUint8.SetValue(basic)
+	tests.AssertEqual(t, nullableUint8, nullable.Uint8From(basic))
+
+	nullableUint8.SetNull()
+	tests.AssertEqual(t, nullableUint8, nullable.NullUint8())
+}
+
+func TestZeroAsNullUint8(t *testing.T) {
+	var basic uint8 = 200
+	var zero uint8 = 0
+	tests.AssertEqual(t, nullable.Uint8ZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.Uint8ZeroAsNull(basic), nullable.Uint8From(basic))
+
+	nullableUint8 := nullable.Uint8From(zero)
+	nullableUint8.NullIfZero()
+	tests.AssertEqual(t, nullableUint8, nullable.NullUint8())
+
+	nullableUint8 = nullable.Uint8From(basic)
+	nullableUint8.NullIfZero()
+	tests.AssertEqual(t, nullableUint8, nullable.Uint8From(basic))
+}
+
+func TestIsNullUint8(t *testing.T) {
+	var zero nullable.Uint8
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic uint8 = 200
+	nullableUint8 := nullable.NewUint8(&basic)
+	tests.AssertEqual(t, nullableUint8.IsNull(), false)
+	tests.AssertEqual(t, nullableUint8.IsValid(), true)
+
+	nullableUint8.Set(nil)
+	tests.AssertEqual(t, nullableUint8.IsNull(), true)
+	tests.AssertEqual(t, nullableUint8.IsValid(), false)
+}
+
+func TestGetOrUint8(t *testing.T) {
+	var zero nullable.Uint8
+	var fallback uint8 = 200
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), 0)
+
+	var basic uint8 = 200
+	nullableUint8 := nullable.NewUint8(&basic)
+	tests.AssertEqual(t, nullableUint8.GetOr(0), basic)
+	tests.AssertEqual(t, nullableUint8.GetOrZero(), basic)
+}
+
+func TestMustGetUint8(t *testing.T) {
+	var basic uint8 = 200
+	nullableUint8 := nullable.NewUint8(&basic)
+	tests.AssertEqual(t, nullableUint8.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Uint8")
+	}()
+	nullableUint8.Set(nil)
+	nullableUint8.MustGet()
+	t.Error("MustGet on NULL Uint8 must panic")
+}
+
+func TestTextUint8(t *testing.T) {
+	var basic uint8 = 200
+	marshalUnmarshalText(t, nullable.NewUint8(&basic))
+
+	marshalUnmarshalText(t, nullable.NewUint8(nil))
+}
+
+func TestCSVUint8(t *testing.T) {
+	var basic uint8 = 200
+	marshalUnmarshalCSV(t, nullable.NewUint8(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewUint8(nil))
+}
+
+func TestYAMLUint8(t *testing.T) {
+	var basic uint8 = 200
+	marshalUnmarshalYAML(t, nullable.NewUint8(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewUint8(nil))
+}
+
+func TestMsgpackUint8(t *testing.T) {
+	var basic uint8 = 200
+	marshalUnmarshalMsgpack(t, nullable.NewUint8(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewUint8(nil))
+}
+
+func TestBSONUint8(t *testing.T) {
+	var basic uint8 = 200
+	marshalUnmarshalBSON(t, nullable.NewUint8(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewUint8(nil))
+}
+
+func TestGobUint8(t *testing.T) {
+	var basic uint8 = 200
+	marshalUnmarshalGob(t, nullable.NewUint8(&basic))
+
+	var zero uint8 = 0
+	marshalUnmarshalGob(t, nullable.NewUint8(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewUint8(nil))
+}
+
+func TestStringerUint8(t *testing.T) {
+	var basic uint8 = 200
+	nullableUint8 := nullable.NewUint8(&basic)
+	tests.AssertEqual(t, nullableUint8.String(), "200")
+	tests.AssertEqual(t, fmt.Sprint(nullableUint8), "200")
+
+	nullableUint8.Set(nil)
+	tests.AssertEqual(t, nullableUint8.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableUint8), "<null>")
+}
+
+func TestChangedUint8(t *testing.T) {
+	var basic uint8 = 200
+	value, null := nullable.NewUint8(&basic), nullable.NewUint8(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.Uint8From(0)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewUint8(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewUint8(nil)), false)
+}
+
+func TestEqualUint8(t *testing.T) {
+	var basic uint8 = 200
+	var zero uint8 = 0
+	tests.AssertEqual(t, nullable.NewUint8(nil).Equal(nullable.NewUint8(nil)), true)
+	tests.AssertEqual(t, nullable.NewUint8(nil).Equal(nullable.NewUint8(&basic)), false)
+	tests.AssertEqual(t, nullable.NewUint8(&basic).Equal(nullable.NewUint8(nil)), false)
+	tests.AssertEqual(t, nullable.NewUint8(&basic).Equal(nullable.NewUint8(&basic)), true)
+	tests.AssertEqual(t, nullable.NewUint8(&basic).Equal(nullable.NewUint8(&zero)), false)
+	tests.AssertEqual(t, nullable.NewUint8(&zero).Equal(nullable.NewUint8(nil)), false)
+}
+
+func TestCompareUint8(t *testing.T) {
+	var lesser uint8 = 1
+	var greater uint8 = 200
+	null := nullable.NewUint8(nil)
+	small := nullable.NewUint8(&lesser)
+	big := nullable.NewUint8(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewUint8(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewUint8(&greater)), 0)
+
+	values := []nullable.Uint8{big, null, small}
+	slices.SortFunc(values, nullable.Uint8.Compare)
+	tests.AssertEqual(t, values, []nullable.Uint8{null, small, big})
+}
+
+func TestXMLUint8(t *testing.T) {
+	var basic uint8 = 200
+	marshalUnmarshalXML(t, nullable.NewUint8(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewUint8(nil))
+}
+
 func TestJSONUint8(t *testing.T) {
 	var basicInt1 uint8 = 37
 	marshalUnmarshalJSON(t, nullable.NewUint8(&basicInt1))
@@ -50,6 +338,16 @@ func TestJSONUint8(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewUint8(nil))
 }
 
+func TestValueUint8(t *testing.T) {
+	value, err := nullable.Uint8From(200).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, int64(200))
+
+	value, err = nullable.NullUint8().Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
 func TestUint8(t *testing.T) {
 	type TestNullableUint8 struct {
 		ID    uint
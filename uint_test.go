@@ -1,6 +1,9 @@
 package nullable_test
 
 import (
+	"fmt"
+	"math"
+	"slices"
 	"testing"
 
 	"github.com/tee8z/nullable"
@@ -30,6 +33,19 @@ func TestScanUint(t *testing.T) {
 	tests.AssertEqual(t, nullableInt.Get(), nil)
 }
 
+func TestScanFloatUint(t *testing.T) {
+	var scanned nullable.Uint
+	tests.AssertEqual(t, scanned.Scan(float64(42)), nil)
+	tests.AssertEqual(t, scanned, nullable.UintFrom(42))
+
+	if err := scanned.Scan(float64(42.5)); err == nil {
+		t.Error("scanning float64 with fractional part into Uint must fail")
+	}
+	if err := scanned.Scan(float64(-1)); err == nil {
+		t.Error("scanning overflowing float64 into Uint must fail")
+	}
+}
+
 func TestNewUint(t *testing.T) {
 	// uint8
 	var basicUint1 uint = 37
@@ -82,6 +98,259 @@ func TestSetUint(t *testing.T) {
 	tests.AssertEqual(t, nullableUint.Get(), nil)
 }
 
+func TestUintFrom(t *testing.T) {
+	var basic uint = 50000000000
+	tests.AssertEqual(t, nullable.UintFrom(basic), nullable.NewUint(&basic))
+	tests.AssertEqual(t, nullable.UintFrom(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullUint(), nullable.NewUint(nil))
+	tests.AssertEqual(t, nullable.NullUint().IsNull(), true)
+}
+
+func TestCoalesceUint(t *testing.T) {
+	var basic uint = 50000000000
+	var zero uint = 0
+	tests.AssertEqual(t, nullable.CoalesceUint(), nullable.Uint{})
+	tests.AssertEqual(t, nullable.CoalesceUint(nullable.NullUint(), nullable.NullUint()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceUint(nullable.NullUint(), nullable.UintFrom(basic), nullable.UintFrom(zero)), nullable.UintFrom(basic))
+	tests.AssertEqual(t, nullable.CoalesceUint(nullable.UintFrom(zero), nullable.UintFrom(basic)), nullable.UintFrom(zero))
+}
+
+func TestIsZeroUint(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullUint().IsZero(), true)
+	tests.AssertEqual(t, nullable.UintFrom(0).IsZero(), false)
+	tests.AssertEqual(t, nullable.UintFrom(50000000000).IsZero(), false)
+}
+
+func TestCloneUint(t *testing.T) {
+	tests.AssertEqual(t, nullable.UintFrom(50000000000).Clone(), nullable.UintFrom(50000000000))
+	tests.AssertEqual(t, nullable.NullUint().Clone(), nullable.NullUint())
+}
+
+func TestUnwrapUint(t *testing.T) {
+	var basic uint = 50000000000
+	value, ok := nullable.UintFrom(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullUint().Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.Uint
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, 0)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseUint(t *testing.T) {
+	parsed, err := nullable.ParseUint("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullUint())
+
+	text, err := nullable.UintFrom(50000000000).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseUint(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.UintFrom(50000000000)), true)
+
+	for _, text := range []string{"abc", "-1", "1.5"} {
+		if _, err := nullable.ParseUint(text); err == nil {
+			t.Errorf("parsing %q as unsigned integer must fail", text)
+		}
+	}
+}
+
+func TestMustParseUint(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseUint(""), nullable.NullUint())
+
+	text, err := nullable.UintFrom(50000000000).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseUint(string(text)).Equal(nullable.UintFrom(50000000000)), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseUint of malformed text must panic")
+		}
+	}()
+	nullable.MustParseUint("abc")
+}
+
+func TestPtrUint(t *testing.T) {
+	var basic uint = 50000000000
+	tests.AssertEqual(t, nullable.UintPtr(nullable.NewUint(&basic)), basic)
+	tests.AssertEqual(t, nullable.UintPtr(nullable.NewUint(nil)), nil)
+	tests.AssertEqual(t, nullable.UintValue(nullable.NewUint(&basic)), basic)
+	tests.AssertEqual(t, nullable.UintValue(nullable.NewUint(nil)), 0)
+}
+
+func TestSetValueUint(t *testing.T) {
+	var basic uint = 50000000000
+	var nullableUint nullable.Uint
+	nullableUint.SetValue(basic)
+	tests.AssertEqual(t, nullableUint, nullable.UintFrom(basic))
+
+	nullableUint.SetNull()
+	tests.AssertEqual(t, nullableUint, nullable.NullUint())
+}
+
+func TestZeroAsNullUint(t *testing.T) {
+	var basic uint = 50000000000
+	var zero uint = 0
+	tests.AssertEqual(t, nullable.UintZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.UintZeroAsNull(basic), nullable.UintFrom(basic))
+
+	nullableUint := nullable.UintFrom(zero)
+	nullableUint.NullIfZero()
+	tests.AssertEqual(t, nullableUint, nullable.NullUint())
+
+	nullableUint = nullable.UintFrom(basic)
+	nullableUint.NullIfZero()
+	tests.AssertEqual(t, nullableUint, nullable.UintFrom(basic))
+}
+
+func TestIsNullUint(t *testing.T) {
+	var zero nullable.Uint
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic uint = 50000000000
+	nullableUint := nullable.NewUint(&basic)
+	tests.AssertEqual(t, nullableUint.IsNull(), false)
+	tests.AssertEqual(t, nullableUint.IsValid(), true)
+
+	nullableUint.Set(nil)
+	tests.AssertEqual(t, nullableUint.IsNull(), true)
+	tests.AssertEqual(t, nullableUint.IsValid(), false)
+}
+
+func TestGetOrUint(t *testing.T) {
+	var zero nullable.Uint
+	var fallback uint = 50000000000
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), 0)
+
+	var basic uint = 50000000000
+	nullableUint := nullable.NewUint(&basic)
+	tests.AssertEqual(t, nullableUint.GetOr(0), basic)
+	tests.AssertEqual(t, nullableUint.GetOrZero(), basic)
+}
+
+func TestMustGetUint(t *testing.T) {
+	var basic uint = 50000000000
+	nullableUint := nullable.NewUint(&basic)
+	tests.AssertEqual(t, nullableUint.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL Uint")
+	}()
+	nullableUint.Set(nil)
+	nullableUint.MustGet()
+	t.Error("MustGet on NULL Uint must panic")
+}
+
+func TestTextUint(t *testing.T) {
+	var basic uint = 50000000000
+	marshalUnmarshalText(t, nullable.NewUint(&basic))
+
+	marshalUnmarshalText(t, nullable.NewUint(nil))
+}
+
+func TestCSVUint(t *testing.T) {
+	var basic uint = 50000000000
+	marshalUnmarshalCSV(t, nullable.NewUint(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewUint(nil))
+}
+
+func TestYAMLUint(t *testing.T) {
+	var basic uint = 50000000000
+	marshalUnmarshalYAML(t, nullable.NewUint(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewUint(nil))
+}
+
+func TestMsgpackUint(t *testing.T) {
+	var basic uint = 50000000000
+	marshalUnmarshalMsgpack(t, nullable.NewUint(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewUint(nil))
+}
+
+func TestBSONUint(t *testing.T) {
+	var basic uint = 50000000000
+	marshalUnmarshalBSON(t, nullable.NewUint(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewUint(nil))
+}
+
+func TestGobUint(t *testing.T) {
+	var basic uint = 50000000000
+	marshalUnmarshalGob(t, nullable.NewUint(&basic))
+
+	var zero uint = 0
+	marshalUnmarshalGob(t, nullable.NewUint(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewUint(nil))
+}
+
+func TestStringerUint(t *testing.T) {
+	var basic uint = 50000000000
+	nullableUint := nullable.NewUint(&basic)
+	tests.AssertEqual(t, nullableUint.String(), "50000000000")
+	tests.AssertEqual(t, fmt.Sprint(nullableUint), "50000000000")
+
+	nullableUint.Set(nil)
+	tests.AssertEqual(t, nullableUint.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableUint), "<null>")
+}
+
+func TestChangedUint(t *testing.T) {
+	var basic uint = 50000000000
+	value, null := nullable.NewUint(&basic), nullable.NewUint(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.UintFrom(0)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewUint(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewUint(nil)), false)
+}
+
+func TestEqualUint(t *testing.T) {
+	var basic uint = 50000000000
+	var zero uint = 0
+	tests.AssertEqual(t, nullable.NewUint(nil).Equal(nullable.NewUint(nil)), true)
+	tests.AssertEqual(t, nullable.NewUint(nil).Equal(nullable.NewUint(&basic)), false)
+	tests.AssertEqual(t, nullable.NewUint(&basic).Equal(nullable.NewUint(nil)), false)
+	tests.AssertEqual(t, nullable.NewUint(&basic).Equal(nullable.NewUint(&basic)), true)
+	tests.AssertEqual(t, nullable.NewUint(&basic).Equal(nullable.NewUint(&zero)), false)
+	tests.AssertEqual(t, nullable.NewUint(&zero).Equal(nullable.NewUint(nil)), false)
+}
+
+func TestCompareUint(t *testing.T) {
+	var lesser uint = 1
+	var greater uint = 50000000000
+	null := nullable.NewUint(nil)
+	small := nullable.NewUint(&lesser)
+	big := nullable.NewUint(&greater)
+
+	tests.AssertEqual(t, null.Compare(nullable.NewUint(nil)), 0)
+	tests.AssertEqual(t, null.Compare(small), -1)
+	tests.AssertEqual(t, small.Compare(null), 1)
+	tests.AssertEqual(t, small.Compare(big), -1)
+	tests.AssertEqual(t, big.Compare(small), 1)
+	tests.AssertEqual(t, big.Compare(nullable.NewUint(&greater)), 0)
+
+	values := []nullable.Uint{big, null, small}
+	slices.SortFunc(values, nullable.Uint.Compare)
+	tests.AssertEqual(t, values, []nullable.Uint{null, small, big})
+}
+
+func TestXMLUint(t *testing.T) {
+	var basic uint = 50000000000
+	marshalUnmarshalXML(t, nullable.NewUint(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewUint(nil))
+}
+
 func TestJSONUint(t *testing.T) {
 	var basicInt1 uint = 37
 	marshalUnmarshalJSON(t, nullable.NewUint(&basicInt1))
@@ -98,6 +367,20 @@ func TestJSONUint(t *testing.T) {
 	marshalUnmarshalJSON(t, nullable.NewUint(nil))
 }
 
+func TestValueUint(t *testing.T) {
+	value, err := nullable.UintFrom(50000000000).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, int64(50000000000))
+
+	value, err = nullable.UintFrom(math.MaxUint64).Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, "18446744073709551615")
+
+	value, err = nullable.NullUint().Value()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, value, nil)
+}
+
 func TestUint(t *testing.T) {
 	type TestNullableUint struct {
 		ID    uint
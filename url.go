@@ -0,0 +1,474 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// URL SQL type that can retrieve NULL value
+type URL struct {
+	core[url.URL]
+}
+
+// NewURL creates a new nullable URL
+func NewURL(value *url.URL) URL {
+	return URL{core: newCore(value)}
+}
+
+// URLFrom creates a new valid nullable URL from value
+func URLFrom(value url.URL) URL {
+	return NewURL(&value)
+}
+
+// NullURL creates a new NULL URL
+func NullURL() URL {
+	return NewURL(nil)
+}
+
+// URLZeroAsNull creates a new nullable URL that is NULL when value is the zero value
+func URLZeroAsNull(value url.URL) URL {
+	if value == (url.URL{}) {
+		return NullURL()
+	}
+	return URLFrom(value)
+}
+
+// CoalesceURL returns the first valid value, or NULL when all of them are NULL
+func CoalesceURL(values ...URL) URL {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return URL{}
+}
+
+// URLPtr returns n.Get(), handy where a function value is needed
+func URLPtr(n URL) *url.URL {
+	return n.Get()
+}
+
+// URLValue returns n.GetOrZero(), zero value when NULL
+func URLValue(n URL) url.URL {
+	return n.GetOrZero()
+}
+
+// ParseURL parses text like UnmarshalText does, empty text is NULL
+func ParseURL(text string) (URL, error) {
+	var n URL
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return URL{}, err
+	}
+	return n, nil
+}
+
+// MustParseURL is ParseURL that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseURL(text string) URL {
+	n, err := ParseURL(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseURL(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL URL, the one a declared
+// URL starts with, so pooled values can be reused
+func (n *URL) Reset() {
+	*n = URL{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *URL) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *URL) NullIfZero() {
+	if n.isValid && n.realValue == (url.URL{}) {
+		n.SetNull()
+	}
+}
+
+// MustGet either URL or panic when NULL
+func (n URL) MustGet() url.URL {
+	return n.mustGet("URL")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n URL) Clone() URL {
+	return n
+}
+
+// String returns URL in its natural text form, or "<null>" when NULL
+func (n URL) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return n.realValue.String()
+}
+
+// DebugString returns URL(value) or URL(NULL), telling NULL apart from a
+// zero value in logs
+func (n URL) DebugString() string {
+	return debugString("URL", n.isValid, n.String())
+}
+
+// LogValue implements slog.LogValuer, logging NULL as nil and the value as
+// its text like String
+func (n URL) LogValue() slog.Value {
+	return logText(n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same URL
+func (n URL) Equal(other URL) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue.String() == other.realValue.String()
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n URL) Changed(old URL) bool {
+	return !n.Equal(old)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL the URL text like Equal compares it, telling NULL apart from zero in
+// cache keys
+func (n URL) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagURL, n.isValid, []byte(n.realValue.String()))
+}
+
+// MarshalJSON converts current value to JSON
+func (n URL) MarshalJSON() ([]byte, error) {
+	if !n.isValid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.realValue.String())
+}
+
+// UnmarshalJSON writes JSON to this type
+func (n *URL) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = url.URL{}
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return jsonError(err)
+	}
+	parsed, err := parseURL(text)
+	if err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n URL) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return []byte(n.realValue.String()), nil
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *URL) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = url.URL{}
+		return nil
+	}
+
+	parsed, err := parseURL(string(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n URL) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *URL) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n URL) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return copyText(n.realValue.String()), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n URL) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue.String(), nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *URL) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = url.URL{}
+		return nil
+	}
+
+	var text string
+	if err := value.Decode(&text); err != nil {
+		return err
+	}
+	parsed, err := parseURL(text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n URL) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue.String(), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *URL) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = url.URL{}
+		return nil
+	}
+
+	parsed, err := parseURL(strings.TrimSpace(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n URL) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.EncodeString(n.realValue.String())
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *URL) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = url.URL{}
+		return nil
+	}
+
+	text, err := dec.DecodeString()
+	if err != nil {
+		return err
+	}
+	parsed, err := parseURL(text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n URL) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.MarshalValue(n.realValue.String())
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *URL) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = url.URL{}
+		return nil
+	}
+
+	if t != bson.TypeString {
+		return bsonTypeError(t, "URL")
+	}
+	text, _, ok := bsoncore.ReadString(data)
+	if !ok {
+		return bsonTypeError(t, "URL")
+	}
+	parsed, err := parseURL(text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n URL) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, &n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *URL) GobDecode(data []byte) error {
+	var parsed url.URL
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = url.URL{}
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// Scan implements scanner interface
+func (n *URL) Scan(value interface{}) error {
+	value = scanValue(value)
+	if value == nil {
+		n.realValue, n.isValid = url.URL{}, false
+		return nil
+	}
+
+	var text string
+	if err := convertAssign(&text, value); err != nil {
+		return scanError(err)
+	}
+	parsed, err := parseURL(text)
+	if err != nil {
+		return scanError(err)
+	}
+	n.realValue = parsed
+
+	n.isValid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n URL) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue.String(), nil
+}
+
+// GormDataType gorm common data type
+func (URL) GormDataType() string {
+	return "url_null"
+}
+
+// GormDBDataType gorm db data type
+func (n URL) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n URL) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (URL) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
+	case "sqlite", "mysql", "sqlserver":
+		return "TEXT"
+	case "postgres", "cockroachdb":
+		return "text"
+	case "clickhouse":
+		return "Nullable(String)"
+	}
+	return ""
+}
+
+// parseURL parses an absolute URL. A scheme is required, and so is a host
+// unless the URL is opaque like "mailto:someone@example.com". Relative
+// references such as "/profile" or "example.com/profile" are rejected.
+func parseURL(text string) (url.URL, error) {
+	parsed, err := url.Parse(text)
+	if err != nil {
+		return url.URL{}, err
+	}
+	if parsed.Scheme == "" {
+		return url.URL{}, fmt.Errorf("nullable: URL %q has no scheme", text)
+	}
+	if parsed.Host == "" && parsed.Opaque == "" {
+		return url.URL{}, fmt.Errorf("nullable: URL %q has no host", text)
+	}
+	return *parsed, nil
+}
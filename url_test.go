@@ -0,0 +1,343 @@
+package nullable_test
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanURL(t *testing.T) {
+	nullableURL := nullable.NewURL(nil)
+
+	tests.AssertEqual(t, nullableURL.Scan("https://example.com/profile"), nil)
+	tests.AssertEqual(t, nullableURL.Get(), url.URL{Scheme: "https", Host: "example.com", Path: "/profile"})
+
+	tests.AssertEqual(t, nullableURL.Scan([]byte("mailto:someone@example.com")), nil)
+	tests.AssertEqual(t, nullableURL.Get(), url.URL{Scheme: "mailto", Opaque: "someone@example.com"})
+
+	for _, invalid := range []string{"example.com/profile", "/profile", "https://", "http://[::1", ""} {
+		if err := nullableURL.Scan(invalid); err == nil {
+			t.Errorf("scanning %q into URL must fail", invalid)
+		}
+	}
+
+	tests.AssertEqual(t, nullableURL.Scan(nil), nil)
+	tests.AssertEqual(t, nullableURL.Get(), nil)
+}
+
+func TestNewURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	nullableURL1 := nullable.NewURL(&basic)
+	tests.AssertEqual(t, nullableURL1.Get(), basic)
+
+	nullableURL2 := nullable.NewURL(nil)
+	tests.AssertEqual(t, nullableURL2.Get(), nil)
+}
+
+func TestSetURL(t *testing.T) {
+	nullableURL := nullable.NewURL(nil)
+	tests.AssertEqual(t, nullableURL.Get(), nil)
+
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	nullableURL.Set(&basic)
+	tests.AssertEqual(t, nullableURL.Get(), basic)
+
+	nullableURL.Set(nil)
+	tests.AssertEqual(t, nullableURL.Get(), nil)
+}
+
+func TestURLFrom(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	tests.AssertEqual(t, nullable.URLFrom(basic), nullable.NewURL(&basic))
+	tests.AssertEqual(t, nullable.URLFrom(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullURL(), nullable.NewURL(nil))
+	tests.AssertEqual(t, nullable.NullURL().IsNull(), true)
+}
+
+func TestCoalesceURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	var zero url.URL = url.URL{}
+	tests.AssertEqual(t, nullable.CoalesceURL(), nullable.URL{})
+	tests.AssertEqual(t, nullable.CoalesceURL(nullable.NullURL(), nullable.NullURL()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceURL(nullable.NullURL(), nullable.URLFrom(basic), nullable.URLFrom(zero)), nullable.URLFrom(basic))
+	tests.AssertEqual(t, nullable.CoalesceURL(nullable.URLFrom(zero), nullable.URLFrom(basic)), nullable.URLFrom(zero))
+}
+
+func TestIsZeroURL(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullURL().IsZero(), true)
+	tests.AssertEqual(t, nullable.URLFrom(url.URL{}).IsZero(), false)
+	tests.AssertEqual(t, nullable.URLFrom(url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}).IsZero(), false)
+}
+
+func TestCloneURL(t *testing.T) {
+	tests.AssertEqual(t, nullable.URLFrom(url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}).Clone(), nullable.URLFrom(url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}))
+	tests.AssertEqual(t, nullable.NullURL().Clone(), nullable.NullURL())
+}
+
+func TestUnwrapURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	value, ok := nullable.URLFrom(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullURL().Unwrap()
+	tests.AssertEqual(t, value, url.URL{})
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.URL
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, url.URL{})
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseURL(t *testing.T) {
+	parsed, err := nullable.ParseURL("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullURL())
+
+	text, err := nullable.URLFrom(url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseURL(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.URLFrom(url.URL{Scheme: "https", Host: "example.com", Path: "/profile"})), true)
+
+	for _, text := range []string{"abc"} {
+		if _, err := nullable.ParseURL(text); err == nil {
+			t.Errorf("parsing %q as URL must fail", text)
+		}
+	}
+}
+
+func TestMustParseURL(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseURL(""), nullable.NullURL())
+
+	text, err := nullable.URLFrom(url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseURL(string(text)).Equal(nullable.URLFrom(url.URL{Scheme: "https", Host: "example.com", Path: "/profile"})), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseURL of malformed text must panic")
+		}
+	}()
+	nullable.MustParseURL("abc")
+}
+
+func TestPtrURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	tests.AssertEqual(t, nullable.URLPtr(nullable.NewURL(&basic)), basic)
+	tests.AssertEqual(t, nullable.URLPtr(nullable.NewURL(nil)), nil)
+	tests.AssertEqual(t, nullable.URLValue(nullable.NewURL(&basic)), basic)
+	tests.AssertEqual(t, nullable.URLValue(nullable.NewURL(nil)), url.URL{})
+}
+
+func TestSetValueURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	var nullableURL nullable.URL
+	nullableURL.SetValue(basic)
+	tests.AssertEqual(t, nullableURL, nullable.URLFrom(basic))
+
+	nullableURL.SetNull()
+	tests.AssertEqual(t, nullableURL, nullable.NullURL())
+}
+
+func TestZeroAsNullURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	var zero url.URL = url.URL{}
+	tests.AssertEqual(t, nullable.URLZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.URLZeroAsNull(basic), nullable.URLFrom(basic))
+
+	nullableURL := nullable.URLFrom(zero)
+	nullableURL.NullIfZero()
+	tests.AssertEqual(t, nullableURL, nullable.NullURL())
+
+	nullableURL = nullable.URLFrom(basic)
+	nullableURL.NullIfZero()
+	tests.AssertEqual(t, nullableURL, nullable.URLFrom(basic))
+}
+
+func TestIsNullURL(t *testing.T) {
+	var zero nullable.URL
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	nullableURL := nullable.NewURL(&basic)
+	tests.AssertEqual(t, nullableURL.IsNull(), false)
+	tests.AssertEqual(t, nullableURL.IsValid(), true)
+
+	nullableURL.Set(nil)
+	tests.AssertEqual(t, nullableURL.IsNull(), true)
+	tests.AssertEqual(t, nullableURL.IsValid(), false)
+}
+
+func TestGetOrURL(t *testing.T) {
+	var zero nullable.URL
+	var fallback url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), url.URL{})
+
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	nullableURL := nullable.NewURL(&basic)
+	tests.AssertEqual(t, nullableURL.GetOr(url.URL{}), basic)
+	tests.AssertEqual(t, nullableURL.GetOrZero(), basic)
+}
+
+func TestMustGetURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	nullableURL := nullable.NewURL(&basic)
+	tests.AssertEqual(t, nullableURL.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL URL")
+	}()
+	nullableURL.Set(nil)
+	nullableURL.MustGet()
+	t.Error("MustGet on NULL URL must panic")
+}
+
+func TestTextURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	marshalUnmarshalText(t, nullable.NewURL(&basic))
+
+	marshalUnmarshalText(t, nullable.NewURL(nil))
+}
+
+func TestCSVURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	marshalUnmarshalCSV(t, nullable.NewURL(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewURL(nil))
+}
+
+func TestYAMLURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	marshalUnmarshalYAML(t, nullable.NewURL(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewURL(nil))
+}
+
+func TestMsgpackURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	marshalUnmarshalMsgpack(t, nullable.NewURL(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewURL(nil))
+}
+
+func TestBSONURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	marshalUnmarshalBSON(t, nullable.NewURL(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewURL(nil))
+}
+
+func TestGobURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	marshalUnmarshalGob(t, nullable.NewURL(&basic))
+
+	var zero url.URL = url.URL{}
+	marshalUnmarshalGob(t, nullable.NewURL(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewURL(nil))
+}
+
+func TestStringerURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	nullableURL := nullable.NewURL(&basic)
+	tests.AssertEqual(t, nullableURL.String(), "https://example.com/profile")
+	tests.AssertEqual(t, fmt.Sprint(nullableURL), "https://example.com/profile")
+
+	nullableURL.Set(nil)
+	tests.AssertEqual(t, nullableURL.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableURL), "<null>")
+}
+
+func TestChangedURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	value, null := nullable.NewURL(&basic), nullable.NewURL(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.URLFrom(url.URL{})), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewURL(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewURL(nil)), false)
+}
+
+func TestEqualURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	var zero url.URL = url.URL{}
+	tests.AssertEqual(t, nullable.NewURL(nil).Equal(nullable.NewURL(nil)), true)
+	tests.AssertEqual(t, nullable.NewURL(nil).Equal(nullable.NewURL(&basic)), false)
+	tests.AssertEqual(t, nullable.NewURL(&basic).Equal(nullable.NewURL(nil)), false)
+	tests.AssertEqual(t, nullable.NewURL(&basic).Equal(nullable.NewURL(&basic)), true)
+	tests.AssertEqual(t, nullable.NewURL(&basic).Equal(nullable.NewURL(&zero)), false)
+	tests.AssertEqual(t, nullable.NewURL(&zero).Equal(nullable.NewURL(nil)), false)
+}
+
+func TestXMLURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	marshalUnmarshalXML(t, nullable.NewURL(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewURL(nil))
+}
+
+func TestJSONURL(t *testing.T) {
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	marshalUnmarshalJSON(t, nullable.NewURL(&basic))
+
+	marshalUnmarshalJSON(t, nullable.NewURL(nil))
+
+	serialized, err := nullable.NewURL(&basic).MarshalJSON()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `"https://example.com/profile"`)
+
+	var unserialized nullable.URL
+	tests.AssertEqual(t, unserialized.UnmarshalJSON([]byte(`"https://example.com/hooks?event=signup"`)), nil)
+	tests.AssertEqual(t, unserialized.Get(), url.URL{Scheme: "https", Host: "example.com", Path: "/hooks", RawQuery: "event=signup"})
+
+	for _, invalid := range []string{`"example.com"`, `"/hooks"`, `""`, `42`} {
+		if err := unserialized.UnmarshalJSON([]byte(invalid)); err == nil {
+			t.Errorf("unmarshalling %s into URL must fail", invalid)
+		}
+	}
+}
+
+func TestURL(t *testing.T) {
+	type TestNullableURL struct {
+		ID      uint
+		Name    string
+		Website nullable.URL
+	}
+
+	DB.Migrator().DropTable(&TestNullableURL{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableURL{}); err != nil {
+		t.Errorf("failed to migrate nullable URL, got error: %v", err)
+	}
+
+	var basic url.URL = url.URL{Scheme: "https", Host: "example.com", Path: "/profile"}
+	present := TestNullableURL{
+		Name:    "present",
+		Website: nullable.NewURL(&basic),
+	}
+	DB.Create(&present)
+
+	missing := TestNullableURL{
+		Name:    "missing",
+		Website: nullable.NewURL(nil),
+	}
+	DB.Create(&missing)
+
+	var result1 TestNullableURL
+	if err := DB.First(&result1, "name = ?", "present").Error; err != nil {
+		t.Fatal("Cannot read URL test record of \"present\"")
+	}
+	tests.AssertEqual(t, result1, present)
+
+	var result2 TestNullableURL
+	if err := DB.First(&result2, "name = ?", "missing").Error; err != nil {
+		t.Fatal("Cannot read URL test record of \"missing\"")
+	}
+	tests.AssertEqual(t, result2, missing)
+}
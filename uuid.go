@@ -0,0 +1,451 @@
+package nullable
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"hash"
+	"log/slog"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/vmihailenco/msgpack/v5"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm"
+	"gorm.io/gorm/schema"
+)
+
+// UUID SQL type that can retrieve NULL value
+type UUID struct {
+	core[uuid.UUID]
+}
+
+// NewUUID creates a new nullable UUID
+func NewUUID(value *uuid.UUID) UUID {
+	return UUID{core: newCore(value)}
+}
+
+// UUIDFrom creates a new valid nullable UUID from value
+func UUIDFrom(value uuid.UUID) UUID {
+	return NewUUID(&value)
+}
+
+// NullUUID creates a new NULL UUID
+func NullUUID() UUID {
+	return NewUUID(nil)
+}
+
+// UUIDZeroAsNull creates a new nullable UUID that is NULL when value is the zero value
+func UUIDZeroAsNull(value uuid.UUID) UUID {
+	if value == uuid.Nil {
+		return NullUUID()
+	}
+	return UUIDFrom(value)
+}
+
+// CoalesceUUID returns the first valid value, or NULL when all of them are NULL
+func CoalesceUUID(values ...UUID) UUID {
+	for _, value := range values {
+		if value.isValid {
+			return value
+		}
+	}
+	return UUID{}
+}
+
+// UUIDPtr returns n.Get(), handy where a function value is needed
+func UUIDPtr(n UUID) *uuid.UUID {
+	return n.Get()
+}
+
+// UUIDValue returns n.GetOrZero(), zero value when NULL
+func UUIDValue(n UUID) uuid.UUID {
+	return n.GetOrZero()
+}
+
+// ParseUUID parses text like UnmarshalText does, empty text is NULL
+func ParseUUID(text string) (UUID, error) {
+	var n UUID
+	if err := n.UnmarshalText([]byte(text)); err != nil {
+		return UUID{}, err
+	}
+	return n, nil
+}
+
+// MustParseUUID is ParseUUID that panics when text is malformed. It is meant
+// for test fixtures and package-level values, never for user input.
+func MustParseUUID(text string) UUID {
+	n, err := ParseUUID(text)
+	if err != nil {
+		panic(fmt.Sprintf("nullable: MustParseUUID(%q): %v", text, err))
+	}
+	return n
+}
+
+// Reset sets the value back to the zero NULL UUID, the one a declared
+// UUID starts with, so pooled values can be reused
+func (n *UUID) Reset() {
+	*n = UUID{}
+}
+
+// SetFromInterface sets value the way Scan reads it, for loosely typed input
+// such as a map[string]interface{} payload. nil is NULL, pointers and
+// driver.Valuer such as other nullables are unwrapped. On error the value is
+// left unchanged.
+func (n *UUID) SetFromInterface(value interface{}) error {
+	unwrapped, err := interfaceValue(value)
+	if err != nil {
+		return err
+	}
+	scanned := *n
+	if err := scanned.Scan(unwrapped); err != nil {
+		return err
+	}
+	*n = scanned
+	return nil
+}
+
+// NullIfZero marks the value as NULL when it holds the zero value
+func (n *UUID) NullIfZero() {
+	if n.isValid && n.realValue == uuid.Nil {
+		n.SetNull()
+	}
+}
+
+// MustGet either UUID or panic when NULL
+func (n UUID) MustGet() uuid.UUID {
+	return n.mustGet("UUID")
+}
+
+// Clone returns a copy of the value, same as assigning it
+func (n UUID) Clone() UUID {
+	return n
+}
+
+// String returns UUID in its natural text form, or "<null>" when NULL
+func (n UUID) String() string {
+	if !n.isValid {
+		return nullString
+	}
+	return n.realValue.String()
+}
+
+// DebugString returns UUID(value) or UUID(NULL), telling NULL apart from a
+// zero value in logs
+func (n UUID) DebugString() string {
+	return debugString("UUID", n.isValid, n.String())
+}
+
+// LogValue implements slog.LogValuer, logging NULL as nil and the value as
+// its text like String
+func (n UUID) LogValue() slog.Value {
+	return logText(n.isValid, n.String())
+}
+
+// Equal reports whether both values are NULL or both hold the same UUID
+func (n UUID) Equal(other UUID) bool {
+	if !n.isValid || !other.isValid {
+		return n.isValid == other.isValid
+	}
+	return n.realValue == other.realValue
+}
+
+// Changed reports whether n differs from old the way Equal tells them
+// apart, going from or to NULL included
+func (n UUID) Changed(old UUID) bool {
+	return !n.Equal(old)
+}
+
+// WriteHash writes to h a type tag, whether the value is valid, and unless
+// NULL the 16 bytes, telling NULL apart from zero in cache keys
+func (n UUID) WriteHash(h hash.Hash) {
+	writeHash(h, hashTagUUID, n.isValid, n.realValue[:])
+}
+
+// MarshalJSON converts current value to JSON
+func (n UUID) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.Get())
+}
+
+// UnmarshalJSON writes JSON to this type
+func (n *UUID) UnmarshalJSON(data []byte) error {
+	isNull, err := unmarshalJSONNull(data)
+	if err != nil {
+		return jsonError(err)
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = uuid.Nil
+		return nil
+	}
+
+	var parsed uuid.UUID
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return jsonError(err)
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalText converts current value to text, NULL is empty text
+func (n UUID) MarshalText() ([]byte, error) {
+	if !n.isValid {
+		return []byte{}, nil
+	}
+	return n.realValue.MarshalText()
+}
+
+// UnmarshalText writes text to this type, empty text is NULL
+func (n *UUID) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		n.isValid = false
+		n.realValue = uuid.Nil
+		return nil
+	}
+
+	parsed, err := uuid.ParseBytes(text)
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalCSV converts current value to a CSV cell, NULL is a blank cell
+func (n UUID) MarshalCSV() (string, error) {
+	text, err := n.MarshalText()
+	return string(text), err
+}
+
+// UnmarshalCSV writes a CSV cell to this type, a blank cell is NULL
+func (n *UUID) UnmarshalCSV(cell string) error {
+	return n.UnmarshalText([]byte(cell))
+}
+
+// CopyText converts current value to a field of PostgreSQL COPY text
+// format, `\N` and true when NULL
+func (n UUID) CopyText() (string, bool) {
+	if !n.isValid {
+		return copyNull, true
+	}
+	return n.realValue.String(), false
+}
+
+// MarshalYAML converts current value to YAML
+func (n UUID) MarshalYAML() (interface{}, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue, nil
+}
+
+// UnmarshalYAML writes YAML to this type
+func (n *UUID) UnmarshalYAML(value *yaml.Node) error {
+	if value.ShortTag() == "!!null" {
+		n.isValid = false
+		n.realValue = uuid.Nil
+		return nil
+	}
+
+	var parsed uuid.UUID
+	if err := value.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalXML converts current value to XML
+func (n UUID) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !n.isValid {
+		return marshalXMLNil(e, start)
+	}
+	return e.EncodeElement(n.realValue.String(), start)
+}
+
+// UnmarshalXML writes XML to this type
+func (n *UUID) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	text, isNull, err := unmarshalXMLText(d, start)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = uuid.Nil
+		return nil
+	}
+
+	parsed, err := uuid.Parse(strings.TrimSpace(text))
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// EncodeMsgpack converts current value to MessagePack
+func (n UUID) EncodeMsgpack(enc *msgpack.Encoder) error {
+	if !n.isValid {
+		return enc.EncodeNil()
+	}
+	return enc.Encode(n.realValue)
+}
+
+// DecodeMsgpack writes MessagePack to this type
+func (n *UUID) DecodeMsgpack(dec *msgpack.Decoder) error {
+	isNull, err := decodeMsgpackNil(dec)
+	if err != nil {
+		return err
+	}
+	if isNull {
+		n.isValid = false
+		n.realValue = uuid.Nil
+		return nil
+	}
+
+	var parsed uuid.UUID
+	if err := dec.Decode(&parsed); err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// MarshalBSONValue converts current value to BSON, NULL is BSON null
+func (n UUID) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !n.isValid {
+		return bson.TypeNull, nil, nil
+	}
+	return bson.TypeBinary, bsoncore.AppendBinary(nil, bson.TypeBinaryUUID, n.realValue[:]), nil
+}
+
+// UnmarshalBSONValue writes BSON to this type
+func (n *UUID) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if isBSONNull(t) {
+		n.isValid = false
+		n.realValue = uuid.Nil
+		return nil
+	}
+
+	var parsed uuid.UUID
+	var err error
+	switch t {
+	case bson.TypeBinary:
+		_, bytes, _, ok := bsoncore.ReadBinary(data)
+		if !ok {
+			return bsonTypeError(t, "UUID")
+		}
+		parsed, err = uuid.FromBytes(bytes)
+	case bson.TypeString:
+		text, _, ok := bsoncore.ReadString(data)
+		if !ok {
+			return bsonTypeError(t, "UUID")
+		}
+		parsed, err = uuid.Parse(text)
+	default:
+		return bsonTypeError(t, "UUID")
+	}
+	if err != nil {
+		return err
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// GobEncode converts current value to gob, keeping NULL apart from zero value
+func (n UUID) GobEncode() ([]byte, error) {
+	return gobEncode(n.isValid, n.realValue)
+}
+
+// GobDecode writes gob to this type
+func (n *UUID) GobDecode(data []byte) error {
+	var parsed uuid.UUID
+	isValid, err := gobDecode(data, &parsed)
+	if err != nil {
+		return err
+	}
+	if !isValid {
+		n.isValid = false
+		n.realValue = uuid.Nil
+		return nil
+	}
+
+	n.isValid = true
+	n.realValue = parsed
+	return nil
+}
+
+// Scan implements scanner interface
+func (n *UUID) Scan(value interface{}) error {
+	value = scanValue(value)
+	if value == nil {
+		n.realValue, n.isValid = uuid.Nil, false
+		return nil
+	}
+
+	// uuid.UUID understands both the 16-byte binary and the 36-char string form
+	var scanned uuid.UUID
+	if err := scanned.Scan(plainValue(value)); err != nil {
+		return scanError(err)
+	}
+	n.realValue = scanned
+
+	n.isValid = true
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (n UUID) Value() (driver.Value, error) {
+	if !n.isValid {
+		return nil, nil
+	}
+	return n.realValue.String(), nil
+}
+
+// GormDataType gorm common data type
+func (UUID) GormDataType() string {
+	return "uuid_null"
+}
+
+// GormDBDataType gorm db data type
+func (n UUID) GormDBDataType(db *gorm.DB, field *schema.Field) string {
+	return n.columnType(dialectName(db), field)
+}
+
+// ColumnType returns the column type GormDBDataType gives dialect, such as
+// "mysql" or "postgres", for a field without tags. Unknown dialects get "".
+func (n UUID) ColumnType(dialect string) string {
+	return n.columnType(dialect, nil)
+}
+
+// columnType returns the column type created on dialect for field
+func (UUID) columnType(dialect string, field *schema.Field) string {
+	switch dialect {
+	case "sqlite", "mysql":
+		return "CHAR(36)"
+	case "postgres", "cockroachdb":
+		return "uuid"
+	case "sqlserver":
+		// UNIQUEIDENTIFIER is read back in mixed-endian byte order, keep the text form
+		return "CHAR(36)"
+	case "clickhouse":
+		return "Nullable(UUID)"
+	}
+	return ""
+}
@@ -0,0 +1,339 @@
+package nullable_test
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestScanUUID(t *testing.T) {
+	nullableUUID := nullable.NewUUID(nil)
+	basicUUID := uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+
+	// string form
+	tests.AssertEqual(t, nullableUUID.Scan("f47ac10b-58cc-4372-a567-0e02b2c3d479"), nil)
+	tests.AssertEqual(t, nullableUUID.Get(), basicUUID)
+
+	// string form as bytes
+	tests.AssertEqual(t, nullableUUID.Scan([]byte("f47ac10b-58cc-4372-a567-0e02b2c3d479")), nil)
+	tests.AssertEqual(t, nullableUUID.Get(), basicUUID)
+
+	// 16-byte binary form
+	tests.AssertEqual(t, nullableUUID.Scan(basicUUID[:]), nil)
+	tests.AssertEqual(t, nullableUUID.Get(), basicUUID)
+
+	// driver buffer valid until the next row
+	tests.AssertEqual(t, nullableUUID.Scan(sql.RawBytes(basicUUID[:])), nil)
+	tests.AssertEqual(t, nullableUUID.Get(), basicUUID)
+
+	if err := nullableUUID.Scan("not-a-uuid"); err == nil {
+		t.Error("scanning malformed UUID must fail")
+	}
+
+	tests.AssertEqual(t, nullableUUID.Scan(nil), nil)
+	tests.AssertEqual(t, nullableUUID.Get(), nil)
+}
+
+func TestNewUUID(t *testing.T) {
+	basicUUID := uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	nullableUUID1 := nullable.NewUUID(&basicUUID)
+	tests.AssertEqual(t, nullableUUID1.Get(), basicUUID)
+
+	nullableUUID2 := nullable.NewUUID(nil)
+	tests.AssertEqual(t, nullableUUID2.Get(), nil)
+}
+
+func TestSetUUID(t *testing.T) {
+	nullableUUID := nullable.NewUUID(nil)
+	tests.AssertEqual(t, nullableUUID.Get(), nil)
+
+	basicUUID := uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	nullableUUID.Set(&basicUUID)
+	tests.AssertEqual(t, nullableUUID.Get(), basicUUID)
+
+	nullableUUID.Set(nil)
+	tests.AssertEqual(t, nullableUUID.Get(), nil)
+}
+
+func TestUUIDFrom(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	tests.AssertEqual(t, nullable.UUIDFrom(basic), nullable.NewUUID(&basic))
+	tests.AssertEqual(t, nullable.UUIDFrom(basic).IsValid(), true)
+	tests.AssertEqual(t, nullable.NullUUID(), nullable.NewUUID(nil))
+	tests.AssertEqual(t, nullable.NullUUID().IsNull(), true)
+}
+
+func TestCoalesceUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	var zero uuid.UUID = uuid.Nil
+	tests.AssertEqual(t, nullable.CoalesceUUID(), nullable.UUID{})
+	tests.AssertEqual(t, nullable.CoalesceUUID(nullable.NullUUID(), nullable.NullUUID()).IsNull(), true)
+	tests.AssertEqual(t, nullable.CoalesceUUID(nullable.NullUUID(), nullable.UUIDFrom(basic), nullable.UUIDFrom(zero)), nullable.UUIDFrom(basic))
+	tests.AssertEqual(t, nullable.CoalesceUUID(nullable.UUIDFrom(zero), nullable.UUIDFrom(basic)), nullable.UUIDFrom(zero))
+}
+
+func TestIsZeroUUID(t *testing.T) {
+	tests.AssertEqual(t, nullable.NullUUID().IsZero(), true)
+	tests.AssertEqual(t, nullable.UUIDFrom(uuid.Nil).IsZero(), false)
+	tests.AssertEqual(t, nullable.UUIDFrom(uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")).IsZero(), false)
+}
+
+func TestCloneUUID(t *testing.T) {
+	tests.AssertEqual(t, nullable.UUIDFrom(uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")).Clone(), nullable.UUIDFrom(uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")))
+	tests.AssertEqual(t, nullable.NullUUID().Clone(), nullable.NullUUID())
+}
+
+func TestUnwrapUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	value, ok := nullable.UUIDFrom(basic).Unwrap()
+	tests.AssertEqual(t, value, basic)
+	tests.AssertEqual(t, ok, true)
+
+	value, ok = nullable.NullUUID().Unwrap()
+	tests.AssertEqual(t, value, uuid.Nil)
+	tests.AssertEqual(t, ok, false)
+
+	var zero nullable.UUID
+	value, ok = zero.Unwrap()
+	tests.AssertEqual(t, value, uuid.Nil)
+	tests.AssertEqual(t, ok, false)
+}
+
+func TestParseUUID(t *testing.T) {
+	parsed, err := nullable.ParseUUID("")
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed, nullable.NullUUID())
+
+	text, err := nullable.UUIDFrom(uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	parsed, err = nullable.ParseUUID(string(text))
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, parsed.Equal(nullable.UUIDFrom(uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479"))), true)
+
+	for _, text := range []string{"abc"} {
+		if _, err := nullable.ParseUUID(text); err == nil {
+			t.Errorf("parsing %q as UUID must fail", text)
+		}
+	}
+}
+
+func TestMustParseUUID(t *testing.T) {
+	tests.AssertEqual(t, nullable.MustParseUUID(""), nullable.NullUUID())
+
+	text, err := nullable.UUIDFrom(uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")).MarshalText()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, nullable.MustParseUUID(string(text)).Equal(nullable.UUIDFrom(uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479"))), true)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("MustParseUUID of malformed text must panic")
+		}
+	}()
+	nullable.MustParseUUID("abc")
+}
+
+func TestPtrUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	tests.AssertEqual(t, nullable.UUIDPtr(nullable.NewUUID(&basic)), basic)
+	tests.AssertEqual(t, nullable.UUIDPtr(nullable.NewUUID(nil)), nil)
+	tests.AssertEqual(t, nullable.UUIDValue(nullable.NewUUID(&basic)), basic)
+	tests.AssertEqual(t, nullable.UUIDValue(nullable.NewUUID(nil)), uuid.Nil)
+}
+
+func TestSetValueUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	var nullableUUID nullable.UUID
+	nullableUUID.SetValue(basic)
+	tests.AssertEqual(t, nullableUUID, nullable.UUIDFrom(basic))
+
+	nullableUUID.SetNull()
+	tests.AssertEqual(t, nullableUUID, nullable.NullUUID())
+}
+
+func TestZeroAsNullUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	var zero uuid.UUID = uuid.Nil
+	tests.AssertEqual(t, nullable.UUIDZeroAsNull(zero).IsNull(), true)
+	tests.AssertEqual(t, nullable.UUIDZeroAsNull(basic), nullable.UUIDFrom(basic))
+
+	nullableUUID := nullable.UUIDFrom(zero)
+	nullableUUID.NullIfZero()
+	tests.AssertEqual(t, nullableUUID, nullable.NullUUID())
+
+	nullableUUID = nullable.UUIDFrom(basic)
+	nullableUUID.NullIfZero()
+	tests.AssertEqual(t, nullableUUID, nullable.UUIDFrom(basic))
+}
+
+func TestIsNullUUID(t *testing.T) {
+	var zero nullable.UUID
+	tests.AssertEqual(t, zero.IsNull(), true)
+	tests.AssertEqual(t, zero.IsValid(), false)
+
+	basic := uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	nullableUUID := nullable.NewUUID(&basic)
+	tests.AssertEqual(t, nullableUUID.IsNull(), false)
+	tests.AssertEqual(t, nullableUUID.IsValid(), true)
+}
+
+func TestGetOrUUID(t *testing.T) {
+	var zero nullable.UUID
+	fallback := uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	tests.AssertEqual(t, zero.GetOr(fallback), fallback)
+	tests.AssertEqual(t, zero.GetOrZero(), uuid.Nil)
+}
+
+func TestMustGetUUID(t *testing.T) {
+	basic := uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	nullableUUID := nullable.NewUUID(&basic)
+	tests.AssertEqual(t, nullableUUID.MustGet(), basic)
+
+	defer func() {
+		tests.AssertEqual(t, recover(), "nullable: MustGet called on NULL UUID")
+	}()
+	nullableUUID.Set(nil)
+	nullableUUID.MustGet()
+	t.Error("MustGet on NULL UUID must panic")
+}
+
+func TestTextUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	marshalUnmarshalText(t, nullable.NewUUID(&basic))
+
+	marshalUnmarshalText(t, nullable.NewUUID(nil))
+}
+
+func TestCSVUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	marshalUnmarshalCSV(t, nullable.NewUUID(&basic))
+
+	marshalUnmarshalCSV(t, nullable.NewUUID(nil))
+}
+
+func TestYAMLUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	marshalUnmarshalYAML(t, nullable.NewUUID(&basic))
+
+	marshalUnmarshalYAML(t, nullable.NewUUID(nil))
+}
+
+func TestMsgpackUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	marshalUnmarshalMsgpack(t, nullable.NewUUID(&basic))
+
+	marshalUnmarshalMsgpack(t, nullable.NewUUID(nil))
+}
+
+func TestBSONUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	marshalUnmarshalBSON(t, nullable.NewUUID(&basic))
+
+	marshalUnmarshalBSON(t, nullable.NewUUID(nil))
+}
+
+func TestGobUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	marshalUnmarshalGob(t, nullable.NewUUID(&basic))
+
+	var zero uuid.UUID = uuid.Nil
+	marshalUnmarshalGob(t, nullable.NewUUID(&zero))
+
+	marshalUnmarshalGob(t, nullable.NewUUID(nil))
+}
+
+func TestStringerUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	nullableUUID := nullable.NewUUID(&basic)
+	tests.AssertEqual(t, nullableUUID.String(), "f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	tests.AssertEqual(t, fmt.Sprint(nullableUUID), "f47ac10b-58cc-4372-a567-0e02b2c3d479")
+
+	nullableUUID.Set(nil)
+	tests.AssertEqual(t, nullableUUID.String(), "<null>")
+	tests.AssertEqual(t, fmt.Sprintf("%v", nullableUUID), "<null>")
+}
+
+func TestChangedUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	value, null := nullable.NewUUID(&basic), nullable.NewUUID(nil)
+	tests.AssertEqual(t, value.Changed(null), true)
+	tests.AssertEqual(t, null.Changed(value), true)
+	tests.AssertEqual(t, value.Changed(nullable.UUIDFrom(uuid.Nil)), true)
+	tests.AssertEqual(t, value.Changed(nullable.NewUUID(&basic)), false)
+	tests.AssertEqual(t, null.Changed(nullable.NewUUID(nil)), false)
+}
+
+func TestEqualUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	var zero uuid.UUID = uuid.Nil
+	tests.AssertEqual(t, nullable.NewUUID(nil).Equal(nullable.NewUUID(nil)), true)
+	tests.AssertEqual(t, nullable.NewUUID(nil).Equal(nullable.NewUUID(&basic)), false)
+	tests.AssertEqual(t, nullable.NewUUID(&basic).Equal(nullable.NewUUID(nil)), false)
+	tests.AssertEqual(t, nullable.NewUUID(&basic).Equal(nullable.NewUUID(&basic)), true)
+	tests.AssertEqual(t, nullable.NewUUID(&basic).Equal(nullable.NewUUID(&zero)), false)
+	tests.AssertEqual(t, nullable.NewUUID(&zero).Equal(nullable.NewUUID(nil)), false)
+}
+
+func TestXMLUUID(t *testing.T) {
+	var basic uuid.UUID = uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	marshalUnmarshalXML(t, nullable.NewUUID(&basic))
+
+	marshalUnmarshalXML(t, nullable.NewUUID(nil))
+}
+
+func TestJSONUUID(t *testing.T) {
+	basicUUID := uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	marshalUnmarshalJSON(t, nullable.NewUUID(&basicUUID))
+
+	marshalUnmarshalJSON(t, nullable.NewUUID(nil))
+
+	serialized, err := nullable.NewUUID(&basicUUID).MarshalJSON()
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `"f47ac10b-58cc-4372-a567-0e02b2c3d479"`)
+
+	var unserialized nullable.UUID
+	if err := unserialized.UnmarshalJSON([]byte(`"not-a-uuid"`)); err == nil {
+		t.Error("unmarshalling malformed UUID must fail")
+	}
+}
+
+func TestUUID(t *testing.T) {
+	type TestNullableUUID struct {
+		ID       uint
+		Name     string
+		ParentID nullable.UUID
+	}
+
+	DB.Migrator().DropTable(&TestNullableUUID{})
+	if err := DB.Migrator().AutoMigrate(&TestNullableUUID{}); err != nil {
+		t.Errorf("failed to migrate nullable uuid, got error: %v", err)
+	}
+
+	parentID := uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	child := TestNullableUUID{
+		Name:     "child",
+		ParentID: nullable.NewUUID(&parentID),
+	}
+	DB.Create(&child)
+
+	root := TestNullableUUID{
+		Name:     "root",
+		ParentID: nullable.NewUUID(nil),
+	}
+	DB.Create(&root)
+
+	var result1 TestNullableUUID
+	if err := DB.First(&result1, "name = ?", "child").Error; err != nil {
+		t.Fatal("Cannot read uuid test record of \"child\"")
+	}
+	tests.AssertEqual(t, result1, child)
+
+	var result2 TestNullableUUID
+	if err := DB.First(&result2, "name = ?", "root").Error; err != nil {
+		t.Fatal("Cannot read uuid test record of \"root\"")
+	}
+	tests.AssertEqual(t, result2, root)
+}
@@ -0,0 +1,47 @@
+package nullable
+
+import (
+	"reflect"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// RegisterValidators makes v validate the value behind every nullable type
+// instead of the struct itself. NULL is seen as a nil pointer, so `required`
+// fails on it, and so do rules such as `min=1` unless they follow `omitnil`,
+// which skips the remaining rules only when NULL. Generic types need their
+// type arguments and are registered with RegisterNullableValidator,
+// RegisterEnumValidator, RegisterSliceValidator and RegisterMapOfValidator.
+func RegisterValidators(v *validator.Validate) {
+	v.RegisterCustomTypeFunc(validatorValue,
+		BigInt{}, Bool{}, Byte{}, Bytes{}, Date{}, Decimal{}, Duration{}, DurationISO{},
+		Float32{}, Float64{}, Int{}, Int8{}, Int16{}, Int32{}, Int64{}, Int64Array{}, IP{},
+		JSON{}, Money{}, Point{}, Rune{}, String{}, StringTrimmed{}, StringEmptyForNull{},
+		Time{}, Uint{}, Uint8{}, Uint16{}, Uint32{}, Uint64{}, Uint64Array{}, URL{}, UUID{},
+	)
+}
+
+// RegisterNullableValidator is RegisterValidators for Nullable[T]
+func RegisterNullableValidator[T any](v *validator.Validate) {
+	v.RegisterCustomTypeFunc(validatorValue, Nullable[T]{})
+}
+
+// RegisterEnumValidator is RegisterValidators for Enum[T]
+func RegisterEnumValidator[T ~string](v *validator.Validate) {
+	v.RegisterCustomTypeFunc(validatorValue, Enum[T]{})
+}
+
+// RegisterSliceValidator is RegisterValidators for Slice[T]
+func RegisterSliceValidator[T any](v *validator.Validate) {
+	v.RegisterCustomTypeFunc(validatorValue, Slice[T]{})
+}
+
+// RegisterMapOfValidator is RegisterValidators for MapOf[K, V]
+func RegisterMapOfValidator[K comparable, V any](v *validator.Validate) {
+	v.RegisterCustomTypeFunc(validatorValue, MapOf[K, V]{})
+}
+
+// validatorValue returns what Get returns, nil pointer when NULL
+func validatorValue(field reflect.Value) interface{} {
+	return field.MethodByName("Get").Call(nil)[0].Interface()
+}
@@ -0,0 +1,140 @@
+package nullable_test
+
+import (
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+func TestRegisterValidators(t *testing.T) {
+	type Order struct {
+		Quantity nullable.Int64   `validate:"omitnil,min=1,max=100"`
+		Total    nullable.Uint64  `validate:"required"`
+		Note     nullable.String  `validate:"omitnil,max=5"`
+		Discount nullable.Float64 `validate:"omitnil,gte=0,lte=1"`
+	}
+
+	validate := validator.New()
+	nullable.RegisterValidators(validate)
+
+	valid := Order{
+		Quantity: nullable.Int64From(10),
+		Total:    nullable.Uint64From(18446744073709551615),
+		Note:     nullable.StringFrom("gift"),
+		Discount: nullable.Float64From(0.5),
+	}
+	tests.AssertEqual(t, validate.Struct(valid), nil)
+
+	// NULL skips every rule after omitnil
+	tests.AssertEqual(t, validate.Struct(Order{Total: nullable.Uint64From(0)}), nil)
+
+	// Without omitnil NULL is a nil pointer and fails min
+	type Strict struct {
+		Quantity nullable.Int64 `validate:"min=1"`
+	}
+	if err := validate.Struct(Strict{}); err == nil {
+		t.Error("NULL without omitnil must fail min=1")
+	}
+	tests.AssertEqual(t, validate.Struct(Strict{Quantity: nullable.Int64From(1)}), nil)
+
+	invalids := map[string]Order{
+		"Quantity": {Quantity: nullable.Int64From(0), Total: nullable.Uint64From(1)},
+		"Total":    {Quantity: nullable.Int64From(1)},
+		"Note":     {Note: nullable.StringFrom("too long"), Total: nullable.Uint64From(1)},
+		"Discount": {Discount: nullable.Float64From(1.5), Total: nullable.Uint64From(1)},
+	}
+	for field, order := range invalids {
+		err := validate.Struct(order)
+		errs, ok := err.(validator.ValidationErrors)
+		if !ok || len(errs) != 1 {
+			t.Errorf("expected a single validation error on %s, got %v", field, err)
+			continue
+		}
+		tests.AssertEqual(t, errs[0].Field(), field)
+	}
+
+	tooMany := Order{Quantity: nullable.Int64From(101), Total: nullable.Uint64From(1)}
+	if err := validate.Struct(tooMany); err == nil {
+		t.Error("quantity above max must fail")
+	}
+
+	// Money and Point hold no single value, so they are validated as a plain struct
+	type Invoice struct {
+		Total nullable.Money `validate:"required"`
+	}
+	tests.AssertEqual(t, validate.Struct(Invoice{Total: nullable.MustParseMoney("0 USD")}), nil)
+	if err := validate.Struct(Invoice{}); err == nil {
+		t.Error("required NULL money must fail")
+	}
+
+	type Stop struct {
+		Location nullable.Point `validate:"required"`
+	}
+	tests.AssertEqual(t, validate.Struct(Stop{Location: nullable.PointFrom(0, 0)}), nil)
+	if err := validate.Struct(Stop{}); err == nil {
+		t.Error("required NULL point must fail")
+	}
+}
+
+func TestRegisterNullableValidator(t *testing.T) {
+	type Profile struct {
+		Age nullable.Nullable[uint8] `validate:"required,max=150"`
+	}
+
+	validate := validator.New()
+	nullable.RegisterNullableValidator[uint8](validate)
+
+	tests.AssertEqual(t, validate.Struct(Profile{Age: nullable.NullableFrom(uint8(30))}), nil)
+	if err := validate.Struct(Profile{}); err == nil {
+		t.Error("NULL must fail required")
+	}
+	if err := validate.Struct(Profile{Age: nullable.NullableFrom(uint8(200))}); err == nil {
+		t.Error("age above max must fail")
+	}
+}
+
+func TestRegisterGenericValidators(t *testing.T) {
+	type Order struct {
+		Status nullable.Enum[orderStatus]     `validate:"omitnil,ne=shipped"`
+		Tags   nullable.Slice[string]         `validate:"omitnil,max=2,dive,min=1"`
+		Labels nullable.MapOf[string, string] `validate:"required,dive,keys,min=1,endkeys,max=5"`
+	}
+
+	validate := validator.New()
+	nullable.RegisterEnumValidator[orderStatus](validate)
+	nullable.RegisterSliceValidator[string](validate)
+	nullable.RegisterMapOfValidator[string, string](validate)
+
+	paid, shipped := orderPaid, orderShipped
+	valid := Order{
+		Status: nullable.NewEnum(orderStatuses, &paid),
+		Tags:   nullable.SliceFrom([]string{"gift"}),
+		Labels: nullable.MapOfFrom(map[string]string{"team": "ops"}),
+	}
+	tests.AssertEqual(t, validate.Struct(valid), nil)
+	tests.AssertEqual(t, validate.Struct(Order{Labels: valid.Labels}), nil)
+
+	invalids := map[string]Order{
+		"Status": {Status: nullable.NewEnum(orderStatuses, &shipped), Labels: valid.Labels},
+		"Tags":   {Tags: nullable.SliceFrom([]string{"a", "b", "c"}), Labels: valid.Labels},
+		"Labels": {},
+	}
+	for field, order := range invalids {
+		err := validate.Struct(order)
+		errs, ok := err.(validator.ValidationErrors)
+		if !ok || len(errs) != 1 {
+			t.Errorf("expected a single validation error on %s, got %v", field, err)
+			continue
+		}
+		tests.AssertEqual(t, errs[0].Field(), field)
+	}
+
+	if err := validate.Struct(Order{Tags: nullable.SliceFrom([]string{""}), Labels: valid.Labels}); err == nil {
+		t.Error("empty tag must fail dive,min=1")
+	}
+	if err := validate.Struct(Order{Labels: nullable.MapOfFrom(map[string]string{"team": "platform"})}); err == nil {
+		t.Error("label above max must fail")
+	}
+}
@@ -0,0 +1,34 @@
+package nullable
+
+import (
+	"encoding/xml"
+)
+
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// marshalXMLNil writes an empty element marked with xsi:nil="true"
+func marshalXMLNil(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr,
+		xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+		xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+	)
+	return e.EncodeElement("", start)
+}
+
+// unmarshalXMLText reads character data of the element, reporting NULL
+// when the element is marked with xsi:nil or has no content at all.
+func unmarshalXMLText(d *xml.Decoder, start xml.StartElement) (text string, isNull bool, err error) {
+	for _, attr := range start.Attr {
+		// Without xmlns:xsi declaration the decoder keeps the raw prefix
+		if attr.Name.Local == "nil" && (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") {
+			if attr.Value == "true" || attr.Value == "1" {
+				return "", true, d.Skip()
+			}
+		}
+	}
+
+	if err := d.DecodeElement(&text, &start); err != nil {
+		return "", false, err
+	}
+	return text, len(text) == 0, nil
+}
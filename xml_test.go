@@ -0,0 +1,62 @@
+package nullable_test
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gorm.io/gorm/utils/tests"
+)
+
+type xmlEnvelope[T any] struct {
+	XMLName xml.Name `xml:"envelope"`
+	Value   T        `xml:"value"`
+}
+
+func marshalUnmarshalXML[T any](t *testing.T, target T) {
+	serialized, err := xml.Marshal(xmlEnvelope[T]{Value: target})
+	if err != nil {
+		t.Fatalf("Failed to marshal %T because: %s", target, err)
+		return
+	}
+
+	var unserialized xmlEnvelope[T]
+	if err := xml.Unmarshal(serialized, &unserialized); err != nil {
+		t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+		return
+	}
+	tests.AssertEqual(t, unserialized.Value, target)
+}
+
+func TestMarshalXMLNil(t *testing.T) {
+	serialized, err := xml.Marshal(xmlEnvelope[nullable.Int64]{Value: nullable.NewInt64(nil)})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `<envelope><value xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></value></envelope>`)
+
+	var basicInt int64 = 42
+	serialized, err = xml.Marshal(xmlEnvelope[nullable.Int64]{Value: nullable.NewInt64(&basicInt)})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), `<envelope><value>42</value></envelope>`)
+}
+
+func TestUnmarshalXMLNil(t *testing.T) {
+	inputs := []string{
+		`<envelope xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance"><value xsi:nil="true"/></envelope>`,
+		`<envelope><value xsi:nil="true"></value></envelope>`,
+		`<envelope><value></value></envelope>`,
+		`<envelope><value/></envelope>`,
+	}
+	for _, input := range inputs {
+		var unserialized xmlEnvelope[nullable.Int64]
+		unserialized.Value = nullable.NewInt64(new(int64))
+		if err := xml.Unmarshal([]byte(input), &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %s because: %s", input, err)
+		}
+		tests.AssertEqual(t, unserialized.Value.IsNull(), true)
+	}
+
+	var unserialized xmlEnvelope[nullable.Int64]
+	if err := xml.Unmarshal([]byte(`<envelope><value>forty two</value></envelope>`), &unserialized); err == nil {
+		t.Error("unmarshalling malformed number must fail")
+	}
+}
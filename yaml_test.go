@@ -0,0 +1,73 @@
+package nullable_test
+
+import (
+	"testing"
+
+	"github.com/tee8z/nullable"
+	"gopkg.in/yaml.v3"
+	"gorm.io/gorm/utils/tests"
+)
+
+type yamlEnvelope[T any] struct {
+	Value T `yaml:"value"`
+}
+
+func marshalUnmarshalYAML[T any](t *testing.T, target T) {
+	serialized, err := yaml.Marshal(yamlEnvelope[T]{Value: target})
+	if err != nil {
+		t.Fatalf("Failed to marshal %T because: %s", target, err)
+		return
+	}
+
+	var unserialized yamlEnvelope[T]
+	if err := yaml.Unmarshal(serialized, &unserialized); err != nil {
+		t.Fatalf("Failed to unmarshal %T because: %s", target, err)
+		return
+	}
+	tests.AssertEqual(t, unserialized.Value, target)
+}
+
+func TestMarshalYAMLNull(t *testing.T) {
+	serialized, err := yaml.Marshal(yamlEnvelope[nullable.Int64]{Value: nullable.NewInt64(nil)})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "value: null\n")
+
+	var basicInt int64 = 42
+	serialized, err = yaml.Marshal(yamlEnvelope[nullable.Int64]{Value: nullable.NewInt64(&basicInt)})
+	tests.AssertEqual(t, err, nil)
+	tests.AssertEqual(t, string(serialized), "value: 42\n")
+}
+
+func TestUnmarshalYAMLNull(t *testing.T) {
+	inputs := []string{"value: null", "value: ~", "value:", "value: Null"}
+	for _, input := range inputs {
+		var unserialized yamlEnvelope[nullable.Int64]
+		if err := yaml.Unmarshal([]byte(input), &unserialized); err != nil {
+			t.Fatalf("Failed to unmarshal %q because: %s", input, err)
+		}
+		tests.AssertEqual(t, unserialized.Value.IsNull(), true)
+
+		// yaml.v3 won't call UnmarshalYAML for null inside a document,
+		// so check null nodes passed directly as well
+		var document yaml.Node
+		tests.AssertEqual(t, yaml.Unmarshal([]byte(input), &document), nil)
+		nullableInt := nullable.NewInt64(new(int64))
+		tests.AssertEqual(t, nullableInt.UnmarshalYAML(document.Content[0].Content[1]), nil)
+		tests.AssertEqual(t, nullableInt.IsNull(), true)
+	}
+
+	// Missing key must leave zero value NULL
+	var missing yamlEnvelope[nullable.Int64]
+	tests.AssertEqual(t, yaml.Unmarshal([]byte("other: 1"), &missing), nil)
+	tests.AssertEqual(t, missing.Value.IsNull(), true)
+
+	// Quoted empty string is a valid empty string
+	var empty yamlEnvelope[nullable.String]
+	tests.AssertEqual(t, yaml.Unmarshal([]byte(`value: ""`), &empty), nil)
+	tests.AssertEqual(t, empty.Value.Get(), "")
+
+	var malformed yamlEnvelope[nullable.Int64]
+	if err := yaml.Unmarshal([]byte("value: forty two"), &malformed); err == nil {
+		t.Error("unmarshalling malformed number must fail")
+	}
+}